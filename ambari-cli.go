@@ -8,12 +8,55 @@ import (
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	"github.com/urfave/cli"
 	"os"
+	"strings"
+	"time"
 )
 
 var debug bool
 var ambariURL string
 var ambariLogin string
 var ambariPassword string
+var waitRequests bool
+var requestTimeout time.Duration
+var auditFile string
+var auditActor string
+var headers cli.StringSlice
+var clientsFile string
+var ambariAlias string
+
+// The flags to choose where are the privileges. Default is Ambari.
+var privilegeScopeFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "cluster-name, cluster",
+		Usage: "The cluster name, to manage the privileges on cluster",
+	},
+	cli.StringFlag{
+		Name:  "view-name",
+		Usage: "The view name, to manage the privileges on view instance",
+	},
+	cli.StringFlag{
+		Name:  "view-version",
+		Usage: "The view version",
+	},
+	cli.StringFlag{
+		Name:  "view-instance",
+		Usage: "The view instance name",
+	},
+}
+var privilegeFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "principal",
+		Usage: "The user or group name",
+	},
+	cli.StringFlag{
+		Name:  "type",
+		Usage: "The principal type (USER or GROUP)",
+	},
+	cli.StringFlag{
+		Name:  "permission",
+		Usage: "The permission, like AMBARI.ADMINISTRATOR, CLUSTER.OPERATOR or VIEW.USER",
+	},
+}
 
 func main() {
 
@@ -56,6 +99,51 @@ func main() {
 			Usage:       "Display debug output",
 			Destination: &debug,
 		},
+		cli.StringFlag{
+			Name:        "output",
+			Usage:       "The output format of get and list commands (table, json or yaml)",
+			Value:       OUTPUT_TABLE,
+			Destination: &outputFormat,
+		},
+		cli.BoolFlag{
+			Name:        "wait",
+			Usage:       "Wait and display the progress of the request for the commands that not wait by default, like cluster create-from-blueprint",
+			Destination: &waitRequests,
+		},
+		cli.DurationFlag{
+			Name:        "timeout",
+			Usage:       "The maximum time to wait the requests, like 30m. The command exit with code 2 if it's not finished. 0 wait forever.",
+			Destination: &requestTimeout,
+		},
+		altsrc.NewStringFlag(cli.StringFlag{
+			Name:        "audit-file",
+			Usage:       "Append the calls that change Ambari on `FILE`, as Json lines",
+			EnvVar:      "AMBARI_AUDIT_FILE",
+			Destination: &auditFile,
+		}),
+		cli.StringFlag{
+			Name:        "audit-actor",
+			Usage:       "The actor written on audit file, like the job name. Default is the current user",
+			EnvVar:      "AMBARI_AUDIT_ACTOR",
+			Destination: &auditActor,
+		},
+		cli.StringSliceFlag{
+			Name:  "header",
+			Usage: "Add header on all calls to Ambari, like X-Correlation-Id=42. Can be repeated",
+			Value: &headers,
+		},
+		altsrc.NewStringFlag(cli.StringFlag{
+			Name:        "clients-file",
+			Usage:       "The Json or Yaml `FILE` that describe how to connect on many Ambari, used with --alias",
+			EnvVar:      "AMBARI_CLIENTS_FILE",
+			Destination: &clientsFile,
+		}),
+		cli.StringFlag{
+			Name:        "alias",
+			Usage:       "The alias of Ambari to use in clients file, instead of --ambari-url, --ambari-login and --ambari-password",
+			EnvVar:      "AMBARI_ALIAS",
+			Destination: &ambariAlias,
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -432,6 +520,439 @@ func main() {
 			},
 			Action: addKerberos,
 		},
+		{
+			Name:  "regenerate-keytabs",
+			Usage: "Regenerate the keytabs on HDP cluster that is already kerberized",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name where to regenerate keytabs",
+				},
+				cli.BoolFlag{
+					Name:  "only-missing",
+					Usage: "Only generate the keytabs that not exist yet",
+				},
+				cli.StringSliceFlag{
+					Name:  "host",
+					Usage: "The host where to regenerate keytabs, it can be repeated. All hosts if not set",
+				},
+				cli.StringSliceFlag{
+					Name:  "component",
+					Usage: "The service or the component as SERVICE:COMPONENT where to regenerate keytabs, it can be repeated. All components if not set",
+				},
+				cli.StringFlag{
+					Name:  "config-update-policy",
+					Usage: "The configurations to update (none, identities_only, new_and_identities or all). Ambari use all if not set",
+				},
+			},
+			Action: regenerateKeytabs,
+		},
+		{
+			Name:  "cluster",
+			Usage: "Manage cluster",
+			Subcommands: []cli.Command{
+				{
+					Name:  "create-from-blueprint",
+					Usage: "Register the blueprint and create the cluster from the hosts template",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name you should to create",
+						},
+						cli.StringFlag{
+							Name:  "blueprint-file",
+							Usage: "The full path of blueprint file",
+						},
+						cli.StringFlag{
+							Name:  "hosts-template-file",
+							Usage: "The full path of hosts template file",
+						},
+						cli.StringFlag{
+							Name:  "blueprint-name",
+							Usage: "The blueprint name. Default is the one in hosts template file, else the cluster name",
+						},
+					},
+					Action: createClusterFromBlueprint,
+				},
+				{
+					Name:  "create",
+					Usage: "Create the cluster without services and hosts",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name you should to create",
+						},
+						cli.StringFlag{
+							Name:  "version",
+							Usage: "The stack version, like HDP-2.6",
+						},
+						cli.StringFlag{
+							Name:  "security-type",
+							Usage: "The security type, NONE or KERBEROS. Default is NONE",
+						},
+					},
+					Action: createEmptyCluster,
+				},
+				{
+					Name:  "rename",
+					Usage: "Rename the cluster",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name you should to rename",
+						},
+						cli.StringFlag{
+							Name:  "new-cluster-name",
+							Usage: "The new cluster name",
+						},
+					},
+					Action: renameCluster,
+				},
+				{
+					Name:  "health",
+					Usage: "Display the state of services and the number of hosts where their components are started",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name to display",
+						},
+						cli.BoolFlag{
+							Name:  "check",
+							Usage: "Exit with error if services not in maintenance have components not started",
+						},
+					},
+					Action: getClusterHealth,
+				},
+			},
+		},
+		{
+			Name:  "get-cluster",
+			Usage: "Display cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name to display",
+				},
+			},
+			Action: getCluster,
+		},
+		{
+			Name:  "list-hosts",
+			Usage: "Display the hosts registered on Ambari or on cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name where to list hosts. Default is all hosts registered on Ambari",
+				},
+			},
+			Action: listHosts,
+		},
+		{
+			Name:  "config",
+			Usage: "Read, change and compare the configurations of cluster",
+			Subcommands: []cli.Command{
+				{
+					Name:  "get",
+					Usage: "Display the properties of configuration",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name",
+						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "The configuration type, like core-site",
+						},
+						cli.StringFlag{
+							Name:  "property",
+							Usage: "The property to display. Default all properties are displayed",
+						},
+						cli.StringFlag{
+							Name:  "tag",
+							Usage: "The configuration version. Default is the one currently used by cluster",
+						},
+					},
+					Action: getConfig,
+				},
+				{
+					Name:  "set",
+					Usage: "Set properties and create new configuration version",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name",
+						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "The configuration type, like core-site",
+						},
+						cli.StringSliceFlag{
+							Name:  "property",
+							Usage: "The property to set, like key=value. You can repeat it",
+						},
+						cli.StringSliceFlag{
+							Name:  "remove",
+							Usage: "The property key to remove. You can repeat it",
+						},
+						cli.StringFlag{
+							Name:  "note",
+							Usage: "The note of new configuration version",
+						},
+					},
+					Action: setConfig,
+				},
+				{
+					Name:  "diff",
+					Usage: "Display the differences of configurations between two clusters or two versions",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name",
+						},
+						cli.StringFlag{
+							Name:  "to-cluster-name",
+							Usage: "The cluster name to compare with",
+						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "The configuration type to compare versions",
+						},
+						cli.StringFlag{
+							Name:  "from-tag",
+							Usage: "The configuration version to compare from",
+						},
+						cli.StringFlag{
+							Name:  "to-tag",
+							Usage: "The configuration version to compare to. Default is the one currently used by cluster",
+						},
+					},
+					Action: diffConfig,
+				},
+			},
+		},
+		{
+			Name:  "log-level",
+			Usage: "Read and change the log levels in the log4j configuration of service",
+			Subcommands: []cli.Command{
+				{
+					Name:  "list",
+					Usage: "Display the level of loggers",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name",
+						},
+						cli.StringFlag{
+							Name:  "service-name",
+							Usage: "The service name, to use its log4j configuration like hdfs-log4j for HDFS",
+						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "The log4j configuration type, when it's not <service>-log4j",
+						},
+					},
+					Action: listLoggerLevels,
+				},
+				{
+					Name:  "set",
+					Usage: "Change the level of logger",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name",
+						},
+						cli.StringFlag{
+							Name:  "service-name",
+							Usage: "The service name, to use its log4j configuration like hdfs-log4j for HDFS",
+						},
+						cli.StringFlag{
+							Name:  "type",
+							Usage: "The log4j configuration type, when it's not <service>-log4j",
+						},
+						cli.StringFlag{
+							Name:  "logger",
+							Usage: "The logger, like org.apache.hadoop.hdfs.server.namenode, or root for the root logger",
+						},
+						cli.StringFlag{
+							Name:  "level",
+							Usage: "The level, like DEBUG or INFO",
+						},
+					},
+					Action: setLoggerLevel,
+				},
+			},
+		},
+		{
+			Name:  "privilege",
+			Usage: "Manage the privileges on Ambari, on cluster or on view instance",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "Display the privileges",
+					Flags:  privilegeScopeFlags,
+					Action: listPrivileges,
+				},
+				{
+					Name:   "grant",
+					Usage:  "Give permission to user or group",
+					Flags:  append(privilegeFlags, privilegeScopeFlags...),
+					Action: grantPrivilege,
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Remove permission to user or group",
+					Flags:  append(privilegeFlags, privilegeScopeFlags...),
+					Action: revokePrivilege,
+				},
+			},
+		},
+		{
+			Name:  "security",
+			Usage: "Export the users, groups and privileges of Ambari and import them on another Ambari",
+			Subcommands: []cli.Command{
+				{
+					Name:  "export",
+					Usage: "Write the users, groups, members and privileges in file",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name where to read the cluster privileges",
+						},
+						cli.StringFlag{
+							Name:  "file",
+							Usage: "The file where to write the security model",
+						},
+						cli.StringFlag{
+							Name:  "format",
+							Usage: "The format of file (json or yaml)",
+							Value: client.CONFIG_FORMAT_JSON,
+						},
+					},
+					Action: exportSecurityModel,
+				},
+				{
+					Name:  "import",
+					Usage: "Create and delete the users, groups, members and privileges to get the security model of file",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name where to give the cluster privileges",
+						},
+						cli.StringFlag{
+							Name:  "file",
+							Usage: "The file that contain the security model",
+						},
+						cli.StringFlag{
+							Name:  "format",
+							Usage: "The format of file (json or yaml)",
+							Value: client.CONFIG_FORMAT_JSON,
+						},
+						cli.StringFlag{
+							Name:   "password",
+							Usage:  "The password of the local users to create",
+							EnvVar: "AMBARI_NEW_USER_PASSWORD",
+						},
+						cli.BoolFlag{
+							Name:  "delete-privileges",
+							Usage: "Delete the privileges of users and groups that are not in the file, except the AMBARI.ADMINISTRATOR privileges of the user logged in",
+						},
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "Display the changes without do them",
+						},
+					},
+					Action: importSecurityModel,
+				},
+			},
+		},
+		{
+			Name:  "upgrade",
+			Usage: "Display the upgrades of cluster and finalize them",
+			Subcommands: []cli.Command{
+				{
+					Name:  "list",
+					Usage: "Display the upgrades and downgrades of cluster",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name where to list upgrades",
+						},
+					},
+					Action: listUpgrades,
+				},
+				{
+					Name:  "get",
+					Usage: "Display the steps of upgrade",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name where is the upgrade",
+						},
+						cli.IntFlag{
+							Name:  "id",
+							Usage: "The upgrade ID, it's the request ID",
+						},
+					},
+					Action: getUpgrade,
+				},
+				{
+					Name:  "finalize",
+					Usage: "Finalize the upgrade that wait the confirmation",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "cluster-name",
+							Usage: "The cluster name where is the upgrade",
+						},
+						cli.IntFlag{
+							Name:  "id",
+							Usage: "The upgrade ID, it's the request ID",
+						},
+						cli.BoolFlag{
+							Name:  "skip-failures",
+							Usage: "Skip the failed steps and the next failures, then finalize",
+						},
+					},
+					Action: finalizeUpgrade,
+				},
+			},
+		},
+		{
+			Name:  "get-service",
+			Usage: "Display service",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name where is the service",
+				},
+				cli.StringFlag{
+					Name:  "service-name",
+					Usage: "The service name to display",
+				},
+			},
+			Action: getService,
+		},
+		{
+			Name:  "list-requests",
+			Usage: "Display the requests on cluster",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name where to list requests",
+				},
+			},
+			Action: listRequests,
+		},
+		{
+			Name:  "list-restart-required",
+			Usage: "Display the started components that must be restarted to use their configurations or the stack version",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "cluster-name",
+					Usage: "The cluster name where to check the components",
+				},
+			},
+			Action: listRestartRequired,
+		},
 	}
 
 	app.Before = func(c *cli.Context) error {
@@ -453,20 +974,82 @@ func manageGlobalParameters() (*client.AmbariClient, error) {
 	if debug == true {
 		log.SetLevel(log.DebugLevel)
 	}
+	if err := checkOutputFormat(); err != nil {
+		return nil, err
+	}
 
-	if ambariURL == "" {
-		return nil, errors.New("You must set --ambari-url parameter")
+	if ambariAlias == "" {
+		if ambariURL == "" {
+			return nil, errors.New("You must set --ambari-url parameter")
+		}
+
+		if ambariLogin == "" {
+			return nil, errors.New("You must set --ambari-login parameter")
+		}
+		if ambariPassword == "" {
+			return nil, errors.New("You must set --ambari-password parameter")
+		}
+	} else if clientsFile == "" {
+		return nil, errors.New("You must set --clients-file parameter with --alias")
 	}
 
-	if ambariLogin == "" {
-		return nil, errors.New("You must set --ambari-login parameter")
+	if requestTimeout < 0 {
+		return nil, errors.New("The --timeout parameter can't be negative")
+	}
+
+	headerOpts := make([]client.RequestOption, 0, len(headers))
+	for _, header := range headers {
+		keyValue := strings.SplitN(header, "=", 2)
+		if len(keyValue) != 2 || keyValue[0] == "" {
+			return nil, errors.New("The --header parameter must be key=value, got " + header)
+		}
+		headerOpts = append(headerOpts, client.Header(keyValue[0], keyValue[1]))
 	}
-	if ambariPassword == "" {
-		return nil, errors.New("You must set --ambari-password parameter")
+
+	var auditHook client.AuditHook
+	if auditFile != "" {
+		file, err := os.OpenFile(auditFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		if auditActor == "" {
+			auditActor = os.Getenv("USER")
+		}
+		auditHook = client.NewAuditWriter(file)
 	}
 
-	client := client.New(ambariURL, ambariLogin, ambariPassword)
-	client.DisableVerifySSL()
+	client, err := newAmbariClient()
+	if err != nil {
+		return nil, err
+	}
+	client.SetRequestTimeout(requestTimeout)
+	client.SetAudit(auditActor, auditHook)
+	if len(headerOpts) > 0 {
+		client = client.WithOptions(headerOpts...)
+	}
 
 	return client, nil
 }
+
+// newAmbariClient create the client from --ambari-url, --ambari-login and --ambari-password,
+// or from the clients file when --alias is set. The secrets of clients file are read from environment variables.
+func newAmbariClient() (*client.AmbariClient, error) {
+	if ambariAlias == "" {
+		ambariClient := client.New(ambariURL, ambariLogin, ambariPassword)
+		ambariClient.DisableVerifySSL()
+		return ambariClient, nil
+	}
+
+	manager, err := client.LoadClientManager(clientsFile, client.SecretProviderFunc(func(name string) (string, error) {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", errors.New("The environment variable " + name + " is not set")
+		}
+		return value, nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.Client(ambariAlias)
+}
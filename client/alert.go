@@ -6,7 +6,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 type Alert struct {
@@ -29,13 +28,33 @@ type Alerts struct {
 	Items []Alert `json:"items,omitempty"`
 }
 
+// AlertHistory is a state change of alert
+type AlertHistory struct {
+	AlertHistoryInfo *AlertHistoryInfo `json:"AlertHistory"`
+}
+
+type AlertHistoryInfo struct {
+	Id             int64  `json:"id,omitempty"`
+	ClusterName    string `json:"cluster_name,omitempty"`
+	ServiceName    string `json:"service_name,omitempty"`
+	ComponentName  string `json:"component_name,omitempty"`
+	Hostname       string `json:"host_name,omitempty"`
+	DefinitionId   int64  `json:"definition_id,omitempty"`
+	DefinitionName string `json:"definition_name,omitempty"`
+	Instance       string `json:"instance,omitempty"`
+	Label          string `json:"label,omitempty"`
+	State          string `json:"state,omitempty"`
+	Text           string `json:"text,omitempty"`
+	Timestamp      int64  `json:"timestamp,omitempty"`
+}
+
 // String permit to return Alert object as Json string
 func (a *Alert) String() string {
 	json, _ := json.Marshal(a)
 	return string(json)
 }
 
-func (c *AmbariClient) AlertsInHost(clusterName string, hostname string) ([]Alert, error) {
+func (c *AmbariClient) AlertsInHost(clusterName string, hostname string, opts ...RequestOption) ([]Alert, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -45,8 +64,7 @@ func (c *AmbariClient) AlertsInHost(clusterName string, hostname string) ([]Aler
 		panic("Hostname can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
+	c.log.Debugw("AlertsInHost", "cluster_name", clusterName, "hostname", hostname)
 
 	// Check if host exist
 	host, err := c.HostOnCluster(clusterName, hostname)
@@ -57,18 +75,17 @@ func (c *AmbariClient) AlertsInHost(clusterName string, hostname string) ([]Aler
 		return nil, NewAmbariError(404, "Host %s not found in cluster", hostname)
 	}
 
-	query := "fields=*&Alert/maintenance_state=OFF"
 	path := fmt.Sprintf("/clusters/%s/hosts/%s/alerts", clusterName, hostname)
-	resp, err := c.Client().R().SetQueryString(query).Get(path)
+	resp, err := c.get(path, opts, Fields("*"), Where(Eq("Alert/maintenance_state", "OFF")))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	alertsTemp := &Alerts{}
@@ -83,12 +100,12 @@ func (c *AmbariClient) AlertsInHost(clusterName string, hostname string) ([]Aler
 		return nil, err
 	}
 
-	log.Debugf("Return alerts: %s", alerts)
+	c.log.Debug("Return alerts: ", alerts)
 
 	return alerts, nil
 }
 
-func (c *AmbariClient) AlertsInService(clusterName string, serviceName string) ([]Alert, error) {
+func (c *AmbariClient) AlertsInService(clusterName string, serviceName string, opts ...RequestOption) ([]Alert, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -98,8 +115,7 @@ func (c *AmbariClient) AlertsInService(clusterName string, serviceName string) (
 		panic("Serviceame can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
+	c.log.Debugw("AlertsInService", "cluster_name", clusterName, "service_name", serviceName)
 
 	// Check if service exist
 	service, err := c.Service(clusterName, serviceName)
@@ -110,19 +126,17 @@ func (c *AmbariClient) AlertsInService(clusterName string, serviceName string) (
 		return nil, NewAmbariError(404, "Service %s not found", serviceName)
 	}
 
-	query := "fields=*&Alert/maintenance_state=OFF"
-
 	path := fmt.Sprintf("/clusters/%s/services/%s/alerts", clusterName, serviceName)
-	resp, err := c.Client().R().SetQueryString(query).Get(path)
+	resp, err := c.get(path, opts, Fields("*"), Where(Eq("Alert/maintenance_state", "OFF")))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	alertsTemp := &Alerts{}
@@ -137,32 +151,30 @@ func (c *AmbariClient) AlertsInService(clusterName string, serviceName string) (
 		return nil, err
 	}
 
-	log.Debugf("Return alerts: %s", alerts)
+	c.log.Debug("Return alerts: ", alerts)
 
 	return alerts, nil
 }
 
-func (c *AmbariClient) AlertsInCluster(clusterName string) ([]Alert, error) {
+func (c *AmbariClient) AlertsInCluster(clusterName string, opts ...RequestOption) ([]Alert, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("AlertsInCluster", "cluster_name", clusterName)
 
 	path := fmt.Sprintf("/clusters/%s/alerts", clusterName)
-	query := "fields=*&Alert/maintenance_state=OFF"
-
-	resp, err := c.Client().R().SetQueryString(query).Get(path)
+	resp, err := c.get(path, opts, Fields("*"), Where(Eq("Alert/maintenance_state", "OFF")))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	alertsTemp := &Alerts{}
@@ -177,31 +189,30 @@ func (c *AmbariClient) AlertsInCluster(clusterName string) ([]Alert, error) {
 		return nil, err
 	}
 
-	log.Debugf("Return alerts: %s", alerts)
+	c.log.Debug("Return alerts: ", alerts)
 
 	return alerts, nil
 }
 
-func (c *AmbariClient) Alerts(clusterName string) ([]Alert, error) {
+func (c *AmbariClient) Alerts(clusterName string, opts ...RequestOption) ([]Alert, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("Alerts", "cluster_name", clusterName)
 
 	path := fmt.Sprintf("/clusters/%s/alerts", clusterName)
-	query := "fields=*&Alert/maintenance_state=OFF"
-	resp, err := c.Client().R().SetQueryString(query).Get(path)
+	resp, err := c.get(path, opts, Fields("*"), Where(Eq("Alert/maintenance_state", "OFF")))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	alerts := &Alerts{}
@@ -209,7 +220,7 @@ func (c *AmbariClient) Alerts(clusterName string) ([]Alert, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return alerts: %s", alerts)
+	c.log.Debug("Return alerts: ", alerts)
 
 	return alerts.Items, nil
 }
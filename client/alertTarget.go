@@ -0,0 +1,321 @@
+// This file permit to manage alert targets in Ambari API, that notify the alerts by email or SNMP
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/alert-targets.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+const (
+	NOTIFICATION_TYPE_EMAIL  = "EMAIL"
+	NOTIFICATION_TYPE_SNMP   = "SNMP"
+	NOTIFICATION_TYPE_SCRIPT = "ALERT_SCRIPT"
+)
+
+// AlertTarget object
+type AlertTarget struct {
+	AlertTargetInfo *AlertTargetInfo `json:"AlertTarget"`
+}
+type AlertTargetsResponse struct {
+	Response
+	Items []AlertTarget `json:"items"`
+}
+type AlertTargetInfo struct {
+	Id               int64             `json:"id,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	NotificationType string            `json:"notification_type,omitempty"`
+	Global           bool              `json:"global"`
+	Enabled          *bool             `json:"enabled,omitempty"`
+	AlertStates      []string          `json:"alert_states,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+}
+
+// String return alert target object as Json string
+func (a *AlertTarget) String() string {
+	json, _ := json.Marshal(a)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update alert target
+func (a *AlertTarget) CleanBeforeSave() *AlertTarget {
+
+	alertTargetInfo := *a.AlertTargetInfo
+	alertTargetInfo.Id = 0
+
+	return &AlertTarget{
+		AlertTargetInfo: &alertTargetInfo,
+	}
+}
+
+// IsEnabled return true if the alert target is enabled, Ambari enable it by default
+func (i *AlertTargetInfo) IsEnabled() bool {
+	return i.Enabled == nil || *i.Enabled
+}
+
+// AlertTarget return existing alert target
+// It return the alert target if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) AlertTarget(id int64, opts ...RequestOption) (*AlertTarget, error) {
+
+	c.log.Debugw("AlertTarget", "id", id)
+
+	path := fmt.Sprintf("/alert_targets/%d", id)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	alertTarget := &AlertTarget{}
+	err = json.Unmarshal(resp.Body(), alertTarget)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return alert target: %s", alertTarget)
+
+	return alertTarget, nil
+}
+
+// AlertTargets return all alert targets
+// It return the list of alert targets.
+// If not alert target, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) AlertTargets(opts ...RequestOption) ([]AlertTarget, error) {
+
+	resp, err := c.get("/alert_targets", opts, Fields("AlertTarget/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	alertTargetsResponse := &AlertTargetsResponse{}
+	err = json.Unmarshal(resp.Body(), alertTargetsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("AlertTargets: ", alertTargetsResponse.Items)
+
+	return alertTargetsResponse.Items, nil
+}
+
+// SearchAlertTarget permit to get alert target by is name
+// It return the alert target if is found
+// It return nil if is not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SearchAlertTarget(name string, opts ...RequestOption) (*AlertTarget, error) {
+
+	if name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("SearchAlertTarget", "name", name)
+
+	resp, err := c.get("/alert_targets", opts, Fields("AlertTarget/*"), Where(Eq("AlertTarget/name", name)))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	alertTargetsResponse := &AlertTargetsResponse{}
+	err = json.Unmarshal(resp.Body(), alertTargetsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("AlertTargetsResponse: ", alertTargetsResponse)
+
+	if len(alertTargetsResponse.Items) > 0 {
+		c.log.Debug("AlertTarget: ", alertTargetsResponse.Items[0])
+		return &alertTargetsResponse.Items[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// CreateAlertTarget permit to create new alert target
+// It return the alert target if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateAlertTarget(alertTarget *AlertTarget) (*AlertTarget, error) {
+
+	if alertTarget == nil {
+		panic("AlertTarget can't be nil")
+	}
+	if alertTarget.AlertTargetInfo.Name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("CreateAlertTarget", "alert_target", alertTarget)
+
+	// Create the alert target
+	alertTargetPayload := alertTarget.CleanBeforeSave()
+	jsonData, err := json.Marshal(alertTargetPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post("/alert_targets")
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the alert target, Ambari not return its id
+	alertTarget, err = c.SearchAlertTarget(alertTarget.AlertTargetInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	if alertTarget == nil {
+		return nil, NewAmbariError(500, "Can't get alert target that just created")
+	}
+
+	return alertTarget, err
+
+}
+
+// UpdateAlertTarget permit to update existing alert target
+// It return the alert target if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateAlertTarget(alertTarget *AlertTarget) (*AlertTarget, error) {
+
+	if alertTarget == nil {
+		panic("AlertTarget can't be nil")
+	}
+	c.log.Debugw("UpdateAlertTarget", "alert_target", alertTarget)
+
+	// Update the alert target
+	path := fmt.Sprintf("/alert_targets/%d", alertTarget.AlertTargetInfo.Id)
+	alertTargetPayload := alertTarget.CleanBeforeSave()
+	jsonData, err := json.Marshal(alertTargetPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the alert target after update
+	alertTarget, err = c.AlertTarget(alertTarget.AlertTargetInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if alertTarget == nil {
+		return nil, NewAmbariError(500, "Can't get alert target that just updated")
+	}
+
+	return alertTarget, err
+
+}
+
+// DeleteAlertTarget permit to delete existing alert target
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteAlertTarget(id int64) error {
+
+	c.log.Debugw("DeleteAlertTarget", "id", id)
+
+	path := fmt.Sprintf("/alert_targets/%d", id)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete alert target: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
+
+// ApplyAlertTarget permit to create the alert target if not exist, or update it if it's not the desired one
+// The alert target is found by its name. The alert states are compared whatever their order.
+// It return the change report, that is empty if the alert target is already as desired
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ApplyAlertTarget(alertTarget *AlertTarget) (*ChangeReport, error) {
+
+	if alertTarget == nil {
+		panic("AlertTarget can't be nil")
+	}
+	if alertTarget.AlertTargetInfo.Name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("ApplyAlertTarget", "alert_target", alertTarget)
+
+	report := newChangeReport()
+	currentAlertTarget, err := c.SearchAlertTarget(alertTarget.AlertTargetInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	desiredAlertTarget := alertTarget.CleanBeforeSave()
+	if currentAlertTarget == nil {
+		report.add(CHANGE_CREATE, "alert_target", alertTarget.AlertTargetInfo.Name, nil, desiredAlertTarget)
+	} else if !sameAlertTarget(currentAlertTarget.AlertTargetInfo, desiredAlertTarget.AlertTargetInfo) {
+		desiredAlertTarget.AlertTargetInfo.Id = currentAlertTarget.AlertTargetInfo.Id
+		report.add(CHANGE_UPDATE, "alert_target", alertTarget.AlertTargetInfo.Name, currentAlertTarget.CleanBeforeSave(), desiredAlertTarget)
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			_, err = c.CreateAlertTarget(desiredAlertTarget)
+		case CHANGE_UPDATE:
+			_, err = c.UpdateAlertTarget(desiredAlertTarget)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// sameAlertTarget return true if the alert targets notify the same alerts on the same way
+func sameAlertTarget(alertTarget1 *AlertTargetInfo, alertTarget2 *AlertTargetInfo) bool {
+
+	if alertTarget1.Description != alertTarget2.Description ||
+		alertTarget1.NotificationType != alertTarget2.NotificationType ||
+		alertTarget1.Global != alertTarget2.Global ||
+		alertTarget1.IsEnabled() != alertTarget2.IsEnabled() {
+		return false
+	}
+	alertStates1 := append([]string(nil), alertTarget1.AlertStates...)
+	alertStates2 := append([]string(nil), alertTarget2.AlertStates...)
+	sort.Strings(alertStates1)
+	sort.Strings(alertStates2)
+	if (len(alertStates1) > 0 || len(alertStates2) > 0) && !reflect.DeepEqual(alertStates1, alertStates2) {
+		return false
+	}
+
+	return (len(alertTarget1.Properties) == 0 && len(alertTarget2.Properties) == 0) || reflect.DeepEqual(alertTarget1.Properties, alertTarget2.Properties)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestApplyAlertTarget(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	alertTarget := &AlertTarget{
+		AlertTargetInfo: &AlertTargetInfo{
+			Name:             "ops-mail",
+			Description:      "Mail to ops team",
+			NotificationType: NOTIFICATION_TYPE_EMAIL,
+			Global:           true,
+			AlertStates:      []string{"CRITICAL", "WARNING"},
+			Properties: map[string]string{
+				"ambari.dispatch.recipients": "ops@company.com",
+				"mail.smtp.host":             "smtp.company.com",
+			},
+		},
+	}
+
+	// Create
+	report, err := client.ApplyAlertTarget(alertTarget)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_CREATE, report.Changes[0].Action)
+	currentAlertTarget, err := client.SearchAlertTarget("ops-mail")
+	assert.NoError(t, err)
+	assert.NotNil(t, currentAlertTarget)
+	assert.True(t, currentAlertTarget.AlertTargetInfo.Global)
+	assert.True(t, currentAlertTarget.AlertTargetInfo.IsEnabled())
+
+	// Nothing to do, whatever the order of alert states
+	alertTarget.AlertTargetInfo.AlertStates = []string{"WARNING", "CRITICAL"}
+	report, err = client.ApplyAlertTarget(alertTarget)
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+
+	// Update
+	alertTarget.AlertTargetInfo.Properties["ambari.dispatch.recipients"] = "oncall@company.com"
+	client.SetDryRun(NewDryRunRecorder())
+	report, err = client.ApplyAlertTarget(alertTarget)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	client.SetDryRun(nil)
+	report, err = client.ApplyAlertTarget(alertTarget)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+	currentAlertTarget, err = client.AlertTarget(currentAlertTarget.AlertTargetInfo.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "oncall@company.com", currentAlertTarget.AlertTargetInfo.Properties["ambari.dispatch.recipients"])
+
+	// Delete
+	assert.NoError(t, client.DeleteAlertTarget(currentAlertTarget.AlertTargetInfo.Id))
+	alertTargets, err := client.AlertTargets()
+	assert.NoError(t, err)
+	assert.Empty(t, alertTargets)
+}
@@ -0,0 +1,83 @@
+// This file permit to serve the alert targets, that are global to Ambari
+
+package ambaritest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+func (s *Server) serveAlertTargets(w http.ResponseWriter, r *http.Request, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]int64, 0, len(s.alertTargets))
+			for id := range s.alertTargets {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			items := make([]object, 0, len(ids))
+			for _, id := range ids {
+				items = append(items, s.alertTarget(s.alertTargets[id]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			info, _ := body["AlertTarget"].(map[string]interface{})
+			if info == nil || info["name"] == nil || info["name"] == "" || info["notification_type"] == nil {
+				writeError(w, http.StatusBadRequest, "Invalid Request: The name and the notification type are required to create alert target")
+				return
+			}
+			for _, alertTarget := range s.alertTargets {
+				if alertTarget["name"] == info["name"] {
+					writeError(w, http.StatusConflict, fmt.Sprintf("Alert target already exist, name=%s", info["name"]))
+					return
+				}
+			}
+			s.nextId++
+			alertTarget := object{"id": s.nextId, "enabled": true, "global": false}
+			updateAlertTarget(alertTarget, info)
+			s.alertTargets[s.nextId] = alertTarget
+			w.WriteHeader(http.StatusCreated)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	alertTarget, ok := s.alertTargets[id]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Alert target not found, id=%s", segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.alertTarget(alertTarget))
+	case http.MethodPut:
+		info, _ := body["AlertTarget"].(map[string]interface{})
+		updateAlertTarget(alertTarget, info)
+	case http.MethodDelete:
+		delete(s.alertTargets, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// updateAlertTarget set the attributes of alert target that are given
+func updateAlertTarget(alertTarget object, info map[string]interface{}) {
+	for _, key := range []string{"name", "description", "notification_type", "global", "enabled", "alert_states", "properties"} {
+		if value, ok := info[key]; ok {
+			alertTarget[key] = value
+		}
+	}
+}
+
+func (s *Server) alertTarget(alertTarget object) object {
+	return object{
+		"href":        s.href("alert_targets", fmt.Sprintf("%d", alertTarget["id"])),
+		"AlertTarget": alertTarget,
+	}
+}
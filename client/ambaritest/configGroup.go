@@ -0,0 +1,171 @@
+// This file permit to serve the config groups of clusters, with their hosts and their configurations
+// The configurations of config groups are stored with the configurations of cluster, but they are not used as desired configurations.
+
+package ambaritest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+func (s *Server) serveConfigGroups(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body interface{}, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]int64, 0, len(cluster.configGroups))
+			for id := range cluster.configGroups {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			items := make([]object, 0, len(ids))
+			for _, id := range ids {
+				items = append(items, s.configGroup(clusterName, cluster.configGroups[id]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			// Ambari expect the list of config groups to create
+			configGroups, _ := body.([]interface{})
+			if len(configGroups) == 0 {
+				writeError(w, http.StatusBadRequest, "Invalid Request: ConfigGroup is required")
+				return
+			}
+			resources := make([]object, 0, len(configGroups))
+			for _, configGroup := range configGroups {
+				info, _ := configGroup.(map[string]interface{})["ConfigGroup"].(map[string]interface{})
+				if info == nil || info["group_name"] == nil || info["group_name"] == "" {
+					writeError(w, http.StatusBadRequest, "Invalid Request: Config group name is required")
+					return
+				}
+				for _, existing := range cluster.configGroups {
+					if existing["group_name"] == info["group_name"] {
+						writeError(w, http.StatusConflict, fmt.Sprintf("Config group already exist, clusterName=%s, groupName=%s", clusterName, info["group_name"]))
+						return
+					}
+				}
+				s.nextId++
+				configGroup := object{"id": s.nextId, "cluster_name": clusterName}
+				if message := s.updateConfigGroup(cluster, configGroup, info); message != "" {
+					writeError(w, http.StatusBadRequest, message)
+					return
+				}
+				cluster.configGroups[s.nextId] = configGroup
+				resources = append(resources, object{
+					"href":        s.href("clusters", clusterName, "config_groups", strconv.FormatInt(s.nextId, 10)),
+					"ConfigGroup": object{"id": s.nextId},
+				})
+			}
+			writeJSON(w, http.StatusCreated, object{"resources": resources})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	configGroup, ok := cluster.configGroups[id]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Config group not found, clusterName=%s, groupId=%s", clusterName, segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.configGroup(clusterName, configGroup))
+	case http.MethodPut:
+		request, _ := body.(map[string]interface{})
+		info, _ := request["ConfigGroup"].(map[string]interface{})
+		if info == nil {
+			writeError(w, http.StatusBadRequest, "Invalid Request: ConfigGroup is required")
+			return
+		}
+		if message := s.updateConfigGroup(cluster, configGroup, info); message != "" {
+			writeError(w, http.StatusBadRequest, message)
+		}
+	case http.MethodDelete:
+		delete(cluster.configGroups, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// updateConfigGroup set the attributes, the hosts and the configurations of config group
+// The configurations with properties are created, the other must already exist.
+// It return the error message if the config group is not valid
+func (s *Server) updateConfigGroup(cluster *cluster, configGroup object, info map[string]interface{}) string {
+
+	hosts := make([]object, 0)
+	rawHosts, _ := info["hosts"].([]interface{})
+	for _, rawHost := range rawHosts {
+		hostname, _ := rawHost.(map[string]interface{})["host_name"].(string)
+		if !cluster.hosts[hostname] {
+			return fmt.Sprintf("Invalid Request: Host %s not found in cluster %s", hostname, cluster.info["cluster_name"])
+		}
+		hosts = append(hosts, object{"host_name": hostname})
+	}
+
+	desiredConfigs := make([]object, 0)
+	rawDesiredConfigs, _ := info["desired_configs"].([]interface{})
+	for _, rawDesiredConfig := range rawDesiredConfigs {
+		desiredConfig, _ := rawDesiredConfig.(map[string]interface{})
+		configurationType, _ := desiredConfig["type"].(string)
+		tag, _ := desiredConfig["tag"].(string)
+		if configurationType == "" || tag == "" {
+			return "Invalid Request: Type and tag are required for the configurations of config group"
+		}
+		if _, ok := desiredConfig["properties"]; ok {
+			s.storeConfiguration(cluster, object(desiredConfig))
+		} else if s.findConfiguration(cluster, configurationType, tag) == nil {
+			return fmt.Sprintf("Invalid Request: Configuration %s with tag %s not found", configurationType, tag)
+		}
+		desiredConfigs = append(desiredConfigs, object{"type": configurationType, "tag": tag})
+	}
+
+	for _, key := range []string{"group_name", "tag", "service_name", "description"} {
+		if value, ok := info[key]; ok {
+			configGroup[key] = value
+		}
+	}
+	configGroup["hosts"] = hosts
+	configGroup["desired_configs"] = desiredConfigs
+
+	return ""
+}
+
+// storeConfiguration add the configuration version if it not exist, without to use it as desired configuration
+func (s *Server) storeConfiguration(cluster *cluster, configuration object) {
+
+	if s.findConfiguration(cluster, configuration["type"], configuration["tag"]) != nil {
+		return
+	}
+	newConfiguration := object{
+		"type":    configuration["type"],
+		"tag":     configuration["tag"],
+		"version": int64(0),
+	}
+	for _, key := range []string{"properties", "properties_attributes"} {
+		if value, ok := configuration[key]; ok {
+			newConfiguration[key] = value
+		}
+	}
+	cluster.configurations = append(cluster.configurations, newConfiguration)
+}
+
+// findConfiguration return the configuration version with the type and the tag, or nil if not found
+func (s *Server) findConfiguration(cluster *cluster, configurationType interface{}, tag interface{}) object {
+	for _, configuration := range cluster.configurations {
+		if configuration["type"] == configurationType && configuration["tag"] == tag {
+			return configuration
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) configGroup(clusterName string, configGroup object) object {
+	return object{
+		"href":        s.href("clusters", clusterName, "config_groups", fmt.Sprintf("%d", configGroup["id"])),
+		"ConfigGroup": configGroup,
+	}
+}
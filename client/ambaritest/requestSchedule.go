@@ -0,0 +1,144 @@
+// This file permit to serve the request schedules of clusters, like the rolling restart
+// The batches are not run, use SetRequestScheduleBatchStatus to simulate their execution
+
+package ambaritest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// SetRequestScheduleBatchStatus permit to set the status and the return code of batch request, like Ambari do when it run it
+func (s *Server) SetRequestScheduleBatchStatus(clusterName string, id int64, orderId int, status string, returnCode int) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	requestSchedule, ok := cluster.requestSchedules[id]
+	if !ok {
+		panic(fmt.Sprintf("Request schedule %d not found", id))
+	}
+	for _, request := range requestSchedule["batch"].(object)["batch_requests"].([]object) {
+		if request["order_id"] == float64(orderId) {
+			request["request_status"] = status
+			request["return_code"] = returnCode
+			return
+		}
+	}
+	panic(fmt.Sprintf("Batch request %d not found", orderId))
+}
+
+func (s *Server) serveRequestSchedules(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body interface{}, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]int64, 0, len(cluster.requestSchedules))
+			for id := range cluster.requestSchedules {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			items := make([]object, 0, len(ids))
+			for _, id := range ids {
+				items = append(items, s.requestSchedule(clusterName, cluster.requestSchedules[id]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			// The request schedules are created by list, with the batch as list of requests and settings
+			list, ok := body.([]interface{})
+			if !ok {
+				writeError(w, http.StatusBadRequest, "Invalid Request: The request schedules must be given as list")
+				return
+			}
+			resources := make([]object, 0, len(list))
+			for _, item := range list {
+				info, _ := item.(map[string]interface{})["RequestSchedule"].(map[string]interface{})
+				s.nextId++
+				requestSchedule := object{
+					"id":           s.nextId,
+					"cluster_name": clusterName,
+					"description":  info["description"],
+					"status":       "SCHEDULED",
+					"batch":        newRequestScheduleBatch(info["batch"]),
+				}
+				if schedule, ok := info["schedule"]; ok {
+					requestSchedule["schedule"] = schedule
+				}
+				cluster.requestSchedules[s.nextId] = requestSchedule
+				resources = append(resources, object{
+					"href":            s.href("clusters", clusterName, "request_schedules", fmt.Sprintf("%d", s.nextId)),
+					"RequestSchedule": object{"id": s.nextId},
+				})
+			}
+			writeJSON(w, http.StatusCreated, object{"resources": resources})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	requestSchedule, ok := cluster.requestSchedules[id]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Request schedule not found, id=%s", segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.requestSchedule(clusterName, requestSchedule))
+	case http.MethodDelete:
+		// Ambari only disable the request schedule
+		requestSchedule["status"] = "DISABLED"
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// newRequestScheduleBatch return the batch as Ambari return it, from the batch given when the request schedule is created
+func newRequestScheduleBatch(rawBatch interface{}) object {
+
+	requests := make([]object, 0)
+	batch := object{}
+	elements, _ := rawBatch.([]interface{})
+	for _, element := range elements {
+		element, _ := element.(map[string]interface{})
+		if rawRequests, ok := element["requests"].([]interface{}); ok {
+			for _, rawRequest := range rawRequests {
+				rawRequest, _ := rawRequest.(map[string]interface{})
+				request := object{
+					"order_id":     rawRequest["order_id"],
+					"request_type": rawRequest["type"],
+					"request_uri":  rawRequest["uri"],
+				}
+				if requestBody, ok := rawRequest["RequestBodyInfo"]; ok {
+					data, _ := json.Marshal(requestBody)
+					request["request_body"] = string(data)
+				}
+				requests = append(requests, request)
+			}
+		}
+		if settings, ok := element["batch_settings"].(map[string]interface{}); ok {
+			batch["batch_settings"] = object{
+				"batch_separation_in_seconds":  settings["batch_separation_in_seconds"],
+				"task_failure_tolerance_limit": settings["task_failure_tolerance"],
+			}
+		}
+	}
+	batch["batch_requests"] = requests
+
+	return batch
+}
+
+func (s *Server) requestSchedule(clusterName string, requestSchedule object) object {
+	return object{
+		"href":            s.href("clusters", clusterName, "request_schedules", fmt.Sprintf("%d", requestSchedule["id"])),
+		"RequestSchedule": requestSchedule,
+	}
+}
@@ -0,0 +1,520 @@
+// This file permit to serve the users, the groups and their members, and the privileges given on Ambari and on view instances
+// The privileges given on clusters are served with the clusters, but they are also returned by the privileges of users and groups.
+
+package ambaritest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const (
+	PRIVILEGE_TYPE_AMBARI  = "AMBARI"
+	PRIVILEGE_TYPE_CLUSTER = "CLUSTER"
+	PRIVILEGE_TYPE_VIEW    = "VIEW"
+)
+
+// SetServerVersion permit to set the Ambari version returned by the server, like 2.6.2.0
+// The users API of Ambari 2.7 is only served when the version is not set or is 2.7 and later
+func (s *Server) SetServerVersion(version string) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.version = version
+}
+
+// AddUser permit to create user without to call the API
+// The userType is LOCAL for the users managed by Ambari, or LDAP for the synchronized users
+func (s *Server) AddUser(userName string, userType string, active bool) {
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.users[userName] = object{
+		"user_name": userName,
+		"active":    active,
+		"admin":     false,
+		"ldap_user": userType == "LDAP",
+		"user_type": userType,
+	}
+}
+
+// AddGroup permit to create local group with its members without to call the API
+// The members must be added before with AddUser
+func (s *Server) AddGroup(groupName string, userNames ...string) {
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.addGroup(groupName)
+	for _, userName := range userNames {
+		if _, ok := s.users[userName]; !ok {
+			panic(fmt.Sprintf("User %s not found", userName))
+		}
+		s.members[groupName][userName] = true
+	}
+}
+
+// AddAmbariPrivilege permit to give permission on Ambari, like AMBARI.ADMINISTRATOR
+// It return the privilege ID
+func (s *Server) AddAmbariPrivilege(permissionName string, principalName string, principalType string) int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.addScopedPrivilege(object{"type": PRIVILEGE_TYPE_AMBARI}, permissionName, principalName, principalType)
+}
+
+// AddClusterPrivilege permit to give permission on cluster, like CLUSTER.OPERATOR
+// It return the privilege ID
+func (s *Server) AddClusterPrivilege(clusterName string, permissionName string, principalName string, principalType string) int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	s.nextId++
+	cluster.privileges[s.nextId] = object{
+		"privilege_id":    s.nextId,
+		"permission_name": permissionName,
+		"principal_name":  principalName,
+		"principal_type":  principalType,
+		"type":            PRIVILEGE_TYPE_CLUSTER,
+	}
+
+	return s.nextId
+}
+
+// AddViewPrivilege permit to give permission on view instance, like VIEW.USER
+// It return the privilege ID
+func (s *Server) AddViewPrivilege(viewName string, version string, instanceName string, permissionName string, principalName string, principalType string) int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.addScopedPrivilege(viewScope(viewName, version, instanceName), permissionName, principalName, principalType)
+}
+
+func (s *Server) serveUsers(w http.ResponseWriter, r *http.Request, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]object, 0, len(s.users))
+			for _, userName := range sortedKeys(s.users) {
+				items = append(items, s.user(userName))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			info, _ := body["Users"].(map[string]interface{})
+			if err := s.checkUserProperties(info); err != "" {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+			userName, _ := info["user_name"].(string)
+			if userName == "" {
+				writeError(w, http.StatusBadRequest, "Invalid Request: Users/user_name is required")
+				return
+			}
+			s.createUser(w, userName, info)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	userName := segments[0]
+	user, ok := s.users[userName]
+	if !ok && !(len(segments) == 1 && r.Method == http.MethodPost) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: User not found, userName=%s", userName))
+		return
+	}
+	if len(segments) == 2 && segments[1] == "privileges" && r.Method == http.MethodGet {
+		writeItems(w, r, s.principalPrivileges(userName, "USER"), predicates)
+		return
+	}
+	if len(segments) > 1 {
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+		return
+	}
+
+	info, _ := body["Users"].(map[string]interface{})
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		if err := s.checkUserProperties(info); err != "" {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.user(userName))
+	case http.MethodPost:
+		s.createUser(w, userName, info)
+	case http.MethodPut:
+		for _, key := range []string{"active", "admin"} {
+			if value, ok := info[key].(bool); ok {
+				user[key] = value
+			}
+		}
+	case http.MethodDelete:
+		delete(s.users, userName)
+		for _, members := range s.members {
+			delete(members, userName)
+		}
+		s.deletePrincipalPrivileges(userName, "USER")
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) serveGroups(w http.ResponseWriter, r *http.Request, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]object, 0, len(s.groups))
+			for _, groupName := range sortedKeys(s.groups) {
+				items = append(items, s.group(groupName))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			info, _ := body["Groups"].(map[string]interface{})
+			groupName, _ := info["group_name"].(string)
+			if groupName == "" {
+				writeError(w, http.StatusBadRequest, "Invalid Request: Groups/group_name is required")
+				return
+			}
+			if _, ok := s.groups[groupName]; ok {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a Group which already exists, groupName=%s", groupName))
+				return
+			}
+			s.addGroup(groupName)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	groupName := segments[0]
+	if _, ok := s.groups[groupName]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Group not found, groupName=%s", groupName))
+		return
+	}
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.group(groupName))
+	case len(segments) == 1 && r.Method == http.MethodDelete:
+		delete(s.groups, groupName)
+		delete(s.members, groupName)
+		s.deletePrincipalPrivileges(groupName, "GROUP")
+	case len(segments) == 2 && segments[1] == "privileges" && r.Method == http.MethodGet:
+		writeItems(w, r, s.principalPrivileges(groupName, "GROUP"), predicates)
+	case len(segments) == 2 && segments[1] == "members" && r.Method == http.MethodGet:
+		writeItems(w, r, s.groupMembers(groupName), predicates)
+	case len(segments) == 2 && segments[1] == "members" && r.Method == http.MethodPost:
+		info, _ := body["MemberInfo"].(map[string]interface{})
+		userName, _ := info["user_name"].(string)
+		if _, ok := s.users[userName]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: User not found, userName=%s", userName))
+			return
+		}
+		s.members[groupName][userName] = true
+		w.WriteHeader(http.StatusCreated)
+	case len(segments) == 3 && segments[1] == "members" && r.Method == http.MethodDelete:
+		if !s.members[groupName][segments[2]] {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Member not found, userName=%s", segments[2]))
+			return
+		}
+		delete(s.members[groupName], segments[2])
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+	}
+}
+
+// serveScopedPrivileges serve the privileges given on Ambari or on view instance
+func (s *Server) serveScopedPrivileges(w http.ResponseWriter, r *http.Request, scope object, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]object, 0)
+			for _, id := range s.privilegeIds() {
+				if matchScope(s.privileges[id], scope) {
+					items = append(items, s.scopedPrivilege(s.privileges[id]))
+				}
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			info, ok := body["PrivilegeInfo"].(map[string]interface{})
+			if !ok {
+				writeError(w, http.StatusBadRequest, "Invalid Request: PrivilegeInfo is required")
+				return
+			}
+			for _, privilege := range s.privileges {
+				if matchScope(privilege, scope) && privilege["permission_name"] == info["permission_name"] && privilege["principal_name"] == info["principal_name"] && privilege["principal_type"] == info["principal_type"] {
+					writeError(w, http.StatusConflict, "Attempted to create a privilege which already exists")
+					return
+				}
+			}
+			permissionName, _ := info["permission_name"].(string)
+			principalName, _ := info["principal_name"].(string)
+			principalType, _ := info["principal_type"].(string)
+			s.addScopedPrivilege(scope, permissionName, principalName, principalType)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	privilege, ok := s.privileges[id]
+	if !ok || !matchScope(privilege, scope) || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Privilege not found, privilegeId=%s", segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.scopedPrivilege(privilege))
+	case http.MethodDelete:
+		delete(s.privileges, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// serveServerInfo serve the Ambari server component, with the version set by SetServerVersion
+func (s *Server) serveServerInfo(w http.ResponseWriter, r *http.Request) {
+
+	if s.version == "" {
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+		return
+	}
+	writeJSON(w, http.StatusOK, object{
+		"href": s.href("services", "AMBARI", "components", "AMBARI_SERVER"),
+		"RootServiceComponents": object{
+			"service_name":      "AMBARI",
+			"component_name":    "AMBARI_SERVER",
+			"component_version": s.version,
+		},
+	})
+}
+
+func (s *Server) createUser(w http.ResponseWriter, userName string, info map[string]interface{}) {
+
+	if _, ok := s.users[userName]; ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a User which already exists, userName=%s", userName))
+		return
+	}
+	user := object{
+		"user_name": userName,
+		"active":    true,
+		"admin":     false,
+		"ldap_user": false,
+		"user_type": "LOCAL",
+	}
+	for _, key := range []string{"active", "admin"} {
+		if value, ok := info[key].(bool); ok {
+			user[key] = value
+		}
+	}
+	for _, key := range []string{"display_name", "local_user_name"} {
+		if value, ok := info[key].(string); ok && value != "" {
+			user[key] = value
+		}
+	}
+	s.users[userName] = user
+	w.WriteHeader(http.StatusCreated)
+}
+
+// checkUserProperties return error message when the properties of Ambari 2.7 are sent to older Ambari
+func (s *Server) checkUserProperties(info map[string]interface{}) string {
+
+	if s.supportsUserAPIV2() {
+		return ""
+	}
+	for _, key := range []string{"user_name", "display_name", "local_user_name"} {
+		if _, ok := info[key]; ok {
+			return fmt.Sprintf("The properties [Users/%s] specified in the request or predicate are not supported for the resource type User.", key)
+		}
+	}
+
+	return ""
+}
+
+// supportsUserAPIV2 return true if the server version is not set or is 2.7 and later
+func (s *Server) supportsUserAPIV2() bool {
+
+	if s.version == "" {
+		return true
+	}
+	var major, minor int
+	fmt.Sscanf(s.version, "%d.%d", &major, &minor)
+
+	return major > 2 || (major == 2 && minor >= 7)
+}
+
+func (s *Server) addGroup(groupName string) {
+	if _, ok := s.groups[groupName]; !ok {
+		s.groups[groupName] = object{
+			"group_name": groupName,
+			"ldap_group": false,
+			"group_type": "LOCAL",
+		}
+		s.members[groupName] = map[string]bool{}
+	}
+}
+
+func (s *Server) addScopedPrivilege(scope object, permissionName string, principalName string, principalType string) int64 {
+
+	s.nextId++
+	privilege := object{
+		"privilege_id":    s.nextId,
+		"permission_name": permissionName,
+		"principal_name":  principalName,
+		"principal_type":  principalType,
+	}
+	for key, value := range scope {
+		privilege[key] = value
+	}
+	s.privileges[s.nextId] = privilege
+
+	return s.nextId
+}
+
+// principalPrivileges return the privileges given to the user or the group, on Ambari, on clusters and on view instances
+func (s *Server) principalPrivileges(principalName string, principalType string) []object {
+
+	items := make([]object, 0)
+	for _, id := range s.privilegeIds() {
+		privilege := s.privileges[id]
+		if privilege["principal_name"] == principalName && privilege["principal_type"] == principalType {
+			items = append(items, s.scopedPrivilege(privilege))
+		}
+	}
+	for _, clusterName := range s.clusterNames() {
+		for _, privilege := range s.clusters[clusterName].privileges {
+			if privilege["principal_name"] == principalName && privilege["principal_type"] == principalType {
+				items = append(items, s.privilege(clusterName, privilege))
+			}
+		}
+	}
+
+	return items
+}
+
+func (s *Server) deletePrincipalPrivileges(principalName string, principalType string) {
+	for id, privilege := range s.privileges {
+		if privilege["principal_name"] == principalName && privilege["principal_type"] == principalType {
+			delete(s.privileges, id)
+		}
+	}
+	for _, cluster := range s.clusters {
+		for id, privilege := range cluster.privileges {
+			if privilege["principal_name"] == principalName && privilege["principal_type"] == principalType {
+				delete(cluster.privileges, id)
+			}
+		}
+	}
+}
+
+func (s *Server) privilegeIds() []int64 {
+	ids := make([]int64, 0, len(s.privileges))
+	for id := range s.privileges {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+func (s *Server) clusterNames() []string {
+	names := make([]string, 0, len(s.clusters))
+	for name := range s.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (s *Server) user(userName string) object {
+	return object{
+		"href":  s.href("users", userName),
+		"Users": s.users[userName],
+	}
+}
+
+func (s *Server) group(groupName string) object {
+	return object{
+		"href":    s.href("groups", groupName),
+		"Groups":  s.groups[groupName],
+		"members": s.groupMembers(groupName),
+	}
+}
+
+func (s *Server) groupMembers(groupName string) []object {
+	userNames := make([]string, 0, len(s.members[groupName]))
+	for userName := range s.members[groupName] {
+		userNames = append(userNames, userName)
+	}
+	sort.Strings(userNames)
+	members := make([]object, 0, len(userNames))
+	for _, userName := range userNames {
+		members = append(members, object{
+			"href":       s.href("groups", groupName, "members", userName),
+			"MemberInfo": object{"group_name": groupName, "user_name": userName},
+		})
+	}
+
+	return members
+}
+
+func (s *Server) scopedPrivilege(privilege object) object {
+	info := object{}
+	for key, value := range privilege {
+		info[key] = value
+	}
+	href := s.href("privileges", fmt.Sprintf("%d", privilege["privilege_id"]))
+	if privilege["type"] == PRIVILEGE_TYPE_VIEW {
+		href = s.href("views", privilege["view_name"].(string), "versions", privilege["version"].(string), "instances", privilege["instance_name"].(string), "privileges", fmt.Sprintf("%d", privilege["privilege_id"]))
+	}
+
+	return object{
+		"href":          href,
+		"PrivilegeInfo": info,
+	}
+}
+
+func viewScope(viewName string, version string, instanceName string) object {
+	return object{
+		"type":          PRIVILEGE_TYPE_VIEW,
+		"view_name":     viewName,
+		"version":       version,
+		"instance_name": instanceName,
+	}
+}
+
+// matchScope return true if the privilege is given on the scope
+func matchScope(privilege object, scope object) bool {
+	for key, value := range scope {
+		if privilege[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
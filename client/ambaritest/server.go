@@ -0,0 +1,1235 @@
+// This file permit to run fake Ambari server in memory, to test the code that use the client without real Ambari
+// It implement the API used by the client for the clusters, the blueprints, the configurations, the config groups, the privileges, the hosts, the components,
+// the requests and their tasks, the upgrades and the request schedules, and for the users, the groups, the alert targets, the settings and the services of stacks.
+
+package ambaritest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	API_PATH = "/api/v1"
+)
+
+// object is Ambari resource, like the content of Clusters or PrivilegeInfo
+type object map[string]interface{}
+
+// Server is fake Ambari server
+// Use NewServer to start it and Close to stop it. The client must use BaseURL().
+type Server struct {
+	*httptest.Server
+	mutex        sync.Mutex
+	clusters     map[string]*cluster
+	hosts        map[string]object
+	blueprints   map[string]object
+	users        map[string]object
+	groups       map[string]object
+	members      map[string]map[string]bool
+	privileges   map[int64]object
+	alertTargets map[int64]object
+	settings     map[string]object
+	// stackServices are the services by stack version, like HDP/2.6
+	stackServices map[string]map[string]object
+	version       string
+	nextId        int64
+}
+
+type cluster struct {
+	info           object
+	configurations []object
+	desiredConfigs map[string]object
+	privileges     map[int64]object
+	hosts          map[string]bool
+	requests       map[int64]object
+	components     map[string]object
+	hostComponents map[string]map[string]object
+	configGroups   map[int64]object
+	// tasks are the tasks by request ID
+	tasks            map[int64]map[int64]object
+	upgrades         map[int64]*upgrade
+	requestSchedules map[int64]object
+}
+
+// NewServer permit to start new fake Ambari server without resources
+// It return the server
+func NewServer() *Server {
+	s := &Server{
+		clusters:      map[string]*cluster{},
+		hosts:         map[string]object{},
+		blueprints:    map[string]object{},
+		users:         map[string]object{},
+		groups:        map[string]object{},
+		members:       map[string]map[string]bool{},
+		privileges:    map[int64]object{},
+		alertTargets:  map[int64]object{},
+		settings:      map[string]object{},
+		stackServices: map[string]map[string]object{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// BaseURL return the URL to give to the client
+func (s *Server) BaseURL() string {
+	return s.URL + API_PATH
+}
+
+// AddCluster permit to create cluster without to call the API
+func (s *Server) AddCluster(clusterName string, version string) {
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.addCluster(object{
+		"cluster_name": clusterName,
+		"version":      version,
+	})
+}
+
+// AddConfiguration permit to add configuration version on cluster, that become the desired one for its type
+func (s *Server) AddConfiguration(clusterName string, configurationType string, tag string, properties map[string]string) {
+	if configurationType == "" || tag == "" {
+		panic("ConfigurationType and Tag can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	rawProperties := map[string]interface{}{}
+	for key, value := range properties {
+		rawProperties[key] = value
+	}
+	s.addConfiguration(clusterName, cluster, object{"type": configurationType, "tag": tag, "properties": rawProperties})
+}
+
+// AddHost permit to register host on Ambari, like the Ambari agent do
+// When clusterNames is set, the host is added on the clusters
+func (s *Server) AddHost(hostname string, clusterNames ...string) {
+	if hostname == "" {
+		panic("Hostname can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.addHost(hostname)
+	for _, clusterName := range clusterNames {
+		cluster, ok := s.clusters[clusterName]
+		if !ok {
+			panic(fmt.Sprintf("Cluster %s not found", clusterName))
+		}
+		cluster.hosts[hostname] = true
+	}
+}
+
+// SetHostStatus permit to change the status of host, like HEALTHY, UNHEALTHY or UNKNOWN when Ambari agent not send heartbeat
+func (s *Server) SetHostStatus(hostname string, status string) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	host, ok := s.hosts[hostname]
+	if !ok {
+		panic(fmt.Sprintf("Host %s not found", hostname))
+	}
+	host["host_status"] = status
+	if status == "UNKNOWN" {
+		host["host_state"] = "HEARTBEAT_LOST"
+	} else {
+		host["host_state"] = "HEALTHY"
+	}
+}
+
+// AddComponent permit to add component of service on cluster, like NAMENODE in HDFS with MASTER category
+func (s *Server) AddComponent(clusterName string, serviceName string, componentName string, category string) {
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	cluster.components[componentName] = object{
+		"cluster_name":   clusterName,
+		"service_name":   serviceName,
+		"component_name": componentName,
+		"category":       category,
+	}
+}
+
+// AddHostComponent permit to install component on host of cluster, with the state like STARTED or INSTALLED
+// The component must be added before with AddComponent
+func (s *Server) AddHostComponent(clusterName string, hostname string, componentName string, state string) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	if !cluster.hosts[hostname] {
+		panic(fmt.Sprintf("Host %s not found in cluster %s", hostname, clusterName))
+	}
+	component, ok := cluster.components[componentName]
+	if !ok {
+		panic(fmt.Sprintf("Component %s not found", componentName))
+	}
+	s.addHostComponent(cluster, hostname, component, state)
+}
+
+// AddBlueprint permit to create blueprint without to call the API
+func (s *Server) AddBlueprint(blueprintName string, jsonBlueprint string) {
+	if blueprintName == "" {
+		panic("BlueprintName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	blueprint := object{}
+	if err := json.Unmarshal([]byte(jsonBlueprint), &blueprint); err != nil {
+		panic(err)
+	}
+	info, _ := blueprint["Blueprints"].(map[string]interface{})
+	if info == nil {
+		info = map[string]interface{}{}
+		blueprint["Blueprints"] = info
+	}
+	info["blueprint_name"] = blueprintName
+	blueprint["href"] = s.href("blueprints", blueprintName)
+	s.blueprints[blueprintName] = blueprint
+}
+
+// AddRequest permit to create request on cluster, like Ambari do when it start service
+// It return the request ID
+func (s *Server) AddRequest(clusterName string, context string, status string) int64 {
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	request := s.addRequest(clusterName, cluster, context)
+	setRequestStatus(request, status)
+
+	return request["id"].(int64)
+}
+
+// SetRequestStatus permit to change the status of request, like IN_PROGRESS or COMPLETED
+// The progress is 100% when the status is final
+func (s *Server) SetRequestStatus(clusterName string, id int64, status string) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	request, ok := cluster.requests[id]
+	if !ok {
+		panic(fmt.Sprintf("Request %d not found", id))
+	}
+	setRequestStatus(request, status)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if r.Header.Get("X-Requested-By") == "" && r.Method != http.MethodGet {
+		writeError(w, http.StatusBadRequest, "CSRF protection is turned on. X-Requested-By HTTP header is required.")
+		return
+	}
+	predicates, err := parsePredicates(r.URL.RawQuery)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The body is an object, except for some resources that are created by list, like the config groups
+	var rawBody interface{}
+	var body object
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &rawBody); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid Request: Malformed Request Body. %s", err.Error()))
+				return
+			}
+		}
+		if rawObject, ok := rawBody.(map[string]interface{}); ok {
+			body = object(rawObject)
+		}
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, API_PATH), "/"), "/")
+	switch {
+	case len(segments) == 1 && segments[0] == "clusters":
+		s.serveClusters(w, r, predicates)
+	case len(segments) >= 2 && segments[0] == "clusters":
+		s.serveCluster(w, r, segments[1], segments[2:], rawBody, predicates)
+	case len(segments) == 2 && segments[0] == "blueprints":
+		s.serveBlueprint(w, r, segments[1], body)
+	case segments[0] == "users":
+		s.serveUsers(w, r, segments[1:], body, predicates)
+	case segments[0] == "groups":
+		s.serveGroups(w, r, segments[1:], body, predicates)
+	case len(segments) == 5 && segments[0] == "stacks" && segments[2] == "versions" && segments[4] == "services":
+		s.serveStackServices(w, r, segments[1], segments[3], predicates)
+	case segments[0] == "settings":
+		s.serveSettings(w, r, segments[1:], body, predicates)
+	case segments[0] == "alert_targets":
+		s.serveAlertTargets(w, r, segments[1:], body, predicates)
+	case segments[0] == "privileges":
+		s.serveScopedPrivileges(w, r, object{"type": PRIVILEGE_TYPE_AMBARI}, segments[1:], body, predicates)
+	case len(segments) >= 7 && segments[0] == "views" && segments[2] == "versions" && segments[4] == "instances" && segments[6] == "privileges":
+		s.serveScopedPrivileges(w, r, viewScope(segments[1], segments[3], segments[5]), segments[7:], body, predicates)
+	case len(segments) == 4 && segments[0] == "services" && segments[1] == "AMBARI" && segments[2] == "components" && segments[3] == "AMBARI_SERVER" && r.Method == http.MethodGet:
+		s.serveServerInfo(w, r)
+	case len(segments) == 1 && segments[0] == "hosts" && r.Method == http.MethodGet:
+		items := make([]object, 0, len(s.hosts))
+		for _, hostname := range sortedKeys(s.hosts) {
+			items = append(items, s.host(hostname, ""))
+		}
+		writeItems(w, r, items, predicates)
+	case len(segments) == 2 && segments[0] == "hosts" && r.Method == http.MethodGet:
+		if _, ok := s.hosts[segments[1]]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Host not found, hostName=%s", segments[1]))
+			return
+		}
+		writeJSON(w, http.StatusOK, s.host(segments[1], ""))
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+	}
+}
+
+func (s *Server) serveClusters(w http.ResponseWriter, r *http.Request, predicates []predicate) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	names := make([]string, 0, len(s.clusters))
+	for name := range s.clusters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	items := make([]object, 0, len(names))
+	for _, name := range names {
+		items = append(items, object{
+			"href":     s.href("clusters", name),
+			"Clusters": object{"cluster_name": name, "version": s.clusters[name].info["version"]},
+		})
+	}
+	writeItems(w, r, items, predicates)
+}
+
+func (s *Server) serveBlueprint(w http.ResponseWriter, r *http.Request, blueprintName string, body object) {
+
+	blueprint, ok := s.blueprints[blueprintName]
+	switch r.Method {
+	case http.MethodGet:
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Blueprint not found, blueprintName=%s", blueprintName))
+			return
+		}
+		writeJSON(w, http.StatusOK, blueprint)
+	case http.MethodPost:
+		if ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a Blueprint which already exists, blueprint_name=%s", blueprintName))
+			return
+		}
+		info, _ := body["Blueprints"].(map[string]interface{})
+		if info == nil {
+			info = map[string]interface{}{}
+			body["Blueprints"] = info
+		}
+		info["blueprint_name"] = blueprintName
+		body["href"] = s.href("blueprints", blueprintName)
+		s.blueprints[blueprintName] = body
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Blueprint not found, blueprintName=%s", blueprintName))
+			return
+		}
+		delete(s.blueprints, blueprintName)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) serveCluster(w http.ResponseWriter, r *http.Request, clusterName string, segments []string, rawBody interface{}, predicates []predicate) {
+
+	body, _ := rawBody.(map[string]interface{})
+
+	cluster, ok := s.clusters[clusterName]
+	if len(segments) == 0 && r.Method == http.MethodPost {
+		if ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a Cluster which already exists, clusterName=%s", clusterName))
+			return
+		}
+		s.createCluster(w, clusterName, body)
+		return
+	}
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Cluster not found, clusterName=%s", clusterName))
+		return
+	}
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, s.cluster(clusterName))
+		case http.MethodPut:
+			if directive := r.URL.Query().Get("regenerate_keytabs"); directive != "" {
+				s.regenerateKeytabs(w, clusterName, cluster, directive)
+				return
+			}
+			s.updateCluster(w, clusterName, cluster, body)
+		case http.MethodDelete:
+			delete(s.clusters, clusterName)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	switch segments[0] {
+	case "configurations":
+		s.serveConfigurations(w, r, clusterName, cluster, segments[1:], predicates)
+	case "privileges":
+		s.servePrivileges(w, r, clusterName, cluster, segments[1:], body, predicates)
+	case "hosts":
+		s.serveClusterHosts(w, r, clusterName, cluster, segments[1:], body, predicates)
+	case "requests":
+		s.serveRequests(w, r, clusterName, cluster, segments[1:], body, predicates)
+	case "services":
+		s.serveServices(w, r, clusterName, cluster, segments[1:], predicates)
+	case "config_groups":
+		s.serveConfigGroups(w, r, clusterName, cluster, segments[1:], rawBody, predicates)
+	case "upgrades":
+		s.serveUpgrades(w, r, clusterName, cluster, segments[1:], body, predicates)
+	case "request_schedules":
+		s.serveRequestSchedules(w, r, clusterName, cluster, segments[1:], rawBody, predicates)
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+	}
+}
+
+// createCluster create the cluster from its Clusters object or from cluster template (blueprint)
+func (s *Server) createCluster(w http.ResponseWriter, clusterName string, body object) {
+
+	if info, ok := body["Clusters"].(map[string]interface{}); ok {
+		info["cluster_name"] = clusterName
+		s.addCluster(object(info))
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	blueprintName, _ := body["blueprint"].(string)
+	if blueprintName == "" {
+		writeError(w, http.StatusBadRequest, "Invalid Request: Cluster template must have blueprint")
+		return
+	}
+	if _, ok := s.blueprints[blueprintName]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid Request: The specified blueprint doesn't exist: %s", blueprintName))
+		return
+	}
+
+	cluster := s.addCluster(object{"cluster_name": clusterName})
+	hostGroups, _ := body["host_groups"].([]interface{})
+	for _, hostGroup := range hostGroups {
+		hosts, _ := hostGroup.(map[string]interface{})["hosts"].([]interface{})
+		for _, host := range hosts {
+			if hostname, ok := host.(map[string]interface{})["fqdn"].(string); ok {
+				s.addHost(hostname)
+				cluster.hosts[hostname] = true
+			}
+		}
+	}
+	request := s.addRequest(clusterName, cluster, "Logical Request: Provision Cluster '"+clusterName+"'")
+	setRequestStatus(request, "COMPLETED")
+	writeJSON(w, http.StatusAccepted, s.requestReference(clusterName, request))
+}
+
+// updateCluster permit to rename cluster, change the desired configurations or the security type
+func (s *Server) updateCluster(w http.ResponseWriter, clusterName string, cluster *cluster, body object) {
+
+	_, isRequest := body["RequestInfo"]
+	if requestBody, ok := body["Body"].(map[string]interface{}); ok {
+		body = object(requestBody)
+	}
+	info, _ := body["Clusters"].(map[string]interface{})
+
+	switch desiredConfigs := info["desired_config"].(type) {
+	case map[string]interface{}:
+		s.addConfiguration(clusterName, cluster, object(desiredConfigs))
+	case []interface{}:
+		for _, desiredConfig := range desiredConfigs {
+			if configuration, ok := desiredConfig.(map[string]interface{}); ok {
+				s.addConfiguration(clusterName, cluster, object(configuration))
+			}
+		}
+	}
+
+	if newName, ok := info["cluster_name"].(string); ok && newName != "" && newName != clusterName {
+		if _, exist := s.clusters[newName]; exist {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Cluster %s already exists", newName))
+			return
+		}
+		delete(s.clusters, clusterName)
+		cluster.info["cluster_name"] = newName
+		s.clusters[newName] = cluster
+		clusterName = newName
+	}
+
+	if securityType, ok := info["security_type"].(string); ok && securityType != "" && securityType != cluster.info["security_type"] {
+		cluster.info["security_type"] = securityType
+		if isRequest {
+			request := s.addRequest(clusterName, cluster, "Update security type to "+securityType)
+			setRequestStatus(request, "COMPLETED")
+			writeJSON(w, http.StatusAccepted, s.requestReference(clusterName, request))
+		}
+	}
+}
+
+// regenerateKeytabs create the request that regenerate the keytabs, only when kerberos is enabled
+func (s *Server) regenerateKeytabs(w http.ResponseWriter, clusterName string, cluster *cluster, directive string) {
+
+	if cluster.info["security_type"] != "KERBEROS" {
+		writeError(w, http.StatusBadRequest, "Invalid Request: Cannot regenerate keytabs: Kerberos is not enabled")
+		return
+	}
+	if directive != "all" && directive != "missing" {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid Request: Unexpected value for regenerate_keytabs: %s", directive))
+		return
+	}
+	request := s.addRequest(clusterName, cluster, "Regenerate keytabs")
+	setRequestStatus(request, "COMPLETED")
+	writeJSON(w, http.StatusAccepted, s.requestReference(clusterName, request))
+}
+
+func (s *Server) serveConfigurations(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, predicates []predicate) {
+	if len(segments) > 0 || r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	items := make([]object, 0, len(cluster.configurations))
+	for _, configuration := range cluster.configurations {
+		item := object{"href": s.href("clusters", clusterName, "configurations?type="+configuration["type"].(string)+"&tag="+configuration["tag"].(string))}
+		for key, value := range configuration {
+			item[key] = value
+		}
+		items = append(items, item)
+	}
+	writeItems(w, r, items, predicates)
+}
+
+func (s *Server) servePrivileges(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]int64, 0, len(cluster.privileges))
+			for id := range cluster.privileges {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			items := make([]object, 0, len(ids))
+			for _, id := range ids {
+				items = append(items, s.privilege(clusterName, cluster.privileges[id]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			info, ok := body["PrivilegeInfo"].(map[string]interface{})
+			if !ok {
+				writeError(w, http.StatusBadRequest, "Invalid Request: PrivilegeInfo is required")
+				return
+			}
+			for _, privilege := range cluster.privileges {
+				if privilege["permission_name"] == info["permission_name"] && privilege["principal_name"] == info["principal_name"] && privilege["principal_type"] == info["principal_type"] {
+					writeError(w, http.StatusConflict, "Attempted to create a privilege which already exists")
+					return
+				}
+			}
+			s.nextId++
+			cluster.privileges[s.nextId] = object{
+				"privilege_id":    s.nextId,
+				"permission_name": info["permission_name"],
+				"principal_name":  info["principal_name"],
+				"principal_type":  info["principal_type"],
+				"type":            "CLUSTER",
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	privilege, ok := cluster.privileges[id]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Privilege not found, privilegeId=%s", segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.privilege(clusterName, privilege))
+	case http.MethodPut:
+		if info, ok := body["PrivilegeInfo"].(map[string]interface{}); ok {
+			for _, key := range []string{"permission_name", "principal_name", "principal_type"} {
+				if value, ok := info[key]; ok {
+					privilege[key] = value
+				}
+			}
+		}
+	case http.MethodDelete:
+		delete(cluster.privileges, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) serveClusterHosts(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		hostnames := make([]string, 0, len(cluster.hosts))
+		for hostname := range cluster.hosts {
+			hostnames = append(hostnames, hostname)
+		}
+		sort.Strings(hostnames)
+		items := make([]object, 0, len(hostnames))
+		for _, hostname := range hostnames {
+			items = append(items, s.host(hostname, clusterName))
+		}
+		writeItems(w, r, items, predicates)
+		return
+	}
+
+	hostname := segments[0]
+	if r.Method == http.MethodPost && len(segments) == 1 {
+		if _, ok := s.hosts[hostname]; !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Attempted to add unknown hosts to a cluster.  These hosts have not been registered with the server: %s", hostname))
+			return
+		}
+		if cluster.hosts[hostname] {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a host which already exists: clusterName=%s, hostName=%s", clusterName, hostname))
+			return
+		}
+		cluster.hosts[hostname] = true
+		s.updateHost(hostname, body)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if !cluster.hosts[hostname] {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Host not found, cluster=%s, hostname=%s", clusterName, hostname))
+		return
+	}
+	if len(segments) > 1 {
+		if segments[1] != "host_components" || len(segments) > 3 {
+			writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+			return
+		}
+		s.serveHostComponents(w, r, clusterName, cluster, hostname, segments[2:], body, predicates)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.host(hostname, clusterName))
+	case http.MethodPut:
+		s.updateHost(hostname, body)
+	case http.MethodDelete:
+		delete(cluster.hosts, hostname)
+		delete(cluster.hostComponents, hostname)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// serveServices permit to read the services and their components
+// The services can't be changed, so there are nothing to start or stop at service level
+func (s *Server) serveServices(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, predicates []predicate) {
+
+	if r.Method != http.MethodGet {
+		return
+	}
+
+	services := map[string]object{}
+	for _, componentName := range sortedKeys(cluster.components) {
+		serviceName := cluster.components[componentName]["service_name"].(string)
+		if _, ok := services[serviceName]; !ok {
+			services[serviceName] = object{
+				"href":        s.href("clusters", clusterName, "services", serviceName),
+				"ServiceInfo": object{"cluster_name": clusterName, "service_name": serviceName, "state": s.serviceState(cluster, serviceName), "maintenance_state": "OFF"},
+				"components":  []object{},
+			}
+		}
+		services[serviceName]["components"] = append(services[serviceName]["components"].([]object), object{
+			"href":                 s.href("clusters", clusterName, "services", serviceName, "components", componentName),
+			"ServiceComponentInfo": s.componentInfo(cluster, componentName),
+		})
+	}
+	if len(segments) == 0 {
+		items := make([]object, 0, len(services))
+		for _, serviceName := range sortedKeys(services) {
+			items = append(items, services[serviceName])
+		}
+		writeItems(w, r, items, predicates)
+		return
+	}
+
+	service, ok := services[segments[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Service not found, clusterName=%s, serviceName=%s", clusterName, segments[0]))
+		return
+	}
+	switch {
+	case len(segments) == 1:
+		writeJSON(w, http.StatusOK, service)
+	case len(segments) == 3 && segments[1] == "components":
+		component, ok := cluster.components[segments[2]]
+		if !ok || component["service_name"] != segments[0] {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: ServiceComponent not found, clusterName=%s, serviceName=%s, serviceComponentName=%s", clusterName, segments[0], segments[2]))
+			return
+		}
+		hostnames := make([]string, 0)
+		for hostname, components := range cluster.hostComponents {
+			if _, ok := components[segments[2]]; ok {
+				hostnames = append(hostnames, hostname)
+			}
+		}
+		sort.Strings(hostnames)
+		hostComponents := make([]object, 0, len(hostnames))
+		for _, hostname := range hostnames {
+			hostComponents = append(hostComponents, object{
+				"href":      s.href("clusters", clusterName, "hosts", hostname, "host_components", segments[2]),
+				"HostRoles": object{"cluster_name": clusterName, "host_name": hostname, "component_name": segments[2]},
+			})
+		}
+		writeJSON(w, http.StatusOK, object{
+			"href":                 s.href("clusters", clusterName, "services", segments[0], "components", segments[2]),
+			"ServiceComponentInfo": s.componentInfo(cluster, segments[2]),
+			"host_components":      hostComponents,
+		})
+	default:
+		writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+	}
+}
+
+// serveHostComponents permit to add, start, stop and remove the components on host
+// The changes of state are done at once, with request already completed
+func (s *Server) serveHostComponents(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, hostname string, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		items := make([]object, 0, len(cluster.hostComponents[hostname]))
+		for _, componentName := range sortedKeys(cluster.hostComponents[hostname]) {
+			items = append(items, s.hostComponent(clusterName, hostname, cluster.hostComponents[hostname][componentName]))
+		}
+		writeItems(w, r, items, predicates)
+		return
+	}
+
+	componentName := segments[0]
+	hostComponent, ok := cluster.hostComponents[hostname][componentName]
+	if r.Method == http.MethodPost {
+		component, exist := cluster.components[componentName]
+		if !exist {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("ServiceComponent not found, clusterName=%s, serviceComponentName=%s", clusterName, componentName))
+			return
+		}
+		if ok {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a host_component which already exists: [clusterName=%s, hostName=%s, componentName=%s]", clusterName, hostname, componentName))
+			return
+		}
+		s.addHostComponent(cluster, hostname, component, "INIT")
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: ServiceComponentHost not found, hostName=%s, serviceComponentName=%s", hostname, componentName))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.hostComponent(clusterName, hostname, hostComponent))
+	case http.MethodPut:
+		requestInfo, isRequest := body["RequestInfo"].(map[string]interface{})
+		if requestBody, ok := body["Body"].(map[string]interface{}); ok {
+			body = object(requestBody)
+		}
+		info, _ := body["HostRoles"].(map[string]interface{})
+		state, _ := info["state"].(string)
+		if state == "" || state == hostComponent["state"] {
+			return
+		}
+		hostComponent["state"] = state
+		hostComponent["desired_state"] = state
+		if isRequest {
+			context, _ := requestInfo["context"].(string)
+			request := s.addRequest(clusterName, cluster, context)
+			setRequestStatus(request, "COMPLETED")
+			writeJSON(w, http.StatusAccepted, s.requestReference(clusterName, request))
+		}
+	case http.MethodDelete:
+		if hostComponent["state"] == "STARTED" {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Host Component cannot be removed, clusterName=%s, serviceName=%s, componentName=%s, hostname=%s, request={ desiredState=STARTED }", clusterName, hostComponent["service_name"], componentName, hostname))
+			return
+		}
+		delete(cluster.hostComponents[hostname], componentName)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) serveRequests(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			ids := make([]int64, 0, len(cluster.requests))
+			for id := range cluster.requests {
+				ids = append(ids, id)
+			}
+			sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+			items := make([]object, 0, len(ids))
+			for _, id := range ids {
+				items = append(items, s.request(clusterName, cluster.requests[id]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			context := ""
+			if requestInfo, ok := body["RequestInfo"].(map[string]interface{}); ok {
+				context, _ = requestInfo["context"].(string)
+			}
+			request := s.addRequest(clusterName, cluster, context)
+			setRequestStatus(request, "COMPLETED")
+			writeJSON(w, http.StatusAccepted, s.requestReference(clusterName, request))
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	request, ok := cluster.requests[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Request resource doesn't exist, requestId=%s", segments[0]))
+		return
+	}
+	if len(segments) > 1 {
+		if segments[1] == "tasks" {
+			s.serveTasks(w, r, clusterName, cluster, id, segments[2:], predicates)
+		} else {
+			writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+		}
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.request(clusterName, request))
+	case http.MethodPut:
+		info, _ := body["Requests"].(map[string]interface{})
+		if info["request_status"] == "ABORTED" {
+			if request["progress_percent"] == float64(100) {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("Request %d is already finished", id))
+				return
+			}
+			setRequestStatus(request, "ABORTED")
+			request["abort_reason"] = info["abort_reason"]
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) addCluster(info object) *cluster {
+
+	s.nextId++
+	info["cluster_id"] = s.nextId
+	if _, ok := info["security_type"]; !ok {
+		info["security_type"] = "NONE"
+	}
+	cluster := &cluster{
+		info:             info,
+		configurations:   []object{},
+		desiredConfigs:   map[string]object{},
+		privileges:       map[int64]object{},
+		hosts:            map[string]bool{},
+		requests:         map[int64]object{},
+		components:       map[string]object{},
+		hostComponents:   map[string]map[string]object{},
+		configGroups:     map[int64]object{},
+		tasks:            map[int64]map[int64]object{},
+		upgrades:         map[int64]*upgrade{},
+		requestSchedules: map[int64]object{},
+	}
+	s.clusters[info["cluster_name"].(string)] = cluster
+
+	return cluster
+}
+
+func (s *Server) addHost(hostname string) {
+	if _, ok := s.hosts[hostname]; !ok {
+		s.hosts[hostname] = object{
+			"host_name":           hostname,
+			"host_status":         "HEALTHY",
+			"host_state":          "HEALTHY",
+			"maintenance_state":   "OFF",
+			"rack_info":           "/default-rack",
+			"last_heartbeat_time": float64(time.Now().UnixNano() / int64(time.Millisecond)),
+		}
+	}
+}
+
+// updateHost permit to change the rack and maintenance state of host
+func (s *Server) updateHost(hostname string, body object) {
+	info, _ := body["Hosts"].(map[string]interface{})
+	for _, key := range []string{"maintenance_state", "rack_info"} {
+		if value, ok := info[key].(string); ok && value != "" {
+			s.hosts[hostname][key] = value
+		}
+	}
+}
+
+// addConfiguration add new configuration version and use it as desired configuration
+func (s *Server) addConfiguration(clusterName string, cluster *cluster, configuration object) {
+
+	configurationType, _ := configuration["type"].(string)
+	tag, _ := configuration["tag"].(string)
+	for _, existing := range cluster.configurations {
+		if existing["type"] == configurationType && existing["tag"] == tag {
+			cluster.desiredConfigs[configurationType] = object{"tag": tag, "version": existing["version"]}
+			return
+		}
+	}
+
+	version := int64(1)
+	if desiredConfig, ok := cluster.desiredConfigs[configurationType]; ok {
+		version = desiredConfig["version"].(int64) + 1
+	}
+	newConfiguration := object{
+		"type":    configurationType,
+		"tag":     tag,
+		"version": version,
+	}
+	for _, key := range []string{"properties", "properties_attributes"} {
+		if value, ok := configuration[key]; ok {
+			newConfiguration[key] = value
+		}
+	}
+	cluster.configurations = append(cluster.configurations, newConfiguration)
+	cluster.desiredConfigs[configurationType] = object{"tag": tag, "version": version}
+}
+
+func (s *Server) addHostComponent(cluster *cluster, hostname string, component object, state string) {
+	if _, ok := cluster.hostComponents[hostname]; !ok {
+		cluster.hostComponents[hostname] = map[string]object{}
+	}
+	cluster.hostComponents[hostname][component["component_name"].(string)] = object{
+		"cluster_name":   component["cluster_name"],
+		"service_name":   component["service_name"],
+		"component_name": component["component_name"],
+		"host_name":      hostname,
+		"state":          state,
+		"desired_state":  state,
+	}
+}
+
+// serviceState return STARTED if all the components of service are started, else INSTALLED
+func (s *Server) serviceState(cluster *cluster, serviceName string) string {
+	for _, hostComponents := range cluster.hostComponents {
+		for _, hostComponent := range hostComponents {
+			if hostComponent["service_name"] == serviceName && hostComponent["state"] != "STARTED" && cluster.components[hostComponent["component_name"].(string)]["category"] != "CLIENT" {
+				return "INSTALLED"
+			}
+		}
+	}
+
+	return "STARTED"
+}
+
+// componentInfo return the component with the number of hosts where it's installed and started
+func (s *Server) componentInfo(cluster *cluster, componentName string) object {
+	info := object{}
+	for key, value := range cluster.components[componentName] {
+		info[key] = value
+	}
+	started, installed, total := 0, 0, 0
+	for _, hostComponents := range cluster.hostComponents {
+		if hostComponent, ok := hostComponents[componentName]; ok {
+			total++
+			switch hostComponent["state"] {
+			case "STARTED":
+				started++
+			case "INSTALLED":
+				installed++
+			}
+		}
+	}
+	info["started_count"] = started
+	info["installed_count"] = installed
+	info["total_count"] = total
+
+	return info
+}
+
+func (s *Server) addRequest(clusterName string, cluster *cluster, context string) object {
+
+	s.nextId++
+	request := object{
+		"id":              s.nextId,
+		"cluster_name":    clusterName,
+		"request_context": context,
+	}
+	cluster.requests[s.nextId] = request
+
+	return request
+}
+
+// setRequestStatus change the status and the progress of request
+func setRequestStatus(request object, status string) {
+	request["request_status"] = status
+	switch status {
+	case "COMPLETED", "FAILED", "ABORTED", "TIMEDOUT":
+		request["progress_percent"] = float64(100)
+	default:
+		request["progress_percent"] = float64(0)
+	}
+}
+
+func (s *Server) cluster(clusterName string) object {
+	cluster := s.clusters[clusterName]
+	info := object{}
+	for key, value := range cluster.info {
+		info[key] = value
+	}
+	info["desired_configs"] = cluster.desiredConfigs
+
+	return object{
+		"href":     s.href("clusters", clusterName),
+		"Clusters": info,
+		"services": []object{},
+	}
+}
+
+func (s *Server) hostComponent(clusterName string, hostname string, hostComponent object) object {
+	return object{
+		"href":      s.href("clusters", clusterName, "hosts", hostname, "host_components", hostComponent["component_name"].(string)),
+		"HostRoles": hostComponent,
+	}
+}
+
+func (s *Server) host(hostname string, clusterName string) object {
+	info := object{}
+	for key, value := range s.hosts[hostname] {
+		info[key] = value
+	}
+	href := s.href("hosts", hostname)
+	hostComponents := make([]object, 0)
+	if clusterName != "" {
+		info["cluster_name"] = clusterName
+		href = s.href("clusters", clusterName, "hosts", hostname)
+		if cluster, ok := s.clusters[clusterName]; ok {
+			for _, componentName := range sortedKeys(cluster.hostComponents[hostname]) {
+				hostComponents = append(hostComponents, s.hostComponent(clusterName, hostname, cluster.hostComponents[hostname][componentName]))
+			}
+		}
+	}
+
+	return object{
+		"href":            href,
+		"Hosts":           info,
+		"host_components": hostComponents,
+	}
+}
+
+func (s *Server) privilege(clusterName string, privilege object) object {
+	info := object{"cluster_name": clusterName}
+	for key, value := range privilege {
+		info[key] = value
+	}
+
+	return object{
+		"href":          s.href("clusters", clusterName, "privileges", fmt.Sprintf("%d", privilege["privilege_id"])),
+		"PrivilegeInfo": info,
+	}
+}
+
+func (s *Server) request(clusterName string, request object) object {
+	info := object{}
+	for key, value := range request {
+		info[key] = value
+	}
+	info["cluster_name"] = clusterName
+
+	return object{
+		"href":     s.href("clusters", clusterName, "requests", fmt.Sprintf("%d", request["id"])),
+		"Requests": info,
+	}
+}
+
+// requestReference is the body return by Ambari when it create request
+func (s *Server) requestReference(clusterName string, request object) object {
+	return object{
+		"href": s.href("clusters", clusterName, "requests", fmt.Sprintf("%d", request["id"])),
+		"Requests": object{
+			"id":     request["id"],
+			"status": "Accepted",
+		},
+	}
+}
+
+func (s *Server) href(segments ...string) string {
+	return s.BaseURL() + "/" + strings.Join(segments, "/")
+}
+
+func sortedKeys(objects map[string]object) []string {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(data)
+}
+
+// writeError write the error like Ambari do
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{
+		"status":  status,
+		"message": message,
+	})
+}
+
+// writeItems write the items that match the predicates
+func writeItems(w http.ResponseWriter, r *http.Request, items []object, predicates []predicate) {
+
+	matchedItems := make([]object, 0, len(items))
+	for _, item := range items {
+		if matchPredicates(item, predicates) {
+			matchedItems = append(matchedItems, item)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, object{
+		"href":  "http://" + r.Host + r.URL.String(),
+		"items": matchedItems,
+	})
+}
+
+// predicate is field compared to value, like PrivilegeInfo/principal_name=admin
+type predicate struct {
+	field    []string
+	value    string
+	notEqual bool
+}
+
+// parsePredicates read the predicates from the query string
+// Only the equality of fields joined with & is supported
+func parsePredicates(rawQuery string) ([]predicate, error) {
+
+	predicates := make([]predicate, 0)
+	if rawQuery == "" {
+		return predicates, nil
+	}
+	for _, part := range strings.Split(rawQuery, "&") {
+		expression, err := url.QueryUnescape(part)
+		if err != nil {
+			return nil, err
+		}
+		index := strings.Index(expression, "=")
+		if index < 0 {
+			return nil, fmt.Errorf("Invalid Request: Unsupported predicate %s", expression)
+		}
+		key := expression[:index]
+		switch key {
+		case "fields", "page_size", "from", "to", "sortBy", "minimal_response", "format",
+			"regenerate_keytabs", "regenerate_hosts", "regenerate_components", "config_update_policy":
+			continue
+		}
+		if strings.ContainsAny(expression, "|()<>") {
+			return nil, fmt.Errorf("Invalid Request: Unsupported predicate %s", expression)
+		}
+		p := predicate{
+			field: strings.Split(strings.TrimSuffix(key, "!"), "/"),
+			value: expression[index+1:],
+		}
+		p.notEqual = strings.HasSuffix(key, "!")
+		predicates = append(predicates, p)
+	}
+
+	return predicates, nil
+}
+
+func matchPredicates(item object, predicates []predicate) bool {
+
+	for _, p := range predicates {
+		var value interface{} = map[string]interface{}(item)
+		for _, name := range p.field {
+			switch current := value.(type) {
+			case object:
+				value = current[name]
+			case map[string]interface{}:
+				value = current[name]
+			default:
+				value = nil
+			}
+		}
+		matched := value != nil && fmt.Sprint(value) == p.value
+		if matched == p.notEqual {
+			return false
+		}
+	}
+
+	return true
+}
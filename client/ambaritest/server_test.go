@@ -0,0 +1,110 @@
+package ambaritest
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client"
+	"testing"
+)
+
+func TestServer(t *testing.T) {
+
+	server := NewServer()
+	defer server.Close()
+	server.AddHost("worker01")
+	ambariClient := client.New(server.BaseURL(), "admin", "admin")
+
+	// Cluster
+	cluster, err := ambariClient.CreateCluster(&client.Cluster{
+		ClusterInfo: &client.ClusterInfo{
+			ClusterName: "test",
+			Version:     "HDP-2.6",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	if cluster != nil {
+		assert.Equal(t, "HDP-2.6", cluster.ClusterInfo.Version)
+	}
+	_, err = ambariClient.CreateCluster(&client.Cluster{ClusterInfo: &client.ClusterInfo{ClusterName: "test"}})
+	assert.True(t, client.IsConflict(err))
+
+	// Configuration
+	_, err = ambariClient.CreateConfigurationOnCluster("test", &client.Configuration{
+		Type:       "core-site",
+		Tag:        "version1",
+		Properties: map[string]string{"fs.defaultFS": "hdfs://test"},
+	})
+	assert.NoError(t, err)
+	configuration, err := ambariClient.DesiredConfigurationOnCluster("test", "core-site")
+	assert.NoError(t, err)
+	assert.NotNil(t, configuration)
+	if configuration != nil {
+		assert.Equal(t, "hdfs://test", configuration.Properties["fs.defaultFS"])
+	}
+
+	// Privilege
+	privilege, err := ambariClient.CreatePrivilege("test", &client.Privilege{
+		PrivilegeInfo: &client.PrivilegeInfo{
+			PermissionName: "CLUSTER.USER",
+			PrincipalName:  "admin",
+			PrincipalType:  "USER",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, privilege)
+	privilege, err = ambariClient.SearchPrivilege("test", "CLUSTER.USER", "admin", "USER")
+	assert.NoError(t, err)
+	assert.NotNil(t, privilege)
+	privilege, err = ambariClient.SearchPrivilege("test", "CLUSTER.OPERATOR", "admin", "USER")
+	assert.NoError(t, err)
+	assert.Nil(t, privilege)
+
+	// Host
+	host, err := ambariClient.CreateHost(&client.Host{
+		HostInfo: &client.HostInfo{
+			ClusterName: "test",
+			Hostname:    "worker01",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, host)
+	host.HostInfo.Rack = "/rack1"
+	host, err = ambariClient.UpdateHost(host)
+	assert.NoError(t, err)
+	assert.Equal(t, "/rack1", host.HostInfo.Rack)
+	_, err = ambariClient.CreateHost(&client.Host{HostInfo: &client.HostInfo{ClusterName: "test", Hostname: "worker02"}})
+	assert.Error(t, err)
+
+	// Request
+	id := server.AddRequest("test", "Start all services", "IN_PROGRESS")
+	requestTask, err := ambariClient.AbortRequest("test", int(id))
+	assert.NoError(t, err)
+	assert.Equal(t, client.REQUEST_ABORTED, requestTask.RequestTaskInfo.Status)
+	cluster, err = ambariClient.ManageKerberosOnCluster(&client.Cluster{ClusterInfo: &client.ClusterInfo{ClusterName: "test", SecurityType: "KERBEROS"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "KERBEROS", cluster.ClusterInfo.SecurityType)
+	requestTasks, err := ambariClient.Requests("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(requestTasks))
+
+	// Blueprint
+	blueprint, err := ambariClient.CreateBlueprint("test", `{"host_groups": [{"name": "master", "cardinality": "1", "components": [{"name": "NAMENODE"}]}], "Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}}`)
+	assert.NoError(t, err)
+	assert.NotNil(t, blueprint)
+	if blueprint != nil {
+		assert.Equal(t, "test", blueprint.BlueprintInfo.Name)
+		assert.Equal(t, "HDP", blueprint.BlueprintInfo.Stack)
+	}
+	err = ambariClient.DeleteBlueprint("test")
+	assert.NoError(t, err)
+	blueprint, err = ambariClient.Blueprint("test")
+	assert.NoError(t, err)
+	assert.Nil(t, blueprint)
+
+	// Delete
+	err = ambariClient.DeleteCluster("test")
+	assert.NoError(t, err)
+	cluster, err = ambariClient.Cluster("test")
+	assert.NoError(t, err)
+	assert.Nil(t, cluster)
+}
@@ -0,0 +1,88 @@
+// This file permit to serve the settings, that are global to Ambari
+
+package ambaritest
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AddSetting permit to create setting without to call the API, like ambari-server setting with its content
+func (s *Server) AddSetting(name string, settingType string, content string) {
+	if name == "" {
+		panic("Name can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.settings[name] = object{
+		"name":         name,
+		"setting_type": settingType,
+		"content":      content,
+	}
+}
+
+func (s *Server) serveSettings(w http.ResponseWriter, r *http.Request, segments []string, body object, predicates []predicate) {
+
+	info, _ := body["Settings"].(map[string]interface{})
+	if len(segments) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			items := make([]object, 0, len(s.settings))
+			for _, name := range sortedKeys(s.settings) {
+				items = append(items, s.setting(s.settings[name]))
+			}
+			writeItems(w, r, items, predicates)
+		case http.MethodPost:
+			name, _ := info["name"].(string)
+			if name == "" {
+				writeError(w, http.StatusBadRequest, "Invalid Request: The name is required to create setting")
+				return
+			}
+			if _, ok := s.settings[name]; ok {
+				writeError(w, http.StatusConflict, fmt.Sprintf("Attempted to create a Setting which already exists, name=%s", name))
+				return
+			}
+			setting := object{"name": name}
+			updateSetting(setting, info)
+			s.settings[name] = setting
+			w.WriteHeader(http.StatusCreated)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+		return
+	}
+
+	setting, ok := s.settings[segments[0]]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Setting not found, name=%s", segments[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.setting(setting))
+	case http.MethodPut:
+		updateSetting(setting, info)
+	case http.MethodDelete:
+		delete(s.settings, segments[0])
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// updateSetting set the attributes of setting that are given
+func updateSetting(setting object, info map[string]interface{}) {
+	for _, key := range []string{"setting_type", "content"} {
+		if value, ok := info[key]; ok {
+			setting[key] = value
+		}
+	}
+}
+
+func (s *Server) setting(setting object) object {
+	return object{
+		"href":     s.href("settings", setting["name"].(string)),
+		"Settings": setting,
+	}
+}
@@ -0,0 +1,55 @@
+// This file permit to serve the services of stack definitions, with their configuration types
+
+package ambaritest
+
+import (
+	"net/http"
+	"sort"
+)
+
+// AddStackService permit to add service on stack version, like HDFS on HDP 2.6, with its configuration types
+func (s *Server) AddStackService(stackName string, stackVersion string, serviceName string, configurationTypes ...string) {
+	if stackName == "" || stackVersion == "" || serviceName == "" {
+		panic("StackName, StackVersion and ServiceName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	configTypes := object{}
+	for _, configurationType := range configurationTypes {
+		configTypes[configurationType] = object{"supports": object{"final": "true"}}
+	}
+	key := stackName + "/" + stackVersion
+	if s.stackServices[key] == nil {
+		s.stackServices[key] = map[string]object{}
+	}
+	s.stackServices[key][serviceName] = object{
+		"stack_name":    stackName,
+		"stack_version": stackVersion,
+		"service_name":  serviceName,
+		"config_types":  configTypes,
+	}
+}
+
+func (s *Server) serveStackServices(w http.ResponseWriter, r *http.Request, stackName string, stackVersion string, predicates []predicate) {
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	services := s.stackServices[stackName+"/"+stackVersion]
+	serviceNames := make([]string, 0, len(services))
+	for serviceName := range services {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+	items := make([]object, 0, len(serviceNames))
+	for _, serviceName := range serviceNames {
+		items = append(items, object{
+			"href":          s.href("stacks", stackName, "versions", stackVersion, "services", serviceName),
+			"StackServices": services[serviceName],
+		})
+	}
+	writeItems(w, r, items, predicates)
+}
@@ -0,0 +1,80 @@
+// This file permit to serve the tasks of requests, with their output
+
+package ambaritest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// AddTask permit to add task on request of cluster, like Ambari do when it run command on host
+// The task is given as Json, like {"host_name": "worker01", "role": "DATANODE", "status": "FAILED", "stderr": "Port 50010 in use"}
+// It return the task ID
+func (s *Server) AddTask(clusterName string, requestId int64, jsonTask string) int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	if _, ok := cluster.requests[requestId]; !ok {
+		panic(fmt.Sprintf("Request %d not found", requestId))
+	}
+	task := object{}
+	if err := json.Unmarshal([]byte(jsonTask), &task); err != nil {
+		panic(err)
+	}
+	s.nextId++
+	task["id"] = s.nextId
+	task["request_id"] = requestId
+	task["cluster_name"] = clusterName
+	if cluster.tasks[requestId] == nil {
+		cluster.tasks[requestId] = map[int64]object{}
+	}
+	cluster.tasks[requestId][s.nextId] = task
+
+	return s.nextId
+}
+
+// serveTasks permit to read the tasks of request, they can't be changed
+func (s *Server) serveTasks(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, requestId int64, segments []string, predicates []predicate) {
+
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	tasks := cluster.tasks[requestId]
+	if len(segments) == 0 {
+		ids := make([]int64, 0, len(tasks))
+		for id := range tasks {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		items := make([]object, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, s.task(clusterName, tasks[id]))
+		}
+		writeItems(w, r, items, predicates)
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	task, ok := tasks[id]
+	if !ok || len(segments) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Task not found, requestId=%d, taskId=%s", requestId, segments[0]))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.task(clusterName, task))
+}
+
+func (s *Server) task(clusterName string, task object) object {
+	return object{
+		"href":  s.href("clusters", clusterName, "requests", fmt.Sprintf("%d", task["request_id"]), "tasks", fmt.Sprintf("%d", task["id"])),
+		"Tasks": task,
+	}
+}
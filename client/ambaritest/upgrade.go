@@ -0,0 +1,245 @@
+// This file permit to serve the upgrades of clusters, with their groups and items
+// The upgrade is not run, it only continue when the user complete the item that wait him, like on Ambari UI
+
+package ambaritest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type upgrade struct {
+	info   object
+	groups []*upgradeGroup
+}
+
+type upgradeGroup struct {
+	info  object
+	items []object
+}
+
+// AddUpgrade permit to create upgrade on cluster, with direction like UPGRADE or DOWNGRADE
+// The status and the progress of upgrade are computed from its items, added with AddUpgradeItem
+// It return the upgrade ID, that is its request ID
+func (s *Server) AddUpgrade(clusterName string, direction string) int64 {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	s.nextId++
+	cluster.upgrades[s.nextId] = &upgrade{
+		info: object{
+			"request_id":                  s.nextId,
+			"cluster_name":                clusterName,
+			"direction":                   direction,
+			"skip_failures":               false,
+			"skip_service_check_failures": false,
+		},
+		groups: []*upgradeGroup{},
+	}
+
+	return s.nextId
+}
+
+// AddUpgradeItem permit to add item on the group of upgrade, with the status like COMPLETED, PENDING or HOLDING_FAILED
+// The group is created if not exist. When the user complete item that wait him, the pending items are completed,
+// except the last item of upgrade that wait the confirmation, like the finalize step.
+// It return the stage ID of item
+func (s *Server) AddUpgradeItem(clusterName string, id int64, groupName string, context string, status string) int64 {
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	cluster, ok := s.clusters[clusterName]
+	if !ok {
+		panic(fmt.Sprintf("Cluster %s not found", clusterName))
+	}
+	upgrade, ok := cluster.upgrades[id]
+	if !ok {
+		panic(fmt.Sprintf("Upgrade %d not found", id))
+	}
+	var group *upgradeGroup
+	stageId := int64(1)
+	for _, current := range upgrade.groups {
+		if current.info["name"] == groupName {
+			group = current
+		}
+		stageId += int64(len(current.items))
+	}
+	if group == nil {
+		group = &upgradeGroup{
+			info:  object{"group_id": int64(len(upgrade.groups) + 1), "name": groupName},
+			items: []object{},
+		}
+		upgrade.groups = append(upgrade.groups, group)
+	}
+	group.items = append(group.items, object{
+		"group_id": group.info["group_id"],
+		"stage_id": stageId,
+		"status":   status,
+		"context":  context,
+	})
+
+	return stageId
+}
+
+func (s *Server) serveUpgrades(w http.ResponseWriter, r *http.Request, clusterName string, cluster *cluster, segments []string, body object, predicates []predicate) {
+
+	if len(segments) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+			return
+		}
+		ids := make([]int64, 0, len(cluster.upgrades))
+		for id := range cluster.upgrades {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		items := make([]object, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, object{
+				"href":    s.href("clusters", clusterName, "upgrades", fmt.Sprintf("%d", id)),
+				"Upgrade": upgradeInfo(cluster.upgrades[id]),
+			})
+		}
+		writeItems(w, r, items, predicates)
+		return
+	}
+
+	id, _ := strconv.ParseInt(segments[0], 10, 64)
+	upgrade, ok := cluster.upgrades[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Upgrade not found, requestId=%s", segments[0]))
+		return
+	}
+	if len(segments) > 1 {
+		if len(segments) != 5 || segments[1] != "upgrade_groups" || segments[3] != "upgrade_items" || r.Method != http.MethodPut {
+			writeError(w, http.StatusNotFound, "The requested resource doesn't exist")
+			return
+		}
+		s.updateUpgradeItem(w, upgrade, segments[2], segments[4], body)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.upgrade(clusterName, upgrade))
+	case http.MethodPut:
+		info, _ := body["Upgrade"].(map[string]interface{})
+		for _, key := range []string{"skip_failures", "skip_service_check_failures"} {
+			if value, ok := info[key].(bool); ok {
+				upgrade.info[key] = value
+			}
+		}
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// updateUpgradeItem permit to complete the item that wait the user, then the upgrade continue
+func (s *Server) updateUpgradeItem(w http.ResponseWriter, upgrade *upgrade, groupId string, stageId string, body object) {
+
+	var item object
+	for _, group := range upgrade.groups {
+		for _, current := range group.items {
+			if fmt.Sprintf("%d", current["group_id"]) == groupId && fmt.Sprintf("%d", current["stage_id"]) == stageId {
+				item = current
+			}
+		}
+	}
+	if item == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested resource doesn't exist: Upgrade item not found, groupId=%s, stageId=%s", groupId, stageId))
+		return
+	}
+	info, _ := body["UpgradeItem"].(map[string]interface{})
+	if info["status"] != "COMPLETED" || !strings.HasPrefix(item["status"].(string), "HOLDING") {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid Request: Can't change the status of upgrade item from %s to %v", item["status"], info["status"]))
+		return
+	}
+	item["status"] = "COMPLETED"
+
+	// The upgrade continue until the last item, that wait the confirmation
+	items := make([]object, 0)
+	for _, group := range upgrade.groups {
+		items = append(items, group.items...)
+	}
+	for index, current := range items {
+		if current["status"] == "COMPLETED" {
+			continue
+		}
+		if current["status"] != "PENDING" {
+			break
+		}
+		if index == len(items)-1 {
+			current["status"] = "HOLDING"
+		} else {
+			current["status"] = "COMPLETED"
+		}
+	}
+}
+
+// upgradeInfo return the upgrade with its status and progress computed from its items
+// The upgrade is completed when all items are completed, else it has the status of the item that wait the user
+func upgradeInfo(upgrade *upgrade) object {
+	info := object{}
+	for key, value := range upgrade.info {
+		info[key] = value
+	}
+	total, completed := 0, 0
+	status := "IN_PROGRESS"
+	for _, group := range upgrade.groups {
+		for _, item := range group.items {
+			total++
+			if item["status"] == "COMPLETED" {
+				completed++
+			} else if strings.HasPrefix(item["status"].(string), "HOLDING") && status == "IN_PROGRESS" {
+				status = item["status"].(string)
+			}
+		}
+	}
+	if completed == total {
+		status = "COMPLETED"
+	}
+	info["request_status"] = status
+	info["progress_percent"] = float64(100)
+	if total > 0 {
+		info["progress_percent"] = float64(completed * 100 / total)
+	}
+
+	return info
+}
+
+func (s *Server) upgrade(clusterName string, upgrade *upgrade) object {
+	href := s.href("clusters", clusterName, "upgrades", fmt.Sprintf("%d", upgrade.info["request_id"]))
+	groups := make([]object, 0, len(upgrade.groups))
+	for _, group := range upgrade.groups {
+		groupHref := fmt.Sprintf("%s/upgrade_groups/%d", href, group.info["group_id"])
+		items := make([]object, 0, len(group.items))
+		for _, item := range group.items {
+			items = append(items, object{
+				"href":        fmt.Sprintf("%s/upgrade_items/%d", groupHref, item["stage_id"]),
+				"UpgradeItem": item,
+			})
+		}
+		groups = append(groups, object{
+			"href":          groupHref,
+			"UpgradeGroup":  group.info,
+			"upgrade_items": items,
+		})
+	}
+
+	return object{
+		"href":           href,
+		"Upgrade":        upgradeInfo(upgrade),
+		"upgrade_groups": groups,
+	}
+}
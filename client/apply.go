@@ -0,0 +1,68 @@
+// This file permit to apply the desired state of resources on Ambari API, doing only the needed changes
+
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	CHANGE_CREATE = "create"
+	CHANGE_UPDATE = "update"
+	CHANGE_DELETE = "delete"
+)
+
+// Change is a change done on resource to get the desired state
+type Change struct {
+	Action   string      `json:"action"`
+	Resource string      `json:"resource"`
+	Name     string      `json:"name"`
+	Before   interface{} `json:"before,omitempty"`
+	After    interface{} `json:"after,omitempty"`
+}
+
+// ChangeReport is the list of changes done by ApplyXxx methods
+// It's empty when the resources are already in the desired state
+type ChangeReport struct {
+	Changes []Change `json:"changes"`
+}
+
+// String return change report as Json string
+func (r *ChangeReport) String() string {
+	json, _ := json.Marshal(r)
+	return string(json)
+}
+
+// HasChanges return true if some changes are needed
+func (r *ChangeReport) HasChanges() bool {
+	return len(r.Changes) > 0
+}
+
+func newChangeReport() *ChangeReport {
+	return &ChangeReport{
+		Changes: make([]Change, 0),
+	}
+}
+
+func (r *ChangeReport) add(action string, resource string, name string, before interface{}, after interface{}) {
+	r.Changes = append(r.Changes, Change{
+		Action:   action,
+		Resource: resource,
+		Name:     name,
+		Before:   before,
+		After:    after,
+	})
+}
+
+// isDryRun return true if the changes are not sent to Ambari
+// The ApplyXxx methods only compute the changes in this case, because they can't read the resources they don't really change
+func (c *AmbariClient) isDryRun() bool {
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*dryRunTransport)
+		return ok
+	})
+
+	return transport != nil && transport.(*dryRunTransport).currentRecorder() != nil
+}
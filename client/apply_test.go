@@ -0,0 +1,74 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestApplyDryRun(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddClusterPrivilege("test", "CLUSTER.USER", "user1", "USER")
+	server.AddClusterPrivilege("test", "CLUSTER.OPERATOR", "ops", "GROUP")
+	server.AddClusterPrivilege("test", "CLUSTER.USER", "ops", "GROUP")
+	server.AddSetting("test", SETTING_TYPE_AMBARI_SERVER, "old")
+
+	client := New(server.BaseURL(), "admin", "admin")
+	recorder := NewDryRunRecorder()
+	client.SetDryRun(recorder)
+
+	// Privileges
+	report, err := client.ApplyPrivileges("test", []Privilege{
+		{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.USER", PrincipalName: "user1", PrincipalType: "USER"}},
+		{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.ADMINISTRATOR", PrincipalName: "admins", PrincipalType: "GROUP"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(report.Changes))
+	assert.Equal(t, CHANGE_CREATE, report.Changes[0].Action)
+	assert.Equal(t, "GROUP:admins:CLUSTER.ADMINISTRATOR", report.Changes[0].Name)
+	assert.Equal(t, CHANGE_DELETE, report.Changes[1].Action)
+	assert.Equal(t, "GROUP:ops:CLUSTER.OPERATOR", report.Changes[1].Name)
+	assert.Equal(t, CHANGE_DELETE, report.Changes[2].Action)
+	assert.Equal(t, "GROUP:ops:CLUSTER.USER", report.Changes[2].Name)
+
+	report, err = client.ApplyPrivilege("test", &Privilege{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.OPERATOR", PrincipalName: "user1", PrincipalType: "USER"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+
+	report, err = client.ApplyPrivilege("test", &Privilege{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.USER", PrincipalName: "user1", PrincipalType: "USER"}})
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+
+	// All the privileges of principal are reconciled
+	report, err = client.ApplyPrivilege("test", &Privilege{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.USER", PrincipalName: "ops", PrincipalType: "GROUP"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_DELETE, report.Changes[0].Action)
+	assert.Equal(t, "GROUP:ops:CLUSTER.OPERATOR", report.Changes[0].Name)
+
+	report, err = client.ApplyPrivilege("test", &Privilege{PrivilegeInfo: &PrivilegeInfo{PermissionName: "CLUSTER.ADMINISTRATOR", PrincipalName: "ops", PrincipalType: "GROUP"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+	assert.Equal(t, "GROUP:ops:CLUSTER.ADMINISTRATOR", report.Changes[0].Name)
+	assert.Equal(t, CHANGE_DELETE, report.Changes[1].Action)
+	assert.Equal(t, "GROUP:ops:CLUSTER.USER", report.Changes[1].Name)
+
+	// Settings
+	report, err = client.ApplySetting(&Setting{SettingInfo: &SettingInfo{Name: "test", SettingType: SETTING_TYPE_AMBARI_SERVER, Content: "new"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+
+	report, err = client.ApplySetting(&Setting{SettingInfo: &SettingInfo{Name: "other", SettingType: SETTING_TYPE_AMBARI_SERVER, Content: "new"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_CREATE, report.Changes[0].Action)
+
+	// Nothing sent
+	assert.Empty(t, recorder.Calls())
+}
@@ -0,0 +1,141 @@
+// This file permit to audit the calls that change Ambari, to keep a trail of who change the clusters and when
+
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuditEntry is a call that change Ambari (POST, PUT or DELETE) and its result
+type AuditEntry struct {
+	Time       time.Time `json:"time"`
+	Actor      string    `json:"actor,omitempty"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	BodyDigest string    `json:"body_digest,omitempty"`
+	Status     int       `json:"status,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// AuditHook is called after each call that change Ambari
+// It's called by the goroutine that do the call, so it must be safe for concurrent use when the client is shared
+type AuditHook func(entry AuditEntry)
+
+type auditTransport struct {
+	next http.RoundTripper
+	// mutex protect the actor and the hook, that can be changed while calls are sent
+	mutex sync.RWMutex
+	actor string
+	hook  AuditHook
+}
+
+// String return audit entry as Json string
+func (e *AuditEntry) String() string {
+	json, _ := json.Marshal(e)
+	return string(json)
+}
+
+// NewAuditWriter return audit hook that write each entry as Json line on the writer, like file opened in append mode
+// The errors when it write are ignored, to not fail the call that is already done on Ambari
+func NewAuditWriter(w io.Writer) AuditHook {
+
+	if w == nil {
+		panic("Writer can't be nil")
+	}
+
+	mutex := &sync.Mutex{}
+	return func(entry AuditEntry) {
+		b, err := json.Marshal(entry)
+		if err != nil {
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		w.Write(append(b, '\n'))
+	}
+}
+
+// SetAudit permit to call the hook after each call that change Ambari, with the actor that do the change, like the user or the job name
+// The body is not given to the hook because it can have passwords, only its SHA-256 digest.
+// Set the audit before the dry run to not audit the calls that are not sent.
+// The nil hook disable the audit
+// Enable the audit before the client is shared between goroutines, the actor and the hook can be changed later
+func (c *AmbariClient) SetAudit(actor string, hook AuditHook) {
+
+	c.checkOwnTransport()
+	c.log.Debugw("SetAudit", "actor", actor)
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*auditTransport)
+		return ok
+	})
+	if transport != nil {
+		audit := transport.(*auditTransport)
+		audit.mutex.Lock()
+		defer audit.mutex.Unlock()
+		audit.actor = actor
+		audit.hook = hook
+		return
+	}
+
+	if hook != nil {
+		c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return &auditTransport{
+				next:  next,
+				actor: actor,
+				hook:  hook,
+			}
+		})
+	}
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *auditTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+// RoundTrip send the request and call the hook if it change Ambari
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	t.mutex.RLock()
+	actor, hook := t.actor, t.hook
+	t.mutex.RUnlock()
+	if hook == nil || req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return t.next.RoundTrip(req)
+	}
+
+	entry := AuditEntry{
+		Time:   time.Now(),
+		Actor:  actor,
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		digest := sha256.Sum256(body)
+		entry.BodyDigest = "sha256:" + hex.EncodeToString(digest[:])
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = resp.StatusCode
+	}
+	hook(entry)
+
+	return resp, err
+}
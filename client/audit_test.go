@@ -0,0 +1,82 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetAudit(t *testing.T) {
+
+	var receivedBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPut {
+			body, _ := ioutil.ReadAll(r.Body)
+			receivedBody = string(body)
+		}
+		w.Write([]byte(`{"Hosts": {"host_name": "worker01", "cluster_name": "test", "rack_info": "/rack1"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	entries := make([]AuditEntry, 0)
+	client.SetAudit("deploy-job", func(entry AuditEntry) {
+		entries = append(entries, entry)
+	})
+
+	// Read is not audited
+	host, err := client.HostOnCluster("test", "worker01")
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// Change is audited, and the body is still sent
+	host.HostInfo.Rack = "/rack2"
+	_, err = client.UpdateHost(host)
+	assert.NoError(t, err)
+	assert.Contains(t, receivedBody, "/rack2")
+	digest := sha256.Sum256([]byte(receivedBody))
+	err = client.DeleteRepository("HDP", "2.6", 1)
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, "deploy-job", entries[0].Actor)
+	assert.Equal(t, "PUT", entries[0].Method)
+	assert.Equal(t, "/api/v1/clusters/test/hosts/worker01", entries[0].Path)
+	assert.Equal(t, "sha256:"+hex.EncodeToString(digest[:]), entries[0].BodyDigest)
+	assert.Equal(t, 200, entries[0].Status)
+	assert.False(t, entries[0].Time.IsZero())
+	assert.Equal(t, "DELETE", entries[1].Method)
+	assert.Equal(t, 404, entries[1].Status)
+
+	// Disable audit
+	client.SetAudit("", nil)
+	_, err = client.UpdateHost(host)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(entries))
+}
+
+func TestNewAuditWriter(t *testing.T) {
+
+	buffer := &bytes.Buffer{}
+	hook := NewAuditWriter(buffer)
+	hook(AuditEntry{Actor: "admin", Method: "POST", Path: "/api/v1/clusters/test", Status: 201})
+	hook(AuditEntry{Actor: "admin", Method: "DELETE", Path: "/api/v1/clusters/test", Error: "connection refused"})
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	entry := &AuditEntry{}
+	err := json.Unmarshal([]byte(lines[1]), entry)
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", entry.Method)
+	assert.Equal(t, "connection refused", entry.Error)
+}
@@ -7,7 +7,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 // Blueprint Json object
@@ -45,14 +44,19 @@ func (c *AmbariClient) CreateBlueprint(name string, jsonBlueprint string) (*Blue
 	if jsonBlueprint == "" {
 		panic("JsonBlueprint can't be empty")
 	}
-	log.Debugf("Name: %s", name)
-	log.Debugf("JsonBlueprint: %s", jsonBlueprint)
+	c.log.Debugf("Name: %s", name)
+	c.log.Debugf("JsonBlueprint: %s", jsonBlueprint)
 
 	var blueprintTest interface{}
 	err := json.Unmarshal([]byte(jsonBlueprint), &blueprintTest)
 	if err != nil {
 		return nil, err
 	}
+	if c.validation {
+		if err = validateBlueprintJson(jsonBlueprint); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the BluePrint
 	path := fmt.Sprintf("/blueprints/%s", name)
@@ -60,9 +64,9 @@ func (c *AmbariClient) CreateBlueprint(name string, jsonBlueprint string) (*Blue
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	blueprint, err := c.Blueprint(name)
@@ -73,7 +77,7 @@ func (c *AmbariClient) CreateBlueprint(name string, jsonBlueprint string) (*Blue
 		return nil, NewAmbariError(500, "Can't get blueprint that just created")
 	}
 
-	log.Debugf("Return blueprint: %s", blueprint)
+	c.log.Debugf("Return blueprint: %s", blueprint)
 
 	return blueprint, nil
 
@@ -82,24 +86,24 @@ func (c *AmbariClient) CreateBlueprint(name string, jsonBlueprint string) (*Blue
 // Blueprint permit to get blueprint item from is name
 // It return blueprint object if exist, else it return nil
 // It return error if something wrong when call the API
-func (c *AmbariClient) Blueprint(name string) (*Blueprint, error) {
+func (c *AmbariClient) Blueprint(name string, opts ...RequestOption) (*Blueprint, error) {
 
 	if name == "" {
 		panic("Name can't be empty")
 	}
-	log.Debug("Name: ", name)
+	c.log.Debugw("Blueprint", "name", name)
 
 	path := fmt.Sprintf("/blueprints/%s", name)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	blueprint := &Blueprint{}
@@ -107,7 +111,7 @@ func (c *AmbariClient) Blueprint(name string) (*Blueprint, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return blueprint: %s", blueprint)
+	c.log.Debugf("Return blueprint: %s", blueprint)
 
 	return blueprint, nil
 }
@@ -119,7 +123,7 @@ func (c *AmbariClient) DeleteBlueprint(name string) error {
 	if name == "" {
 		panic("Name can't be empty")
 	}
-	log.Debug("Name: ", name)
+	c.log.Debugw("DeleteBlueprint", "name", name)
 
 	// Check if blueprint exist
 	blueprint, err := c.Blueprint(name)
@@ -135,9 +139,9 @@ func (c *AmbariClient) DeleteBlueprint(name string) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete blueprint: ", resp)
+	c.log.Debug("Response to delete blueprint: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
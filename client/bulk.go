@@ -0,0 +1,135 @@
+// This file permit to run many independent operations on Ambari API at the same time, like update all hosts
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// BulkOperation is the operation to run for one item, like the host name
+// It return the result of the operation, or error if it failed
+type BulkOperation func(ctx context.Context, key string) (interface{}, error)
+
+// BulkItemResult is the result of the operation for one item
+type BulkItemResult struct {
+	Index  int
+	Key    string
+	Result interface{}
+	Err    error
+}
+
+// BulkResult is the result of the operations, in the same order as the items
+type BulkResult struct {
+	Items []BulkItemResult
+}
+
+// BulkError is the error returned when some operations failed
+// The errors are keyed by the index of the item, so the same key can be given many times without lose error
+type BulkError struct {
+	Errors map[int]error
+	Keys   map[int]string
+}
+
+// Error return the number of failed operations and their errors, in the order of the items
+func (e *BulkError) Error() string {
+
+	indexes := make([]int, 0, len(e.Errors))
+	for index := range e.Errors {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	messages := make([]string, 0, len(indexes))
+	for _, index := range indexes {
+		messages = append(messages, fmt.Sprintf("%s: %s", e.Keys[index], e.Errors[index].Error()))
+	}
+
+	return fmt.Sprintf("%d operations failed: %s", len(e.Errors), strings.Join(messages, ", "))
+}
+
+// Failed return the results of the operations that failed
+func (r *BulkResult) Failed() []BulkItemResult {
+
+	failed := make([]BulkItemResult, 0)
+	for _, item := range r.Items {
+		if item.Err != nil {
+			failed = append(failed, item)
+		}
+	}
+
+	return failed
+}
+
+// Err return BulkError if some operations failed, else nil
+func (r *BulkResult) Err() error {
+
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	bulkError := &BulkError{
+		Errors: make(map[int]error, len(failed)),
+		Keys:   make(map[int]string, len(failed)),
+	}
+	for _, item := range failed {
+		bulkError.Errors[item.Index] = item.Err
+		bulkError.Keys[item.Index] = item.Key
+	}
+
+	return bulkError
+}
+
+// Bulk permit to run the operation for each item, with at most workers operations at the same time
+// It wait all operations are finished, even if some failed. When the context is canceled, the operations not yet started failed with the context error.
+//
+//	result := client.Bulk(ctx, 10, hostnames, func(ctx context.Context, hostname string) (interface{}, error) {
+//		return client.StopHostComponent("test", hostname, "DATANODE")
+//	})
+//	if result.Err() != nil {
+//		return result.Err()
+//	}
+func (c *AmbariClient) Bulk(ctx context.Context, workers int, keys []string, operation BulkOperation) *BulkResult {
+
+	if workers <= 0 {
+		panic("Workers must be greater than 0")
+	}
+	if operation == nil {
+		panic("Operation can't be nil")
+	}
+	c.log.Debugf("Run bulk operation on %d items with %d workers", len(keys), workers)
+
+	result := &BulkResult{
+		Items: make([]BulkItemResult, len(keys)),
+	}
+	indexes := make(chan int)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers && i < len(keys); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				item := &result.Items[index]
+				item.Index = index
+				item.Key = keys[index]
+				if err := ctx.Err(); err != nil {
+					item.Err = err
+					continue
+				}
+				item.Result, item.Err = operation(ctx, item.Key)
+				if item.Err != nil {
+					c.log.Debugf("Bulk operation on %s failed: %s", item.Key, item.Err.Error())
+				}
+			}
+		}()
+	}
+	for index := range keys {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	return result
+}
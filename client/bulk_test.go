@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+func TestBulk(t *testing.T) {
+
+	client := New("http://ambari-server:8080/api/v1", "admin", "admin")
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		keys = append(keys, fmt.Sprintf("host%d", i))
+	}
+
+	// Limit the number of operations at the same time
+	mutex := &sync.Mutex{}
+	running := 0
+	maxRunning := 0
+	result := client.Bulk(context.Background(), 5, keys, func(ctx context.Context, key string) (interface{}, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		defer func() {
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+		}()
+
+		if key == "host10" || key == "host42" {
+			return nil, errors.New("failed")
+		}
+		return "ok " + key, nil
+	})
+	assert.True(t, maxRunning <= 5)
+	assert.Equal(t, 100, len(result.Items))
+	assert.Equal(t, "host3", result.Items[3].Key)
+	assert.Equal(t, "ok host3", result.Items[3].Result)
+	assert.Equal(t, 2, len(result.Failed()))
+	assert.EqualError(t, result.Err(), "2 operations failed: host10: failed, host42: failed")
+
+	// Context canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result = client.Bulk(ctx, 5, keys, func(ctx context.Context, key string) (interface{}, error) {
+		return nil, nil
+	})
+	assert.Equal(t, 100, len(result.Failed()))
+	assert.True(t, errors.Is(result.Failed()[0].Err, context.Canceled))
+
+	// All succeed
+	result = client.Bulk(context.Background(), 5, keys[:2], func(ctx context.Context, key string) (interface{}, error) {
+		return nil, nil
+	})
+	assert.NoError(t, result.Err())
+
+	// Same key given many times
+	result = client.Bulk(context.Background(), 5, []string{"host1", "host2", "host1"}, func(ctx context.Context, key string) (interface{}, error) {
+		if key == "host1" {
+			return nil, errors.New("failed")
+		}
+		return nil, nil
+	})
+	assert.Equal(t, 2, len(result.Failed()))
+	assert.Equal(t, 2, result.Failed()[1].Index)
+	bulkError := result.Err().(*BulkError)
+	assert.Equal(t, 2, len(bulkError.Errors))
+	assert.EqualError(t, bulkError, "2 operations failed: host1: failed, host1: failed")
+}
@@ -0,0 +1,217 @@
+// This file permit to cache the responses of Ambari API, to not call again and again Ambari for resources that rarely change like the stacks
+
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultCachePaths is the resources cached when no paths are given, the stack definitions and the permissions that change only when Ambari is upgraded
+var defaultCachePaths = []string{"/stacks", "/permissions"}
+
+// volatileClusterResources is the resources of cluster that the client poll when it wait a request or a state, they are never cached
+var volatileClusterResources = map[string]bool{
+	"requests":        true,
+	"upgrades":        true,
+	"services":        true,
+	"hosts":           true,
+	"host_components": true,
+}
+
+type cacheTransport struct {
+	next     http.RoundTripper
+	ttl      time.Duration
+	basePath string
+	paths    []string
+	mutex    sync.Mutex
+	entries  map[string]*cacheEntry
+}
+
+// cacheEntry is the response of GET call
+type cacheEntry struct {
+	status  int
+	header  http.Header
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+// SetCache permit to cache the responses of GET calls during ttl
+// When the ttl expire and Ambari give an ETag, the response is revalidated with If-None-Match, else it's read again.
+// Paths permit to cache only the resources that start with one of them, like /stacks. When it's empty, only /stacks and /permissions are cached.
+// The requests, the upgrades, the services, the hosts and the host components of clusters are never cached, because the client poll them to wait the end of request or the state.
+// All the cache is removed when the client call Ambari to create, update or delete resource.
+// Set 0 ttl to disable the cache
+func (c *AmbariClient) SetCache(ttl time.Duration, paths ...string) {
+
+	c.checkOwnTransport()
+	if ttl < 0 {
+		panic("Ttl can't be negative")
+	}
+	if len(paths) == 0 {
+		paths = defaultCachePaths
+	}
+	c.log.Debugf("Cache: %s, paths %v", ttl, paths)
+
+	basePath := ""
+	if baseUrl, err := url.Parse(c.client.HostURL); err == nil {
+		basePath = strings.TrimSuffix(baseUrl.Path, "/")
+	}
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*cacheTransport)
+		return ok
+	})
+	if transport != nil {
+		cache := transport.(*cacheTransport)
+		cache.mutex.Lock()
+		defer cache.mutex.Unlock()
+		cache.ttl = ttl
+		cache.basePath = basePath
+		cache.paths = paths
+		cache.entries = map[string]*cacheEntry{}
+		return
+	}
+
+	if ttl > 0 {
+		c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return &cacheTransport{
+				next:     next,
+				ttl:      ttl,
+				basePath: basePath,
+				paths:    paths,
+				entries:  map[string]*cacheEntry{},
+			}
+		})
+	}
+}
+
+// ClearCache permit to remove all responses from the cache, to read again the resources from Ambari
+func (c *AmbariClient) ClearCache() {
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*cacheTransport)
+		return ok
+	})
+	if transport != nil {
+		transport.(*cacheTransport).clear()
+	}
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *cacheTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+// RoundTrip return the response from the cache if it's not expired, else it call Ambari and keep the response
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	if req.Method != http.MethodGet {
+		resp, err := t.next.RoundTrip(req)
+		if err == nil && resp.StatusCode < 300 {
+			t.clear()
+		}
+		return resp, err
+	}
+
+	t.mutex.Lock()
+	if t.ttl == 0 || !t.match(req.URL.Path) {
+		t.mutex.Unlock()
+		return t.next.RoundTrip(req)
+	}
+	key := req.URL.String()
+	entry := t.entries[key]
+	ttl := t.ttl
+	t.mutex.Unlock()
+
+	if entry != nil && time.Now().Before(entry.expires) {
+		return entry.response(req), nil
+	}
+
+	// Revalidate the response with its ETag
+	if entry != nil && entry.etag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotModified && entry != nil {
+		resp.Body.Close()
+		t.mutex.Lock()
+		entry.expires = time.Now().Add(ttl)
+		t.mutex.Unlock()
+		return entry.response(req), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return resp, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	t.mutex.Lock()
+	t.entries[key] = &cacheEntry{
+		status:  resp.StatusCode,
+		header:  resp.Header.Clone(),
+		body:    body,
+		etag:    resp.Header.Get("ETag"),
+		expires: time.Now().Add(ttl),
+	}
+	t.mutex.Unlock()
+
+	return resp, nil
+}
+
+// match return true if the resource must be cached
+func (t *cacheTransport) match(path string) bool {
+
+	path = strings.TrimPrefix(path, t.basePath)
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if segments[0] == "clusters" {
+		for _, segment := range segments[1:] {
+			if volatileClusterResources[segment] {
+				return false
+			}
+		}
+	}
+	for _, prefix := range t.paths {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t *cacheTransport) clear() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.entries = map[string]*cacheEntry{}
+}
+
+// response return new response with the cached body
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
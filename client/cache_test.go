@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSetCache(t *testing.T) {
+
+	calls := map[string]int{}
+	revalidated := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path]++
+		switch r.URL.Path {
+		case "/api/v1/stacks":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				revalidated++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			w.Write([]byte(`{"items": [{"Stacks": {"stack_name": "HDP"}}]}`))
+		case "/api/v1/clusters/test":
+			w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	client.SetCache(50*time.Millisecond, "/stacks")
+
+	// Read from cache
+	for i := 0; i < 3; i++ {
+		stacks, err := client.Stacks()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(stacks))
+	}
+	assert.Equal(t, 1, calls["/api/v1/stacks"])
+
+	// Not cached path
+	client.Cluster("test")
+	client.Cluster("test")
+	assert.Equal(t, 2, calls["/api/v1/clusters/test"])
+
+	// Revalidate with ETag when expired
+	time.Sleep(60 * time.Millisecond)
+	stacks, err := client.Stacks()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(stacks))
+	assert.Equal(t, 1, revalidated)
+
+	// Removed by update
+	err = client.DeleteSetting("test")
+	assert.NoError(t, err)
+	client.Stacks()
+	assert.Equal(t, 3, calls["/api/v1/stacks"])
+
+	// Disable cache
+	client.SetCache(0)
+	client.Stacks()
+	assert.Equal(t, 4, calls["/api/v1/stacks"])
+}
+
+func TestSetCacheWaitRequest(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	id := server.AddRequest("test", "Start HDFS", "IN_PROGRESS")
+	client := New(server.BaseURL(), "admin", "admin")
+	client.SetPollPolicy(&PollPolicy{MinInterval: 10 * time.Millisecond, MaxInterval: 10 * time.Millisecond})
+	client.SetRequestTimeout(5 * time.Second)
+
+	// The request is read from Ambari on each poll, even when all the clusters resources are cached
+	for _, paths := range [][]string{nil, {"/clusters"}} {
+		client.SetCache(time.Minute, paths...)
+		requestTask, err := client.Request("test", int(id))
+		assert.NoError(t, err)
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			server.SetRequestStatus("test", id, REQUEST_COMPLETED)
+		}()
+		assert.NoError(t, requestTask.WaitContext(context.Background(), client, "test"))
+		assert.Equal(t, REQUEST_COMPLETED, requestTask.RequestTaskInfo.Status)
+		server.SetRequestStatus("test", id, "IN_PROGRESS")
+	}
+}
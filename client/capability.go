@@ -0,0 +1,212 @@
+// This file permit to know the features supported by Ambari server, because the API change between Ambari versions
+// Only the payloads of these features are adapted, the endpoint names are the same on the supported Ambari versions
+
+package client
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Feature is Ambari API feature that not exist on all Ambari versions
+type Feature string
+
+const (
+	// FEATURE_SETTINGS is the settings API (/settings)
+	FEATURE_SETTINGS Feature = "settings"
+	// FEATURE_COMPONENT_RECOVERY is the auto start setting on each component
+	FEATURE_COMPONENT_RECOVERY Feature = "component_recovery"
+	// FEATURE_QUICKLINKS_PROFILE is the quick links profile setting
+	FEATURE_QUICKLINKS_PROFILE Feature = "quicklinks_profile"
+	// FEATURE_REPOSITORY_AMBARI_MANAGED is the ambari_managed_repositories attribute of repository operating systems
+	FEATURE_REPOSITORY_AMBARI_MANAGED Feature = "repository_ambari_managed"
+	// FEATURE_USER_API_V2 is the users API with authentication sources, introduced by Ambari 2.7
+	FEATURE_USER_API_V2 Feature = "user_api_v2"
+)
+
+// The first Ambari version that support the feature
+var featureVersions = map[Feature]string{
+	FEATURE_SETTINGS:                  "2.4.0",
+	FEATURE_COMPONENT_RECOVERY:        "2.4.0",
+	FEATURE_QUICKLINKS_PROFILE:        "2.5.0",
+	FEATURE_REPOSITORY_AMBARI_MANAGED: "2.6.0",
+	FEATURE_USER_API_V2:               "2.7.0",
+}
+
+// The delay before read again the Ambari server version after failure, it's doubled on each failure until the max delay
+var (
+	serverVersionRetryDelay    = 10 * time.Second
+	serverVersionMaxRetryDelay = 5 * time.Minute
+)
+
+// capabilities keep the Ambari server version, read once
+// The failure is kept until the retry delay, so the calls not read the version each time when Ambari can't give it
+type capabilities struct {
+	mutex      sync.Mutex
+	version    string
+	err        error
+	failures   int
+	retryAfter time.Time
+	// reading is closed when the current read of version is finished
+	reading chan struct{}
+}
+
+// SetServerVersion permit to set the Ambari server version, so the client not read it from Ambari
+func (c *AmbariClient) SetServerVersion(version string) {
+
+	if version == "" {
+		panic("Version can't be empty")
+	}
+	c.log.Debugw("SetServerVersion", "version", version)
+
+	c.capabilities.mutex.Lock()
+	defer c.capabilities.mutex.Unlock()
+	c.capabilities.version = version
+	c.capabilities.err = nil
+	c.capabilities.failures = 0
+}
+
+// ServerVersion return the Ambari server version, like 2.7.5.0
+// It read it from Ambari on first call, and keep it for the next calls. The concurrent calls wait the same read.
+// When the read failed, the next calls return the same error until the retry delay
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ServerVersion() (string, error) {
+
+	capabilities := c.capabilities
+	for {
+		capabilities.mutex.Lock()
+		if capabilities.version != "" {
+			defer capabilities.mutex.Unlock()
+			return capabilities.version, nil
+		}
+		if capabilities.err != nil && time.Now().Before(capabilities.retryAfter) {
+			defer capabilities.mutex.Unlock()
+			return "", capabilities.err
+		}
+		if capabilities.reading == nil {
+			break
+		}
+		reading := capabilities.reading
+		capabilities.mutex.Unlock()
+		<-reading
+	}
+	reading := make(chan struct{})
+	capabilities.reading = reading
+	capabilities.mutex.Unlock()
+
+	// The mutex is not hold when it call Ambari, so SetServerVersion is not blocked
+	version, err := c.readServerVersion()
+
+	capabilities.mutex.Lock()
+	defer capabilities.mutex.Unlock()
+	capabilities.reading = nil
+	close(reading)
+	if capabilities.version != "" {
+		// Set while reading
+		return capabilities.version, nil
+	}
+	if err != nil {
+		delay := serverVersionRetryDelay << uint(capabilities.failures)
+		if delay > serverVersionMaxRetryDelay || delay <= 0 {
+			delay = serverVersionMaxRetryDelay
+		} else {
+			capabilities.failures++
+		}
+		capabilities.err = err
+		capabilities.retryAfter = time.Now().Add(delay)
+		c.log.Debugf("Can't read Ambari server version, retry in %s: %s", delay, err.Error())
+		return "", err
+	}
+	capabilities.version = version
+	capabilities.err = nil
+	capabilities.failures = 0
+
+	return version, nil
+}
+
+// readServerVersion read the Ambari server version from Ambari
+func (c *AmbariClient) readServerVersion() (string, error) {
+
+	serverInfo, err := c.ServerInfo()
+	if err != nil {
+		return "", err
+	}
+	if serverInfo == nil || serverInfo.ServerInfoInfo == nil || serverInfo.ServerInfoInfo.Version == "" {
+		return "", NewAmbariError(500, "Can't read Ambari server version")
+	}
+
+	return serverInfo.ServerInfoInfo.Version, nil
+}
+
+// Supports return true if Ambari server support the feature
+// It return false if the feature is unknown, or if it can't read the Ambari server version
+func (c *AmbariClient) Supports(feature Feature) bool {
+
+	version, err := c.ServerVersion()
+	if err != nil {
+		c.log.Warnf("Can't read Ambari server version to check feature %s: %s", feature, err.Error())
+		return false
+	}
+
+	return isFeatureSupported(feature, version)
+}
+
+// notSupports return true only if Ambari server is known to not support the feature
+// It permit to adapt the calls for old Ambari versions, and to keep the default calls when the version can't be read
+func (c *AmbariClient) notSupports(feature Feature) bool {
+
+	version, err := c.ServerVersion()
+	if err != nil {
+		c.log.Debugf("Can't read Ambari server version to check feature %s: %s", feature, err.Error())
+		return false
+	}
+
+	return !isFeatureSupported(feature, version)
+}
+
+// isFeatureSupported return true if the feature exist in the Ambari version
+func isFeatureSupported(feature Feature, version string) bool {
+
+	minVersion, ok := featureVersions[feature]
+	if !ok {
+		return false
+	}
+
+	return compareVersions(version, minVersion) >= 0
+}
+
+// compareVersions return -1 if version1 is lower than version2, 1 if it's greater, else 0
+// The versions are like 2.7.5.0, the not numeric parts are ignored
+func compareVersions(version1 string, version2 string) int {
+
+	parts1 := strings.Split(version1, ".")
+	parts2 := strings.Split(version2, ".")
+	for index := 0; index < len(parts1) || index < len(parts2); index++ {
+		number1 := versionNumber(parts1, index)
+		number2 := versionNumber(parts2, index)
+		if number1 < number2 {
+			return -1
+		}
+		if number1 > number2 {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionNumber(parts []string, index int) int {
+
+	if index >= len(parts) {
+		return 0
+	}
+	part := parts[index]
+	if end := strings.IndexFunc(part, func(r rune) bool { return r < '0' || r > '9' }); end >= 0 {
+		part = part[:end]
+	}
+	number, _ := strconv.Atoi(part)
+
+	return number
+}
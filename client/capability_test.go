@@ -0,0 +1,122 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCompareVersions(t *testing.T) {
+
+	assert.Equal(t, 0, compareVersions("2.7.5.0", "2.7.5.0"))
+	assert.Equal(t, 0, compareVersions("2.7", "2.7.0.0"))
+	assert.Equal(t, 1, compareVersions("2.7.5.0", "2.7.0"))
+	assert.Equal(t, -1, compareVersions("2.6.2.2", "2.7.0"))
+	assert.Equal(t, 1, compareVersions("2.10.0", "2.7.0"))
+	assert.Equal(t, 0, compareVersions("2.7.5.0-72", "2.7.5.0"))
+}
+
+func TestSupports(t *testing.T) {
+
+	calls := 0
+	var repositoryBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/AMBARI/components/AMBARI_SERVER":
+			calls++
+			w.Write([]byte(`{"RootServiceComponents": {"component_name": "AMBARI_SERVER", "component_version": "2.5.2.0"}}`))
+		case "/stacks/HDP/versions/2.6/repository_versions/1":
+			body, _ := ioutil.ReadAll(r.Body)
+			repositoryBody = string(body)
+			w.WriteHeader(500)
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	// Read version once
+	client := New(server.URL, "admin", "admin")
+	assert.True(t, client.Supports(FEATURE_SETTINGS))
+	assert.True(t, client.Supports(FEATURE_QUICKLINKS_PROFILE))
+	assert.False(t, client.Supports(FEATURE_REPOSITORY_AMBARI_MANAGED))
+	assert.False(t, client.Supports(FEATURE_USER_API_V2))
+	assert.False(t, client.Supports(Feature("unknown")))
+	assert.Equal(t, 1, calls)
+
+	// Adapt payload
+	_, err := client.UpdateRepository(&Repository{
+		RepositoryVersion: &RepositoryVersion{Id: 1, StackName: "HDP", StackVersion: "2.6"},
+		OS: []OS{
+			{OSInfo: &OSInfo{Type: "redhat7", ManagedRepository: true}},
+		},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, repositoryBody, "redhat7")
+	assert.NotContains(t, repositoryBody, "ambari_managed_repositories")
+
+	// Set version
+	client.SetServerVersion("2.7.5.0")
+	assert.True(t, client.Supports(FEATURE_USER_API_V2))
+	assert.Equal(t, 1, calls)
+
+	// Can't read version
+	client = New(server.URL+"/bad", "admin", "admin")
+	assert.False(t, client.Supports(FEATURE_SETTINGS))
+	assert.False(t, client.notSupports(FEATURE_SETTINGS))
+}
+
+func TestServerVersionReadOnce(t *testing.T) {
+
+	var calls int32
+	failed := int32(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		if atomic.LoadInt32(&failed) == 1 {
+			w.WriteHeader(500)
+			return
+		}
+		w.Write([]byte(`{"RootServiceComponents": {"component_name": "AMBARI_SERVER", "component_version": "2.7.5.0"}}`))
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	// The failure is kept until the retry delay
+	_, err := client.ServerVersion()
+	assert.Error(t, err)
+	_, err2 := client.ServerVersion()
+	assert.Equal(t, err, err2)
+	assert.False(t, client.Supports(FEATURE_SETTINGS))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, 1, client.capabilities.failures)
+
+	// The delay is doubled on each failure
+	client.capabilities.retryAfter = time.Now()
+	_, err = client.ServerVersion()
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	assert.True(t, client.capabilities.retryAfter.After(time.Now().Add(serverVersionRetryDelay)))
+
+	// The concurrent calls wait the same read
+	atomic.StoreInt32(&failed, 0)
+	client.capabilities.retryAfter = time.Now()
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			version, err := client.ServerVersion()
+			assert.NoError(t, err)
+			assert.Equal(t, "2.7.5.0", version)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.Equal(t, 0, client.capabilities.failures)
+}
@@ -4,23 +4,72 @@ package client
 
 import (
 	"crypto/tls"
+	"errors"
 	"gopkg.in/resty.v1"
+	"net/http"
+	"time"
 )
 
 // Ambari client object
 type AmbariClient struct {
-	client *resty.Client
+	client         *resty.Client
+	log            *clientLogger
+	capabilities   *capabilities
+	requestTimeout time.Duration
+	pollPolicy     *PollPolicy
+	validation     bool
+	// derived is true on the copy returned by WithOptions, that share the transport of its parent
+	derived bool
 }
 type Response struct {
 	Href *string `json:"href,omitempty"`
 }
 
+// transportWrapper is implemented by the round trippers that the client add on top of the resty transport, like the retry
+type transportWrapper interface {
+	http.RoundTripper
+	Unwrap() http.RoundTripper
+}
+
 // New permit to create new Ambari client
 // It return AmbariClient
 func New(baseUrl string, login string, password string) *AmbariClient {
-	return &AmbariClient{
-		client: resty.New().SetHostURL(baseUrl).SetHeader("X-Requested-By", "ambari").SetBasicAuth(login, password),
+	return newAmbariClient(resty.New(), baseUrl, login, password)
+}
+
+// NewWithHTTPClient permit to create new Ambari client that use the given http.Client
+// It permit to use custom round tripper (like tracing or proxy auth) or tune the connection pool. The TLS, proxy and auth options of the client are added on top of its transport.
+// It return AmbariClient
+func NewWithHTTPClient(baseUrl string, login string, password string, httpClient *http.Client) *AmbariClient {
+
+	if httpClient == nil {
+		panic("HttpClient can't be nil")
+	}
+
+	return newAmbariClient(resty.NewWithClient(httpClient), baseUrl, login, password)
+}
+
+func newAmbariClient(client *resty.Client, baseUrl string, login string, password string) *AmbariClient {
+	c := &AmbariClient{
+		client:       client.SetHostURL(baseUrl).SetHeader("X-Requested-By", "ambari").SetBasicAuth(login, password),
+		log:          newClientLogger(),
+		capabilities: &capabilities{},
 	}
+	client.OnAfterResponse(c.logCall)
+
+	return c
+}
+
+// logCall write the method, the path, the status and the duration of each call as log fields
+func (c *AmbariClient) logCall(client *resty.Client, resp *resty.Response) error {
+
+	path := resp.Request.URL
+	if resp.RawResponse != nil && resp.RawResponse.Request != nil {
+		path = resp.RawResponse.Request.URL.Path
+	}
+	c.log.Debugw("Call Ambari API", "method", resp.Request.Method, "path", path, "status", resp.StatusCode(), "duration", resp.Time().String())
+
+	return nil
 }
 
 // Pertmit to set custom resty.Client for advance option
@@ -30,7 +79,7 @@ func (c *AmbariClient) SetClient(client *resty.Client) {
 		panic("Client can't be empty")
 	}
 
-	c.client = client
+	c.client = client.OnAfterResponse(c.logCall)
 }
 
 // Client permit to return resty.Client Object
@@ -38,7 +87,84 @@ func (c *AmbariClient) Client() *resty.Client {
 	return c.client
 }
 
+// loginName return the user name used with basic auth, or empty string when the client use token or Kerberos
+func (c *AmbariClient) loginName() string {
+
+	if c.client.UserInfo == nil {
+		return ""
+	}
+
+	return c.client.UserInfo.Username
+}
+
 // DisableVerifySSL permit to disable the SSL certificat check when call Ambari webservice
 func (c *AmbariClient) DisableVerifySSL() {
-	c.client = c.client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
+	c.checkOwnTransport()
+	transport, err := c.transport()
+	if err != nil {
+		c.log.Error(err)
+		return
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.InsecureSkipVerify = true
+}
+
+// transport return the http.Transport used to call Ambari, under the round trippers added by the client
+// It return error if the resty client use custom round tripper
+func (c *AmbariClient) transport() (*http.Transport, error) {
+
+	current := c.client.GetClient().Transport
+	if current == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		c.client.SetTransport(transport)
+		return transport, nil
+	}
+	for {
+		switch transport := current.(type) {
+		case *http.Transport:
+			return transport, nil
+		case transportWrapper:
+			current = transport.Unwrap()
+		default:
+			return nil, errors.New("The transport is not an *http.Transport")
+		}
+	}
+}
+
+// findTransport return the first round tripper added by the client that match
+func (c *AmbariClient) findTransport(match func(transport http.RoundTripper) bool) http.RoundTripper {
+
+	current := c.client.GetClient().Transport
+	for current != nil {
+		if match(current) {
+			return current
+		}
+		wrapper, ok := current.(transportWrapper)
+		if !ok {
+			return nil
+		}
+		current = wrapper.Unwrap()
+	}
+
+	return nil
+}
+
+// checkOwnTransport panic if the client share the transport of its parent, because changing it would change the parent too
+func (c *AmbariClient) checkOwnTransport() {
+	if c.derived {
+		panic("The transport of client returned by WithOptions can't be changed, change it on the parent client")
+	}
+}
+
+// wrapTransport permit to add round tripper on top of the current transport
+// It's not safe while calls are sent, because http.Client read its transport on each call. So the setters wrap the transport only on their first call,
+// then they change the round tripper under its mutex.
+func (c *AmbariClient) wrapTransport(wrap func(next http.RoundTripper) http.RoundTripper) {
+
+	if c.client.GetClient().Transport == nil {
+		c.client.SetTransport(http.DefaultTransport.(*http.Transport).Clone())
+	}
+	c.client.SetTransport(wrap(c.client.GetClient().Transport))
 }
@@ -0,0 +1,287 @@
+// This file permit to manage the clients of many Ambari servers from one configuration file
+// Each Ambari is identified by alias, like paris or london, and its client is created the first time it's asked.
+
+package client
+
+import (
+	"encoding/json"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ClientConfig permit to describe how to connect on one Ambari
+// The password and the token can be read from secret backend with PasswordSecret and TokenSecret, instead of be written in file.
+type ClientConfig struct {
+	Alias          string          `json:"alias,omitempty" yaml:"alias,omitempty"`
+	URL            string          `json:"url,omitempty" yaml:"url,omitempty"`
+	ClusterName    string          `json:"cluster_name,omitempty" yaml:"cluster_name,omitempty"`
+	Login          string          `json:"login,omitempty" yaml:"login,omitempty"`
+	Password       string          `json:"password,omitempty" yaml:"password,omitempty"`
+	PasswordSecret string          `json:"password_secret,omitempty" yaml:"password_secret,omitempty"`
+	TokenSecret    string          `json:"token_secret,omitempty" yaml:"token_secret,omitempty"`
+	Kerberos       *KerberosConfig `json:"kerberos,omitempty" yaml:"kerberos,omitempty"`
+	TLS            *TLSConfig      `json:"tls,omitempty" yaml:"tls,omitempty"`
+	Retry          *RetryPolicy    `json:"retry,omitempty" yaml:"retry,omitempty"`
+	Proxy          string          `json:"proxy,omitempty" yaml:"proxy,omitempty"`
+}
+
+// ClientManagerConfig is the list of Ambari to manage
+// The Defaults are used for all Ambari, each Ambari can override them.
+type ClientManagerConfig struct {
+	Defaults ClientConfig   `json:"defaults" yaml:"defaults"`
+	Clients  []ClientConfig `json:"clients" yaml:"clients"`
+}
+
+// SecretProvider permit to read the passwords and the tokens from secret backend, like Vault
+type SecretProvider interface {
+	// Secret return the secret value, or error if not found
+	Secret(name string) (string, error)
+}
+
+// SecretProviderFunc permit to use function as SecretProvider
+type SecretProviderFunc func(name string) (string, error)
+
+// Secret call the function
+func (f SecretProviderFunc) Secret(name string) (string, error) {
+	return f(name)
+}
+
+// ClientManager hold the clients of many Ambari, by alias
+// It can be used by many goroutines
+type ClientManager struct {
+	mutex   sync.Mutex
+	configs map[string]ClientConfig
+	clients map[string]*AmbariClient
+	secrets SecretProvider
+}
+
+// String return client configuration as Json string, without the credentials
+func (cc *ClientConfig) String() string {
+	json, _ := json.Marshal(cc)
+	return redact(string(json))
+}
+
+// UnmarshalClientManagerConfig permit to read the configuration of client manager in CONFIG_FORMAT_JSON or CONFIG_FORMAT_YAML
+func UnmarshalClientManagerConfig(data []byte, format string) (*ClientManagerConfig, error) {
+
+	config := &ClientManagerConfig{}
+	var err error
+	switch format {
+	case CONFIG_FORMAT_JSON:
+		err = json.Unmarshal(data, config)
+	case CONFIG_FORMAT_YAML:
+		err = yaml.Unmarshal(data, config)
+	default:
+		return nil, NewAmbariError(400, "Format %s is not supported", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// NewClientManager permit to create the client manager from its configuration
+// The secrets can be nil if no password or token come from secret backend
+// It return error if an Ambari has not alias or URL, or if the alias is used many times
+func NewClientManager(config *ClientManagerConfig, secrets SecretProvider) (*ClientManager, error) {
+
+	if config == nil {
+		panic("Config can't be nil")
+	}
+
+	manager := &ClientManager{
+		configs: make(map[string]ClientConfig, len(config.Clients)),
+		clients: make(map[string]*AmbariClient, len(config.Clients)),
+		secrets: secrets,
+	}
+	for _, clientConfig := range config.Clients {
+		clientConfig = mergeClientConfig(config.Defaults, clientConfig)
+		if clientConfig.Alias == "" {
+			return nil, NewAmbariError(400, "Alias can't be empty")
+		}
+		if clientConfig.URL == "" {
+			return nil, NewAmbariError(400, "URL of %s can't be empty", clientConfig.Alias)
+		}
+		if _, ok := manager.configs[clientConfig.Alias]; ok {
+			return nil, NewAmbariError(409, "Alias %s is used many times", clientConfig.Alias)
+		}
+		if clientConfig.ClusterName == "" {
+			clientConfig.ClusterName = clientConfig.Alias
+		}
+		manager.configs[clientConfig.Alias] = clientConfig
+	}
+
+	return manager, nil
+}
+
+// LoadClientManager permit to create the client manager from Json or Yaml file, the format is chosen with the file extension
+// It return error if it can't read the file or if the configuration is invalid
+func LoadClientManager(path string, secrets SecretProvider) (*ClientManager, error) {
+
+	format := CONFIG_FORMAT_JSON
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		format = CONFIG_FORMAT_YAML
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config, err := UnmarshalClientManagerConfig(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientManager(config, secrets)
+}
+
+// Aliases return the aliases of all Ambari, sorted
+func (m *ClientManager) Aliases() []string {
+
+	aliases := make([]string, 0, len(m.configs))
+	for alias := range m.configs {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	return aliases
+}
+
+// Config return the configuration of Ambari, with the defaults applied
+// It return nil if alias not found
+func (m *ClientManager) Config(alias string) *ClientConfig {
+
+	clientConfig, ok := m.configs[alias]
+	if !ok {
+		return nil
+	}
+
+	return &clientConfig
+}
+
+// ClusterName return the cluster name managed by Ambari, it's the alias if not set
+// It return error with code 404 if alias not found
+func (m *ClientManager) ClusterName(alias string) (string, error) {
+
+	clientConfig, ok := m.configs[alias]
+	if !ok {
+		return "", NewAmbariError(404, "Ambari %s not found", alias)
+	}
+
+	return clientConfig.ClusterName, nil
+}
+
+// Client return the client of Ambari, it's created the first time and then reused
+// It return error with code 404 if alias not found, or error if it can't set the TLS, proxy or authentication options
+func (m *ClientManager) Client(alias string) (*AmbariClient, error) {
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if client, ok := m.clients[alias]; ok {
+		return client, nil
+	}
+	clientConfig, ok := m.configs[alias]
+	if !ok {
+		return nil, NewAmbariError(404, "Ambari %s not found", alias)
+	}
+	client, err := m.newClient(&clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	m.clients[alias] = client
+
+	return client, nil
+}
+
+// newClient create the client with the options of configuration
+func (m *ClientManager) newClient(clientConfig *ClientConfig) (*AmbariClient, error) {
+
+	password := clientConfig.Password
+	if clientConfig.PasswordSecret != "" {
+		secret, err := m.secret(clientConfig.PasswordSecret)
+		if err != nil {
+			return nil, err
+		}
+		password = secret
+	}
+
+	client := New(clientConfig.URL, clientConfig.Login, password)
+	client.log.Debug("Client config: ", clientConfig)
+	if clientConfig.TLS != nil {
+		if err := client.SetTLSConfig(clientConfig.TLS); err != nil {
+			return nil, err
+		}
+	}
+	if clientConfig.Proxy != "" {
+		if err := client.SetProxy(clientConfig.Proxy); err != nil {
+			return nil, err
+		}
+	}
+	if clientConfig.Retry != nil {
+		client.SetRetryPolicy(clientConfig.Retry)
+	}
+	if clientConfig.Kerberos != nil {
+		if err := client.SetKerberosAuth(clientConfig.Kerberos); err != nil {
+			return nil, err
+		}
+	}
+	if clientConfig.TokenSecret != "" {
+		token, err := m.secret(clientConfig.TokenSecret)
+		if err != nil {
+			return nil, err
+		}
+		client.SetBearerTokenAuth(StaticToken(token))
+	}
+
+	return client, nil
+}
+
+func (m *ClientManager) secret(name string) (string, error) {
+
+	if m.secrets == nil {
+		return "", NewAmbariError(400, "Secret %s is used but there are no secret provider", name)
+	}
+
+	return m.secrets.Secret(name)
+}
+
+// mergeClientConfig return the configuration of Ambari, with the defaults for the options it not set
+// The options TLS, Retry and Kerberos are replaced as a whole, they are not merged field by field
+func mergeClientConfig(defaults ClientConfig, clientConfig ClientConfig) ClientConfig {
+
+	merged := clientConfig
+	if merged.URL == "" {
+		merged.URL = defaults.URL
+	}
+	if merged.Login == "" {
+		merged.Login = defaults.Login
+	}
+	if merged.Password == "" && merged.PasswordSecret == "" {
+		merged.Password = defaults.Password
+		merged.PasswordSecret = defaults.PasswordSecret
+	}
+	if merged.TokenSecret == "" {
+		merged.TokenSecret = defaults.TokenSecret
+	}
+	if merged.Kerberos == nil {
+		merged.Kerberos = defaults.Kerberos
+	}
+	if merged.TLS == nil {
+		merged.TLS = defaults.TLS
+	}
+	if merged.Retry == nil {
+		merged.Retry = defaults.Retry
+	}
+	if merged.Proxy == "" {
+		merged.Proxy = defaults.Proxy
+	}
+
+	return merged
+}
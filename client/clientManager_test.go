@@ -0,0 +1,148 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestClientManager(t *testing.T) {
+
+	authorizations := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations[r.URL.Path] = r.Header.Get("Authorization")
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	defer server.Close()
+
+	data := []byte(`
+defaults:
+  url: ` + server.URL + `
+  login: admin
+  password_secret: ambari/admin
+  retry:
+    max_attempts: 3
+    min_backoff: 2s
+clients:
+  - alias: paris
+    cluster_name: prod-paris
+  - alias: london
+    url: ` + server.URL + `/london
+    password: london-password
+    retry:
+      max_attempts: 1
+  - alias: tokyo
+    token_secret: ambari/tokyo/token
+`)
+	path := filepath.Join(t.TempDir(), "clients.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, data, 0600))
+	secrets := SecretProviderFunc(func(name string) (string, error) {
+		switch name {
+		case "ambari/admin":
+			return "secret-password", nil
+		case "ambari/tokyo/token":
+			return "tokyo-token", nil
+		}
+		return "", NewAmbariError(404, "Secret %s not found", name)
+	})
+	manager, err := LoadClientManager(path, secrets)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"london", "paris", "tokyo"}, manager.Aliases())
+
+	// Defaults and overrides
+	config := manager.Config("paris")
+	assert.Equal(t, server.URL, config.URL)
+	assert.Equal(t, 3, config.Retry.MaxAttempts)
+	assert.Equal(t, 2*time.Second, config.Retry.MinBackoff)
+	assert.Equal(t, 1, manager.Config("london").Retry.MaxAttempts)
+	assert.Equal(t, "london-password", manager.Config("london").Password)
+	assert.Equal(t, "", manager.Config("london").PasswordSecret)
+	assert.Nil(t, manager.Config("berlin"))
+	assert.NotContains(t, manager.Config("london").String(), "london-password")
+
+	clusterName, err := manager.ClusterName("paris")
+	assert.NoError(t, err)
+	assert.Equal(t, "prod-paris", clusterName)
+	clusterName, err = manager.ClusterName("london")
+	assert.NoError(t, err)
+	assert.Equal(t, "london", clusterName)
+
+	// Clients with their authentication
+	client, err := manager.Client("paris")
+	assert.NoError(t, err)
+	sameClient, err := manager.Client("paris")
+	assert.NoError(t, err)
+	assert.True(t, client == sameClient)
+	_, err = client.Cluster("test")
+	assert.NoError(t, err)
+	username, password, _ := (&http.Request{Header: http.Header{"Authorization": {authorizations["/clusters/test"]}}}).BasicAuth()
+	assert.Equal(t, "admin", username)
+	assert.Equal(t, "secret-password", password)
+
+	client, err = manager.Client("london")
+	assert.NoError(t, err)
+	_, err = client.Cluster("test")
+	assert.NoError(t, err)
+	_, password, _ = (&http.Request{Header: http.Header{"Authorization": {authorizations["/london/clusters/test"]}}}).BasicAuth()
+	assert.Equal(t, "london-password", password)
+
+	client, err = manager.Client("tokyo")
+	assert.NoError(t, err)
+	_, err = client.Cluster("other")
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer tokyo-token", authorizations["/clusters/other"])
+
+	// Unknown alias
+	_, err = manager.Client("berlin")
+	assert.Error(t, err)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestClientManagerJson(t *testing.T) {
+
+	data := []byte(`{
+		"defaults": {"url": "https://ambari:8443/api/v1", "login": "admin", "retry": {"max_attempts": 3, "min_backoff": "2s", "max_backoff": "1m30s"}},
+		"clients": [{"alias": "paris", "password": "secret"}, {"alias": "london", "retry": {"max_attempts": 2, "min_backoff": 500000000}}]
+	}`)
+	path := filepath.Join(t.TempDir(), "clients.json")
+	assert.NoError(t, ioutil.WriteFile(path, data, 0600))
+	manager, err := LoadClientManager(path, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"london", "paris"}, manager.Aliases())
+	assert.Equal(t, &RetryPolicy{MaxAttempts: 3, MinBackoff: 2 * time.Second, MaxBackoff: 90 * time.Second}, manager.Config("paris").Retry)
+	// The number is read as nanoseconds
+	assert.Equal(t, 500*time.Millisecond, manager.Config("london").Retry.MinBackoff)
+	assert.Contains(t, manager.Config("paris").String(), `"min_backoff":"2s"`)
+
+	_, err = UnmarshalClientManagerConfig([]byte(`{"clients": [{"alias": "paris", "retry": {"min_backoff": "two seconds"}}]}`), CONFIG_FORMAT_JSON)
+	assert.Error(t, err)
+}
+
+func TestClientManagerInvalidConfig(t *testing.T) {
+
+	_, err := NewClientManager(&ClientManagerConfig{Clients: []ClientConfig{{Alias: "paris"}}}, nil)
+	assert.Error(t, err)
+
+	_, err = NewClientManager(&ClientManagerConfig{
+		Defaults: ClientConfig{URL: "https://ambari:8443/api/v1"},
+		Clients:  []ClientConfig{{Alias: "paris"}, {Alias: "paris"}},
+	}, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 409, err.(AmbariError).Code)
+
+	// Secret without provider
+	manager, err := NewClientManager(&ClientManagerConfig{
+		Clients: []ClientConfig{{Alias: "paris", URL: "https://ambari:8443/api/v1", PasswordSecret: "ambari/admin"}},
+	}, nil)
+	assert.NoError(t, err)
+	_, err = manager.Client("paris")
+	assert.Error(t, err)
+
+	_, err = LoadClientManager(filepath.Join(os.TempDir(), "not-exist.yaml"), nil)
+	assert.Error(t, err)
+}
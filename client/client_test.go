@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -165,3 +168,41 @@ func (s *ClientTestSuite) WaitClusterTask() {
 	}
 
 }
+
+type countTransport struct {
+	calls int
+}
+
+func (t *countTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestNewWithHTTPClient(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Requested-By") != "ambari" {
+			w.WriteHeader(400)
+			return
+		}
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	defer server.Close()
+
+	transport := &countTransport{}
+	client := NewWithHTTPClient(server.URL, "admin", "admin", &http.Client{Transport: transport})
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	assert.Equal(t, 1, transport.calls)
+
+	// The client options use the custom transport
+	client.SetRetryPolicy(DefaultRetryPolicy())
+	_, err = client.Cluster("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, transport.calls)
+
+	// Can't set TLS on custom transport
+	err = client.SetTLSConfig(&TLSConfig{InsecureSkipVerify: true})
+	assert.Error(t, err)
+}
@@ -6,7 +6,18 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
+	"sort"
+	"strings"
+)
+
+const (
+	SECURITY_KERBEROS = "KERBEROS"
+	SECURITY_NONE     = "NONE"
+
+	KEYTAB_CONFIG_UPDATE_NONE               = "none"
+	KEYTAB_CONFIG_UPDATE_IDENTITIES_ONLY    = "identities_only"
+	KEYTAB_CONFIG_UPDATE_NEW_AND_IDENTITIES = "new_and_identities"
+	KEYTAB_CONFIG_UPDATE_ALL                = "all"
 )
 
 // Cluster item
@@ -17,10 +28,20 @@ type Cluster struct {
 	SessionAttributes map[string]map[string]string `json:"session_attributes,omitempty"`
 }
 type ClusterInfo struct {
-	ClusterId    int64  `json:"cluster_id,omitempty"`
-	ClusterName  string `json:"cluster_name"`
-	Version      string `json:"version,omitempty"`
-	SecurityType string `json:"security_type,omitempty"`
+	ClusterId      int64                    `json:"cluster_id,omitempty"`
+	ClusterName    string                   `json:"cluster_name"`
+	Version        string                   `json:"version,omitempty"`
+	SecurityType   string                   `json:"security_type,omitempty"`
+	DesiredConfigs map[string]Configuration `json:"desired_configs,omitempty"`
+}
+
+// KeytabScope permit to limit the keytabs to regenerate
+// Components is the list of components by service, all components of service are used when its list is empty.
+// ConfigUpdatePolicy is one of KEYTAB_CONFIG_UPDATE_*, Ambari use KEYTAB_CONFIG_UPDATE_ALL when it's empty.
+type KeytabScope struct {
+	Hosts              []string
+	Components         map[string][]string
+	ConfigUpdatePolicy string
 }
 
 // String permit to return cluster object as Json string
@@ -38,14 +59,21 @@ func (c *Cluster) CleanBeforeSave() {
 }
 
 // Create cluster eprmit to create new HDP cluster on Ambari
+// The version, like HDP-2.6, and the security type, NONE or KERBEROS, can be set on ClusterInfo. Ambari use NONE when security type is empty.
 // It return the cluster object if all work fine
-// It return error if something wrong when it call the API
+// It return error if security type is not supported or if something wrong when it call the API
 func (c *AmbariClient) CreateCluster(cluster *Cluster) (*Cluster, error) {
 
 	if cluster == nil {
 		panic("Cluster can't be nil")
 	}
-	log.Debug("Cluster: ", cluster)
+	c.log.Debugw("CreateCluster", "cluster", cluster)
+
+	switch cluster.ClusterInfo.SecurityType {
+	case "", SECURITY_NONE, SECURITY_KERBEROS:
+	default:
+		return nil, NewAmbariError(400, "Security type must be %s or %s, got %s", SECURITY_NONE, SECURITY_KERBEROS, cluster.ClusterInfo.SecurityType)
+	}
 
 	// Create the Cluster
 	path := fmt.Sprintf("/clusters/%s", cluster.ClusterInfo.ClusterName)
@@ -57,9 +85,9 @@ func (c *AmbariClient) CreateCluster(cluster *Cluster) (*Cluster, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the cluster
@@ -80,6 +108,28 @@ func (c *AmbariClient) CreateCluster(cluster *Cluster) (*Cluster, error) {
 // It return error if something wrong when it call the API
 func (c *AmbariClient) CreateClusterFromTemplate(name string, jsonClusterTemplate string) (*Cluster, error) {
 
+	_, err := c.ProvisionClusterFromTemplate(name, jsonClusterTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the cluster
+	cluster, err := c.Cluster(name)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, NewAmbariError(500, "Can't get cluster that just created")
+	}
+
+	return cluster, err
+}
+
+// ProvisionClusterFromTemplate permit to create new cluster from template file, like CreateClusterFromTemplate.
+// It return the request that install and start the services, to follow the provisioning
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ProvisionClusterFromTemplate(name string, jsonClusterTemplate string) (*RequestTask, error) {
+
 	if name == "" {
 		panic("Name can't be empty")
 	}
@@ -91,8 +141,8 @@ func (c *AmbariClient) CreateClusterFromTemplate(name string, jsonClusterTemplat
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Name: %s", name)
-	log.Debugf("JsonClusterTemplate: %s", jsonClusterTemplate)
+	c.log.Debugf("Name: %s", name)
+	c.log.Debugf("JsonClusterTemplate: %s", jsonClusterTemplate)
 
 	// Create the Cluster
 	path := fmt.Sprintf("/clusters/%s", name)
@@ -100,44 +150,43 @@ func (c *AmbariClient) CreateClusterFromTemplate(name string, jsonClusterTemplat
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
-	}
-
-	// Get the cluster
-	cluster, err := c.Cluster(name)
-	if err != nil {
-		return nil, err
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
-	if cluster == nil {
-		return nil, NewAmbariError(500, "Can't get cluster that just created")
+	requestTask := &RequestTask{}
+	if len(resp.Body()) > 0 {
+		err = json.Unmarshal(resp.Body(), requestTask)
+		if err != nil {
+			return nil, err
+		}
 	}
+	c.log.Debugf("Return request: %s", requestTask)
 
-	return cluster, err
+	return requestTask, nil
 }
 
 // Cluster permit to return cluster object from is name
 // It return cluster object if found
 // It return nil if cluster is not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Cluster(clusterName string) (*Cluster, error) {
+func (c *AmbariClient) Cluster(clusterName string, opts ...RequestOption) (*Cluster, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
 	path := fmt.Sprintf("/clusters/%s", clusterName)
 
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	cluster := &Cluster{}
@@ -145,7 +194,7 @@ func (c *AmbariClient) Cluster(clusterName string) (*Cluster, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Cluster: ", cluster)
+	c.log.Debug("Cluster: ", cluster)
 
 	return cluster, nil
 }
@@ -162,8 +211,7 @@ func (c *AmbariClient) RenameCluster(oldClusterName string, cluster *Cluster) (*
 	if cluster == nil {
 		panic("Cluster can't be nil")
 	}
-	log.Debug("OldClusterName: ", oldClusterName)
-	log.Debug("Cluster: ", cluster)
+	c.log.Debugw("RenameCluster", "old_cluster_name", oldClusterName, "cluster", cluster)
 
 	// Update the Cluster
 	path := fmt.Sprintf("/clusters/%s", oldClusterName)
@@ -180,9 +228,9 @@ func (c *AmbariClient) RenameCluster(oldClusterName string, cluster *Cluster) (*
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the cluster
@@ -194,7 +242,7 @@ func (c *AmbariClient) RenameCluster(oldClusterName string, cluster *Cluster) (*
 		return nil, NewAmbariError(500, "Can't get cluster that just updated")
 	}
 
-	log.Debug("Cluster: ", cluster)
+	c.log.Debug("Cluster: ", cluster)
 
 	return cluster, err
 
@@ -208,10 +256,10 @@ func (c *AmbariClient) ManageKerberosOnCluster(cluster *Cluster) (*Cluster, erro
 	if cluster == nil {
 		panic("Cluster can't be nil")
 	}
-	log.Debug("Cluster: ", cluster)
+	c.log.Debugw("ManageKerberosOnCluster", "cluster", cluster)
 
 	context := "Disable kerberos from API"
-	if cluster.ClusterInfo.SecurityType == "KERBEROS" {
+	if cluster.ClusterInfo.SecurityType == SECURITY_KERBEROS {
 		context = "Enable kerberos from API"
 	}
 
@@ -237,7 +285,7 @@ func (c *AmbariClient) ManageKerberosOnCluster(cluster *Cluster) (*Cluster, erro
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 	}
 
@@ -251,6 +299,85 @@ func (c *AmbariClient) ManageKerberosOnCluster(cluster *Cluster) (*Cluster, erro
 
 }
 
+// RegenerateKeytabs permit to regenerate the keytabs on cluster that is already kerberized, without disable and enable kerberos.
+// Scope permit to limit the regeneration to some hosts and some components, when it's nil all keytabs of cluster are regenerated.
+// When onlyMissing is true, only the keytabs that not exist yet are generated.
+// It not wait the end of request, the services must be restarted when it's finished to use the new keytabs.
+// It return the request ID, 0 if Ambari not create request
+// It return error if something wrong when it call the API
+func (c *AmbariClient) RegenerateKeytabs(clusterName string, scope *KeytabScope, onlyMissing bool) (int, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("RegenerateKeytabs", "cluster_name", clusterName, "scope", scope, "only_missing", onlyMissing)
+
+	queryParams := map[string]string{
+		"regenerate_keytabs": "all",
+	}
+	if onlyMissing {
+		queryParams["regenerate_keytabs"] = "missing"
+	}
+	if scope != nil {
+		if len(scope.Hosts) > 0 {
+			queryParams["regenerate_hosts"] = strings.Join(scope.Hosts, ",")
+		}
+		if len(scope.Components) > 0 {
+			services := make([]string, 0, len(scope.Components))
+			for serviceName := range scope.Components {
+				services = append(services, serviceName)
+			}
+			sort.Strings(services)
+			for index, serviceName := range services {
+				if len(scope.Components[serviceName]) > 0 {
+					services[index] = serviceName + ":" + strings.Join(scope.Components[serviceName], ";")
+				}
+			}
+			queryParams["regenerate_components"] = strings.Join(services, ",")
+		}
+		if scope.ConfigUpdatePolicy != "" {
+			queryParams["config_update_policy"] = scope.ConfigUpdatePolicy
+		}
+	}
+	request := &Request{
+		RequestInfo: &RequestInfo{
+			Context: "Regenerate keytabs from API",
+		},
+		Body: &Cluster{
+			ClusterInfo: &ClusterInfo{
+				ClusterName:  clusterName,
+				SecurityType: SECURITY_KERBEROS,
+			},
+		},
+	}
+	c.log.Debug("Sended Request: ", request)
+
+	path := fmt.Sprintf("/clusters/%s", clusterName)
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := c.Client().R().SetQueryParams(queryParams).SetBody(jsonData).Put(path)
+	if err != nil {
+		return 0, err
+	}
+	c.log.Debug("Response to regenerate keytabs: ", resp)
+	if resp.StatusCode() >= 300 {
+		return 0, NewAmbariErrorFromResponse(resp)
+	}
+	if len(resp.Body()) == 0 {
+		return 0, nil
+	}
+	requestTask := &RequestTask{}
+	err = json.Unmarshal(resp.Body(), requestTask)
+	if err != nil {
+		return 0, err
+	}
+	c.log.Debugf("Return request: %s", requestTask)
+
+	return requestTask.RequestTaskInfo.Id, nil
+}
+
 // DeleteCluster permit to delete existing cluster
 // It need to delete all services and delete all hosts before to delete the cluster
 // It return error if cluster not exist of something wrong when it call the API
@@ -259,7 +386,7 @@ func (c *AmbariClient) DeleteCluster(clusterName string) error {
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("DeleteCluster", "cluster_name", clusterName)
 
 	// Check if cluster exist
 	cluster, err := c.Cluster(clusterName)
@@ -301,9 +428,9 @@ func (c *AmbariClient) DeleteCluster(clusterName string) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete cluster: ", resp)
+	c.log.Debug("Response to delete cluster: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -320,7 +447,7 @@ func (c *AmbariClient) SendRequestCluster(request *Request) (*RequestTask, error
 	if request == nil {
 		panic("Request can't be nil")
 	}
-	log.Debug("Request: ", request)
+	c.log.Debugw("SendRequestCluster", "request", request)
 	cluster := request.Body.(*Cluster)
 	clusterTemp := &Cluster{
 		ClusterInfo: &ClusterInfo{
@@ -331,7 +458,7 @@ func (c *AmbariClient) SendRequestCluster(request *Request) (*RequestTask, error
 	}
 	request.Body = clusterTemp
 
-	log.Debug("Sended Request: ", request)
+	c.log.Debug("Sended Request: ", request)
 
 	path := fmt.Sprintf("/clusters/%s", cluster.ClusterInfo.ClusterName)
 	jsonData, err := json.Marshal(request)
@@ -342,9 +469,9 @@ func (c *AmbariClient) SendRequestCluster(request *Request) (*RequestTask, error
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response when send request: ", resp)
+	c.log.Debug("Response when send request: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
 		return nil, nil
@@ -354,7 +481,7 @@ func (c *AmbariClient) SendRequestCluster(request *Request) (*RequestTask, error
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	return requestTask, err
 
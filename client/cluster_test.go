@@ -2,6 +2,10 @@ package client
 
 import (
 	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"net/http"
+	"net/url"
+	"testing"
 )
 
 func (s *ClientTestSuite) TestCluster() {
@@ -56,3 +60,103 @@ func (s *ClientTestSuite) TestCluster() {
 	// We test it with cli test. It' not easy to test directly.
 
 }
+
+func TestProvisionClusterFromTemplate(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	server.AddBlueprint("test", `{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}}`)
+	requestTask, err := client.ProvisionClusterFromTemplate("test", `{"blueprint": "test", "host_groups": [{"name": "master", "hosts": [{"fqdn": "master01"}]}]}`)
+	assert.NoError(t, err)
+	assert.NotNil(t, requestTask)
+	if requestTask != nil {
+		assert.NotEqual(t, 0, requestTask.RequestTaskInfo.Id)
+		requestTask, err = client.Request("test", requestTask.RequestTaskInfo.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, REQUEST_COMPLETED, requestTask.RequestTaskInfo.Status)
+	}
+	host, err := client.HostOnCluster("test", "master01")
+	assert.NoError(t, err)
+	assert.NotNil(t, host)
+}
+
+func TestRegenerateKeytabs(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Kerberos is not enabled
+	_, err := client.RegenerateKeytabs("test", nil, false)
+	assert.Error(t, err)
+
+	_, err = client.ManageKerberosOnCluster(&Cluster{ClusterInfo: &ClusterInfo{ClusterName: "test", SecurityType: SECURITY_KERBEROS}})
+	assert.NoError(t, err)
+
+	// Keep the query of calls that regenerate keytabs
+	var query url.Values
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut && r.URL.Query().Get("regenerate_keytabs") != "" {
+			query = r.URL.Query()
+		}
+		handler.ServeHTTP(w, r)
+	})
+
+	id, err := client.RegenerateKeytabs("test", nil, false)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, id)
+	assert.Equal(t, url.Values{"regenerate_keytabs": {"all"}}, query)
+	requestTask, err := client.Request("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, requestTask)
+
+	// With scope
+	id, err = client.RegenerateKeytabs("test", &KeytabScope{
+		Hosts:              []string{"worker01", "worker02"},
+		Components:         map[string][]string{"YARN": {"NODEMANAGER"}, "HDFS": {"DATANODE", "NAMENODE"}, "ZOOKEEPER": nil},
+		ConfigUpdatePolicy: KEYTAB_CONFIG_UPDATE_NONE,
+	}, true)
+	assert.NoError(t, err)
+	assert.NotEqual(t, 0, id)
+	assert.Equal(t, url.Values{
+		"regenerate_keytabs":    {"missing"},
+		"regenerate_hosts":      {"worker01,worker02"},
+		"regenerate_components": {"HDFS:DATANODE;NAMENODE,YARN:NODEMANAGER,ZOOKEEPER"},
+		"config_update_policy":  {"none"},
+	}, query)
+}
+
+func TestCreateAndRenameCluster(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Bad security type
+	_, err := client.CreateCluster(&Cluster{ClusterInfo: &ClusterInfo{ClusterName: "test", Version: "HDP-2.6", SecurityType: "LDAP"}})
+	assert.Error(t, err)
+
+	cluster, err := client.CreateCluster(&Cluster{ClusterInfo: &ClusterInfo{ClusterName: "test", Version: "HDP-2.6", SecurityType: SECURITY_KERBEROS}})
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	if cluster != nil {
+		assert.Equal(t, "test", cluster.ClusterInfo.ClusterName)
+		assert.Equal(t, "HDP-2.6", cluster.ClusterInfo.Version)
+		assert.Equal(t, SECURITY_KERBEROS, cluster.ClusterInfo.SecurityType)
+	}
+
+	cluster, err = client.RenameCluster("test", &Cluster{ClusterInfo: &ClusterInfo{ClusterName: "test_archived"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	if cluster != nil {
+		assert.Equal(t, "test_archived", cluster.ClusterInfo.ClusterName)
+		assert.Equal(t, "HDP-2.6", cluster.ClusterInfo.Version)
+	}
+	cluster, err = client.Cluster("test")
+	assert.NoError(t, err)
+	assert.Nil(t, cluster)
+}
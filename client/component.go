@@ -6,11 +6,12 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 const (
 	COMPONENT_CLIENT = "CLIENT"
+	COMPONENT_MASTER = "MASTER"
+	COMPONENT_SLAVE  = "SLAVE"
 )
 
 type Component struct {
@@ -18,11 +19,19 @@ type Component struct {
 	HostComponents []HostComponent `json:"host_components"`
 }
 type ComponentInfo struct {
-	ClusterName   string `json:"cluster_name,omitempty"`
-	ServiceName   string `json:"service_name,omitempty"`
-	ComponentName string `json:"component_name,omitempty"`
-	State         string `json:"state,omitempty"`
-	Category      string `json:"category,omitempty"`
+	ClusterName     string `json:"cluster_name,omitempty"`
+	ServiceName     string `json:"service_name,omitempty"`
+	ComponentName   string `json:"component_name,omitempty"`
+	State           string `json:"state,omitempty"`
+	Category        string `json:"category,omitempty"`
+	RecoveryEnabled string `json:"recovery_enabled,omitempty"`
+	StartedCount    int    `json:"started_count,omitempty"`
+	InstalledCount  int    `json:"installed_count,omitempty"`
+	TotalCount      int    `json:"total_count,omitempty"`
+}
+type ComponentsResponse struct {
+	Response
+	Items []Component `json:"items"`
 }
 
 // String permit to return Component as Json string
@@ -39,16 +48,16 @@ func (c *AmbariClient) CreateComponent(component *Component) (*Component, error)
 	if component == nil {
 		panic("Component can't be nil")
 	}
-	log.Debugf("Component: %s", component.String())
+	c.log.Debugf("Component: %s", component.String())
 
 	path := fmt.Sprintf("/clusters/%s/services/%s/components/%s", component.ComponentInfo.ClusterName, component.ComponentInfo.ServiceName, component.ComponentInfo.ComponentName)
 	resp, err := c.Client().R().Post(path)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	component, err = c.Component(component.ComponentInfo.ClusterName, component.ComponentInfo.ServiceName, component.ComponentInfo.ComponentName)
@@ -59,7 +68,7 @@ func (c *AmbariClient) CreateComponent(component *Component) (*Component, error)
 		return nil, NewAmbariError(500, "Can't get component that just created")
 	}
 
-	log.Debugf("Return component: %s", component)
+	c.log.Debugf("Return component: %s", component)
 
 	return component, nil
 
@@ -69,7 +78,7 @@ func (c *AmbariClient) CreateComponent(component *Component) (*Component, error)
 // It return Component if found
 // It return nil if service Component not found
 // It return error if something wrong when API call
-func (c *AmbariClient) Component(clusterName string, serviceName string, componentName string) (*Component, error) {
+func (c *AmbariClient) Component(clusterName string, serviceName string, componentName string, opts ...RequestOption) (*Component, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -80,21 +89,19 @@ func (c *AmbariClient) Component(clusterName string, serviceName string, compone
 	if componentName == "" {
 		panic("ComponentName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
-	log.Debug("ComponentName: ", componentName)
+	c.log.Debugw("Component", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName)
 
 	path := fmt.Sprintf("/clusters/%s/services/%s/components/%s", clusterName, serviceName, componentName)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	component := &Component{}
@@ -102,7 +109,7 @@ func (c *AmbariClient) Component(clusterName string, serviceName string, compone
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return component: %s", component)
+	c.log.Debugf("Return component: %s", component)
 
 	return component, nil
 }
@@ -122,9 +129,7 @@ func (c *AmbariClient) DeleteComponent(clusterName string, serviceName string, c
 	if componentName == "" {
 		panic("ComponentName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
-	log.Debug("ComponentName: ", componentName)
+	c.log.Debugw("DeleteComponent", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName)
 
 	// Check if component exist
 	component, err := c.Component(clusterName, serviceName, componentName)
@@ -150,9 +155,9 @@ func (c *AmbariClient) DeleteComponent(clusterName string, serviceName string, c
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete service: ", resp)
+	c.log.Debug("Response to delete service: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
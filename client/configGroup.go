@@ -0,0 +1,389 @@
+// This file permit to manage config groups in Ambari API, that override the configurations of service on some hosts
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/config-groups.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ConfigGroup object
+type ConfigGroup struct {
+	ConfigGroupInfo *ConfigGroupInfo `json:"ConfigGroup"`
+}
+type ConfigGroupsResponse struct {
+	Response
+	Items []ConfigGroup `json:"items"`
+}
+type ConfigGroupInfo struct {
+	Id             int64             `json:"id,omitempty"`
+	ClusterName    string            `json:"cluster_name,omitempty"`
+	GroupName      string            `json:"group_name,omitempty"`
+	Tag            string            `json:"tag,omitempty"`
+	ServiceName    string            `json:"service_name,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Hosts          []ConfigGroupHost `json:"hosts"`
+	DesiredConfigs []Configuration   `json:"desired_configs"`
+}
+type ConfigGroupHost struct {
+	Hostname string `json:"host_name"`
+}
+
+// Response return by Ambari when create config group
+type configGroupCreateResponse struct {
+	Resources []struct {
+		ConfigGroupInfo *ConfigGroupInfo `json:"ConfigGroup,omitempty"`
+	} `json:"resources"`
+}
+
+// String return config group object as Json string
+func (g *ConfigGroup) String() string {
+	json, _ := json.Marshal(g)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update config group
+func (g *ConfigGroup) CleanBeforeSave() *ConfigGroup {
+
+	configGroupInfo := *g.ConfigGroupInfo
+	configGroupInfo.Id = 0
+	if configGroupInfo.Hosts == nil {
+		configGroupInfo.Hosts = make([]ConfigGroupHost, 0)
+	}
+	if configGroupInfo.DesiredConfigs == nil {
+		configGroupInfo.DesiredConfigs = make([]Configuration, 0)
+	}
+
+	return &ConfigGroup{
+		ConfigGroupInfo: &configGroupInfo,
+	}
+}
+
+// Hostnames return the sorted name of hosts in config group
+func (g *ConfigGroup) Hostnames() []string {
+
+	hostnames := make([]string, 0, len(g.ConfigGroupInfo.Hosts))
+	for _, host := range g.ConfigGroupInfo.Hosts {
+		hostnames = append(hostnames, host.Hostname)
+	}
+	sort.Strings(hostnames)
+
+	return hostnames
+}
+
+// ConfigGroup return existing config group on cluster
+// It return the config group if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ConfigGroup(clusterName string, id int64, opts ...RequestOption) (*ConfigGroup, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ConfigGroup", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/config_groups/%d", clusterName, id)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	configGroup := &ConfigGroup{}
+	err = json.Unmarshal(resp.Body(), configGroup)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return config group: %s", configGroup)
+
+	return configGroup, nil
+}
+
+// ConfigGroups return all config groups on cluster
+// It return the list of config groups.
+// If not config group, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ConfigGroups(clusterName string, opts ...RequestOption) ([]ConfigGroup, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ConfigGroups", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/config_groups", clusterName)
+	resp, err := c.get(path, opts, Fields("ConfigGroup/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	configGroupsResponse := &ConfigGroupsResponse{}
+	err = json.Unmarshal(resp.Body(), configGroupsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("ConfigGroups: ", configGroupsResponse.Items)
+
+	return configGroupsResponse.Items, nil
+}
+
+// SearchConfigGroup permit to get config group by is name
+// It return the config group if is found
+// It return nil if is not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SearchConfigGroup(clusterName string, groupName string, opts ...RequestOption) (*ConfigGroup, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("SearchConfigGroup", "cluster_name", clusterName, "group_name", groupName)
+
+	path := fmt.Sprintf("/clusters/%s/config_groups", clusterName)
+	resp, err := c.get(path, opts, Fields("ConfigGroup/*"), Where(Eq("ConfigGroup/group_name", groupName)))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	configGroupsResponse := &ConfigGroupsResponse{}
+	err = json.Unmarshal(resp.Body(), configGroupsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("ConfigGroupsResponse: ", configGroupsResponse)
+
+	if len(configGroupsResponse.Items) > 0 {
+		c.log.Debug("ConfigGroup: ", configGroupsResponse.Items[0])
+		return &configGroupsResponse.Items[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// CreateConfigGroup permit to create new config group on cluster
+// The desired configurations must have a new tag and their properties, they are created with the config group
+// It return the config group if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateConfigGroup(configGroup *ConfigGroup) (*ConfigGroup, error) {
+
+	if configGroup == nil {
+		panic("ConfigGroup can't be nil")
+	}
+	if configGroup.ConfigGroupInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("CreateConfigGroup", "config_group", configGroup)
+
+	// Create the config group
+	path := fmt.Sprintf("/clusters/%s/config_groups", configGroup.ConfigGroupInfo.ClusterName)
+	configGroupPayload := configGroup.CleanBeforeSave()
+	jsonData, err := json.Marshal([]*ConfigGroup{configGroupPayload})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Ambari return the id of the config group that just created
+	createResponse := &configGroupCreateResponse{}
+	err = json.Unmarshal(resp.Body(), createResponse)
+	if err != nil {
+		return nil, err
+	}
+	if len(createResponse.Resources) == 0 || createResponse.Resources[0].ConfigGroupInfo == nil {
+		return nil, NewAmbariError(500, "Can't get the id of config group that just created")
+	}
+
+	// Get the config group
+	configGroup, err = c.ConfigGroup(configGroup.ConfigGroupInfo.ClusterName, createResponse.Resources[0].ConfigGroupInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if configGroup == nil {
+		return nil, NewAmbariError(500, "Can't get config group that just created")
+	}
+
+	return configGroup, err
+
+}
+
+// UpdateConfigGroup permit to update existing config group, like its hosts or its configurations
+// The desired configurations replace the current ones. The new configurations must have a new tag and their properties.
+// It return the config group if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateConfigGroup(configGroup *ConfigGroup) (*ConfigGroup, error) {
+
+	if configGroup == nil {
+		panic("ConfigGroup can't be nil")
+	}
+	if configGroup.ConfigGroupInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("UpdateConfigGroup", "config_group", configGroup)
+
+	// Update the config group
+	path := fmt.Sprintf("/clusters/%s/config_groups/%d", configGroup.ConfigGroupInfo.ClusterName, configGroup.ConfigGroupInfo.Id)
+	configGroupPayload := configGroup.CleanBeforeSave()
+	jsonData, err := json.Marshal(configGroupPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the config group after update
+	configGroup, err = c.ConfigGroup(configGroup.ConfigGroupInfo.ClusterName, configGroup.ConfigGroupInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if configGroup == nil {
+		return nil, NewAmbariError(500, "Can't get config group that just updated")
+	}
+
+	return configGroup, err
+
+}
+
+// DeleteConfigGroup permit to delete existing config group on cluster
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteConfigGroup(clusterName string, id int64) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("DeleteConfigGroup", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/config_groups/%d", clusterName, id)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete config group: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
+
+// ApplyConfigGroup permit to create the config group if not exist, or update it if its hosts, its description or its configurations are not the desired ones
+// The config group is found by its name. The desired configurations only need their type and their properties, the tag of new configuration versions is computed.
+// It return the change report, that is empty if the config group is already as desired
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ApplyConfigGroup(configGroup *ConfigGroup) (*ChangeReport, error) {
+
+	if configGroup == nil {
+		panic("ConfigGroup can't be nil")
+	}
+	if configGroup.ConfigGroupInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configGroup.ConfigGroupInfo.GroupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("ApplyConfigGroup", "config_group", configGroup)
+
+	clusterName := configGroup.ConfigGroupInfo.ClusterName
+	report := newChangeReport()
+	currentConfigGroup, err := c.SearchConfigGroup(clusterName, configGroup.ConfigGroupInfo.GroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the current configuration versions that have the desired properties
+	desiredConfigGroup := configGroup.CleanBeforeSave()
+	currentTags := map[string]string{}
+	if currentConfigGroup != nil {
+		for _, configuration := range currentConfigGroup.ConfigGroupInfo.DesiredConfigs {
+			currentTags[configuration.Type] = configuration.Tag
+		}
+	}
+	desiredConfigs := make([]Configuration, 0, len(desiredConfigGroup.ConfigGroupInfo.DesiredConfigs))
+	configurationChanged := len(currentTags) != len(desiredConfigGroup.ConfigGroupInfo.DesiredConfigs)
+	for _, desiredConfig := range desiredConfigGroup.ConfigGroupInfo.DesiredConfigs {
+		if desiredConfig.Type == "" {
+			return nil, NewAmbariError(400, "Type of configuration can't be empty in config group %s", configGroup.ConfigGroupInfo.GroupName)
+		}
+		if tag, ok := currentTags[desiredConfig.Type]; ok {
+			currentConfig, err := c.ConfigurationOnCluster(clusterName, desiredConfig.Type, tag)
+			if err != nil {
+				return nil, err
+			}
+			if currentConfig != nil && sameConfiguration(currentConfig, &desiredConfig) {
+				desiredConfig.Tag = tag
+				desiredConfigs = append(desiredConfigs, desiredConfig)
+				continue
+			}
+		}
+		configurationChanged = true
+		desiredConfig.Tag = newConfigurationTag()
+		desiredConfigs = append(desiredConfigs, desiredConfig)
+	}
+	desiredConfigGroup.ConfigGroupInfo.DesiredConfigs = desiredConfigs
+
+	if currentConfigGroup == nil {
+		report.add(CHANGE_CREATE, "config_group", configGroup.ConfigGroupInfo.GroupName, nil, desiredConfigGroup)
+	} else {
+		desiredConfigGroup.ConfigGroupInfo.Id = currentConfigGroup.ConfigGroupInfo.Id
+		if configurationChanged ||
+			currentConfigGroup.ConfigGroupInfo.Description != desiredConfigGroup.ConfigGroupInfo.Description ||
+			!reflect.DeepEqual(currentConfigGroup.Hostnames(), desiredConfigGroup.Hostnames()) {
+			report.add(CHANGE_UPDATE, "config_group", configGroup.ConfigGroupInfo.GroupName, currentConfigGroup.CleanBeforeSave(), desiredConfigGroup)
+		}
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			_, err = c.CreateConfigGroup(desiredConfigGroup)
+		case CHANGE_UPDATE:
+			_, err = c.UpdateConfigGroup(desiredConfigGroup)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestApplyConfigGroup(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("worker01", "test")
+	server.AddHost("worker02", "test")
+	client := New(server.BaseURL(), "admin", "admin")
+
+	configGroup := &ConfigGroup{
+		ConfigGroupInfo: &ConfigGroupInfo{
+			ClusterName: "test",
+			GroupName:   "big-memory",
+			Tag:         "YARN",
+			Description: "Workers with more memory",
+			Hosts:       []ConfigGroupHost{{Hostname: "worker01"}},
+			DesiredConfigs: []Configuration{
+				{Type: "yarn-site", Properties: map[string]string{"yarn.nodemanager.resource.memory-mb": "65536"}},
+			},
+		},
+	}
+
+	// Create
+	report, err := client.ApplyConfigGroup(configGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_CREATE, report.Changes[0].Action)
+	currentConfigGroup, err := client.SearchConfigGroup("test", "big-memory")
+	assert.NoError(t, err)
+	assert.NotNil(t, currentConfigGroup)
+	assert.Equal(t, []string{"worker01"}, currentConfigGroup.Hostnames())
+	assert.Equal(t, 1, len(currentConfigGroup.ConfigGroupInfo.DesiredConfigs))
+	tag := currentConfigGroup.ConfigGroupInfo.DesiredConfigs[0].Tag
+	configuration, err := client.ConfigurationOnCluster("test", "yarn-site", tag)
+	assert.NoError(t, err)
+	assert.Equal(t, "65536", configuration.Properties["yarn.nodemanager.resource.memory-mb"])
+
+	// Nothing to do
+	report, err = client.ApplyConfigGroup(configGroup)
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+
+	// Add host keep the configuration version
+	configGroup.ConfigGroupInfo.Hosts = append(configGroup.ConfigGroupInfo.Hosts, ConfigGroupHost{Hostname: "worker02"})
+	report, err = client.ApplyConfigGroup(configGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+	currentConfigGroup, err = client.SearchConfigGroup("test", "big-memory")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"worker01", "worker02"}, currentConfigGroup.Hostnames())
+	assert.Equal(t, tag, currentConfigGroup.ConfigGroupInfo.DesiredConfigs[0].Tag)
+
+	// Change properties create new configuration version
+	configGroup.ConfigGroupInfo.DesiredConfigs[0].Properties["yarn.nodemanager.resource.memory-mb"] = "131072"
+	client.SetDryRun(NewDryRunRecorder())
+	report, err = client.ApplyConfigGroup(configGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	client.SetDryRun(nil)
+	currentConfigGroup, err = client.SearchConfigGroup("test", "big-memory")
+	assert.NoError(t, err)
+	assert.Equal(t, tag, currentConfigGroup.ConfigGroupInfo.DesiredConfigs[0].Tag)
+	report, err = client.ApplyConfigGroup(configGroup)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	currentConfigGroup, err = client.SearchConfigGroup("test", "big-memory")
+	assert.NoError(t, err)
+	assert.NotEqual(t, tag, currentConfigGroup.ConfigGroupInfo.DesiredConfigs[0].Tag)
+	configuration, err = client.ConfigurationOnCluster("test", "yarn-site", currentConfigGroup.ConfigGroupInfo.DesiredConfigs[0].Tag)
+	assert.NoError(t, err)
+	assert.Equal(t, "131072", configuration.Properties["yarn.nodemanager.resource.memory-mb"])
+
+	// The configurations of config group are not used by cluster
+	configuration, err = client.DesiredConfigurationOnCluster("test", "yarn-site")
+	assert.NoError(t, err)
+	assert.Nil(t, configuration)
+
+	// Delete
+	assert.NoError(t, client.DeleteConfigGroup("test", currentConfigGroup.ConfigGroupInfo.Id))
+	configGroups, err := client.ConfigGroups("test")
+	assert.NoError(t, err)
+	assert.Empty(t, configGroups)
+}
@@ -6,14 +6,21 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
+	"sync/atomic"
+	"time"
 )
 
 // Object item
 type Configuration struct {
-	Type       string            `json:"type,omitempty"`
-	Tag        string            `json:"tag,omitempty"`
-	Properties map[string]string `json:"properties,omitempty"`
+	Type                 string                       `json:"type,omitempty" yaml:"type,omitempty"`
+	Tag                  string                       `json:"tag,omitempty" yaml:"tag,omitempty"`
+	Properties           map[string]string            `json:"properties,omitempty" yaml:"properties,omitempty"`
+	PropertiesAttributes map[string]map[string]string `json:"properties_attributes,omitempty" yaml:"properties_attributes,omitempty"`
+	Note                 string                       `json:"service_config_version_note,omitempty" yaml:"service_config_version_note,omitempty"`
+}
+type ConfigurationsResponse struct {
+	Response
+	Items []Configuration `json:"items"`
 }
 type DesiredConfig struct {
 	DesiredConfig *Configuration `json:"desired_config,omitempty"`
@@ -28,6 +35,24 @@ func (c *Configuration) String() string {
 	return string(json)
 }
 
+// lastConfigurationTag is the last timestamp used by newConfigurationTag
+var lastConfigurationTag int64
+
+// newConfigurationTag return the tag for new configuration version, like Ambari UI do
+// The tag is unique even when two versions are created in the same millisecond
+func newConfigurationTag() string {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	for {
+		last := atomic.LoadInt64(&lastConfigurationTag)
+		if now <= last {
+			now = last + 1
+		}
+		if atomic.CompareAndSwapInt64(&lastConfigurationTag, last, now) {
+			return fmt.Sprintf("version%d", now)
+		}
+	}
+}
+
 // CreateConfigurationOnCluster permit to add new service confoguration on cluster
 // It return cluster object if all right fine
 // It return error if something wrong
@@ -40,8 +65,8 @@ func (c *AmbariClient) CreateConfigurationOnCluster(clusterName string, configur
 		panic("Configuration can't be empty")
 	}
 
-	log.Debugf("ClusterName: %s", clusterName)
-	log.Debugf("Configuration: %s", configuration)
+	c.log.Debugf("ClusterName: %s", clusterName)
+	c.log.Debugf("Configuration: %s", configuration)
 
 	// Create the configuration
 	path := fmt.Sprintf("/clusters/%s", clusterName)
@@ -58,9 +83,9 @@ func (c *AmbariClient) CreateConfigurationOnCluster(clusterName string, configur
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the cluster
@@ -75,3 +100,127 @@ func (c *AmbariClient) CreateConfigurationOnCluster(clusterName string, configur
 	return cluster, err
 
 }
+
+// ConfigurationOnCluster permit to get configuration on cluster from is type and is tag
+// It return the configuration if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ConfigurationOnCluster(clusterName string, configurationType string, tag string, opts ...RequestOption) (*Configuration, error) {
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	if tag == "" {
+		panic("Tag can't be empty")
+	}
+	c.log.Debugw("ConfigurationOnCluster", "cluster_name", clusterName, "configuration_type", configurationType, "tag", tag)
+
+	path := fmt.Sprintf("/clusters/%s/configurations", clusterName)
+	resp, err := c.get(path, opts, Where(And(
+		Eq("type", configurationType),
+		Eq("tag", tag),
+	)))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	configurationsResponse := &ConfigurationsResponse{}
+	err = json.Unmarshal(resp.Body(), configurationsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("ConfigurationsResponse: ", configurationsResponse)
+
+	if len(configurationsResponse.Items) > 0 {
+		c.log.Debug("Configuration: ", configurationsResponse.Items[0])
+		return &configurationsResponse.Items[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// DesiredConfigurationOnCluster permit to get the configuration currently used by cluster for the given type
+// It return the configuration if is found
+// It return nil if cluster not found or if the type is not used by the cluster
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DesiredConfigurationOnCluster(clusterName string, configurationType string) (*Configuration, error) {
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	c.log.Debugw("DesiredConfigurationOnCluster", "cluster_name", clusterName, "configuration_type", configurationType)
+
+	cluster, err := c.Cluster(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, nil
+	}
+	desiredConfig, ok := cluster.ClusterInfo.DesiredConfigs[configurationType]
+	if !ok {
+		return nil, nil
+	}
+
+	return c.ConfigurationOnCluster(clusterName, configurationType, desiredConfig.Tag)
+}
+
+// UpdateConfigurationProperties permit to set some properties on the configuration currently used by cluster, and to remove others
+// It create new configuration version with the note, like Ambari UI do when you save the service configs. The note can be empty.
+// It return the new configuration, or the current one if it already have these properties
+// It return error if cluster or configuration type not found or if something wrong when it call the API
+func (c *AmbariClient) UpdateConfigurationProperties(clusterName string, configurationType string, properties map[string]string, removedProperties []string, note string) (*Configuration, error) {
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	c.log.Debugw("UpdateConfigurationProperties", "cluster_name", clusterName, "configuration_type", configurationType, "properties", properties, "removed_properties", removedProperties, "note", note)
+
+	currentConfiguration, err := c.DesiredConfigurationOnCluster(clusterName, configurationType)
+	if err != nil {
+		return nil, err
+	}
+	if currentConfiguration == nil {
+		return nil, NewAmbariError(404, "Configuration %s not found on cluster %s", configurationType, clusterName)
+	}
+
+	newConfiguration := &Configuration{
+		Type:                 configurationType,
+		Tag:                  newConfigurationTag(),
+		Properties:           make(map[string]string, len(currentConfiguration.Properties)+len(properties)),
+		PropertiesAttributes: currentConfiguration.PropertiesAttributes,
+		Note:                 note,
+	}
+	for key, value := range currentConfiguration.Properties {
+		newConfiguration.Properties[key] = value
+	}
+	for key, value := range properties {
+		newConfiguration.Properties[key] = value
+	}
+	for _, key := range removedProperties {
+		delete(newConfiguration.Properties, key)
+	}
+	if sameConfiguration(currentConfiguration, newConfiguration) {
+		c.log.Debugf("Configuration %s already have these properties", configurationType)
+		return currentConfiguration, nil
+	}
+
+	if _, err = c.CreateConfigurationOnCluster(clusterName, newConfiguration); err != nil {
+		return nil, err
+	}
+
+	return c.DesiredConfigurationOnCluster(clusterName, configurationType)
+}
@@ -0,0 +1,184 @@
+// This file permit to compare the configurations of two clusters, or of cluster and blueprint, like to detect drift
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ConfigDiff is the difference of properties for one configuration type
+type ConfigDiff struct {
+	Type    string                    `json:"type"`
+	Added   map[string]string         `json:"added,omitempty"`
+	Removed map[string]string         `json:"removed,omitempty"`
+	Changed map[string]PropertyChange `json:"changed,omitempty"`
+}
+type PropertyChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// String return configuration diff as Json string
+func (d *ConfigDiff) String() string {
+	json, _ := json.Marshal(d)
+	return string(json)
+}
+
+// DiffConfigs permit to compare the properties of two cluster configurations, like exported with ExportClusterConfigs
+// The added properties are only in to, the removed properties are only in from. The properties attributes are not compared.
+// It return the differences by configuration type, sorted by type. It return empty list if there are no difference.
+func DiffConfigs(from *ClusterConfigs, to *ClusterConfigs) []ConfigDiff {
+
+	if from == nil || to == nil {
+		panic("ClusterConfigs can't be nil")
+	}
+
+	fromProperties := configurationProperties(from)
+	toProperties := configurationProperties(to)
+	types := make([]string, 0, len(fromProperties)+len(toProperties))
+	for configurationType := range fromProperties {
+		types = append(types, configurationType)
+	}
+	for configurationType := range toProperties {
+		if _, ok := fromProperties[configurationType]; !ok {
+			types = append(types, configurationType)
+		}
+	}
+	sort.Strings(types)
+
+	diffs := make([]ConfigDiff, 0)
+	for _, configurationType := range types {
+		diff := ConfigDiff{
+			Type:    configurationType,
+			Added:   map[string]string{},
+			Removed: map[string]string{},
+			Changed: map[string]PropertyChange{},
+		}
+		for key, fromValue := range fromProperties[configurationType] {
+			toValue, ok := toProperties[configurationType][key]
+			if !ok {
+				diff.Removed[key] = fromValue
+			} else if fromValue != toValue {
+				diff.Changed[key] = PropertyChange{From: fromValue, To: toValue}
+			}
+		}
+		for key, toValue := range toProperties[configurationType] {
+			if _, ok := fromProperties[configurationType][key]; !ok {
+				diff.Added[key] = toValue
+			}
+		}
+		if len(diff.Added) > 0 || len(diff.Removed) > 0 || len(diff.Changed) > 0 {
+			diffs = append(diffs, diff)
+		}
+	}
+
+	return diffs
+}
+
+// DiffClusterConfigs permit to compare the configurations currently used by two clusters
+// It return the differences by configuration type, see DiffConfigs
+// It return error if cluster not found or if something wrong when it call the API
+func (c *AmbariClient) DiffClusterConfigs(fromClusterName string, toClusterName string) ([]ConfigDiff, error) {
+
+	if fromClusterName == "" {
+		panic("FromClusterName can't be empty")
+	}
+	if toClusterName == "" {
+		panic("ToClusterName can't be empty")
+	}
+	c.log.Debugw("DiffClusterConfigs", "from_cluster_name", fromClusterName, "to_cluster_name", toClusterName)
+
+	from, err := c.ExportClusterConfigs(fromClusterName)
+	if err != nil {
+		return nil, err
+	}
+	to, err := c.ExportClusterConfigs(toClusterName)
+	if err != nil {
+		return nil, err
+	}
+	diffs := DiffConfigs(from, to)
+	c.log.Debug("Diffs: ", diffs)
+
+	return diffs, nil
+}
+
+// DiffBlueprintConfigs permit to compare the configurations of blueprint with the ones currently used by cluster
+// Blueprint only set the properties that are not the stack default, so only these properties are compared. The host group configurations are not compared.
+// It return the differences by configuration type, from the blueprint to the cluster, see DiffConfigs
+// It return error if cluster or blueprint not found or if something wrong when it call the API
+func (c *AmbariClient) DiffBlueprintConfigs(blueprintName string, clusterName string) ([]ConfigDiff, error) {
+
+	if blueprintName == "" {
+		panic("BlueprintName can't be empty")
+	}
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("DiffBlueprintConfigs", "blueprint_name", blueprintName, "cluster_name", clusterName)
+
+	blueprint, err := c.Blueprint(blueprintName)
+	if err != nil {
+		return nil, err
+	}
+	if blueprint == nil {
+		return nil, NewAmbariError(404, "Blueprint %s not found", blueprintName)
+	}
+	from := blueprint.ClusterConfigs()
+	to, err := c.ExportClusterConfigs(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep only the properties set by blueprint
+	fromProperties := configurationProperties(from)
+	for index, configuration := range to.Configurations {
+		properties := map[string]string{}
+		for key, value := range configuration.Properties {
+			if _, ok := fromProperties[configuration.Type][key]; ok {
+				properties[key] = value
+			}
+		}
+		to.Configurations[index].Properties = properties
+	}
+	diffs := DiffConfigs(from, to)
+	c.log.Debug("Diffs: ", diffs)
+
+	return diffs, nil
+}
+
+// ClusterConfigs return the cluster level configurations of blueprint
+func (b *Blueprint) ClusterConfigs() *ClusterConfigs {
+
+	clusterConfigs := &ClusterConfigs{
+		ClusterName:    fmt.Sprintf("blueprint %s", b.BlueprintInfo.Name),
+		Configurations: make([]Configuration, 0, len(b.Configurations)),
+	}
+	for _, configurations := range b.Configurations {
+		for configurationType, configuration := range configurations {
+			clusterConfigs.Configurations = append(clusterConfigs.Configurations, Configuration{
+				Type:       configurationType,
+				Properties: configuration["properties"],
+			})
+		}
+	}
+
+	return clusterConfigs
+}
+
+// configurationProperties return the properties by configuration type
+func configurationProperties(clusterConfigs *ClusterConfigs) map[string]map[string]string {
+
+	properties := make(map[string]map[string]string, len(clusterConfigs.Configurations))
+	for _, configuration := range clusterConfigs.Configurations {
+		if properties[configuration.Type] == nil {
+			properties[configuration.Type] = map[string]string{}
+		}
+		for key, value := range configuration.Properties {
+			properties[configuration.Type][key] = value
+		}
+	}
+
+	return properties
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDiffConfigs(t *testing.T) {
+
+	from := &ClusterConfigs{
+		ClusterName: "dev",
+		Configurations: []Configuration{
+			{Type: "core-site", Properties: map[string]string{"fs.defaultFS": "hdfs://dev", "hadoop.proxyuser.hive.hosts": "*"}},
+			{Type: "hdfs-site", Properties: map[string]string{"dfs.replication": "3"}},
+			{Type: "ranger-env", Properties: map[string]string{"ranger_user": "ranger"}},
+		},
+	}
+	to := &ClusterConfigs{
+		ClusterName: "prod",
+		Configurations: []Configuration{
+			{Type: "hdfs-site", Properties: map[string]string{"dfs.replication": "3"}},
+			{Type: "core-site", Properties: map[string]string{"fs.defaultFS": "hdfs://prod", "fs.trash.interval": "360"}},
+			{Type: "yarn-site", Properties: map[string]string{"yarn.acl.enable": "true"}},
+		},
+	}
+
+	diffs := DiffConfigs(from, to)
+	assert.Equal(t, []ConfigDiff{
+		{
+			Type:    "core-site",
+			Added:   map[string]string{"fs.trash.interval": "360"},
+			Removed: map[string]string{"hadoop.proxyuser.hive.hosts": "*"},
+			Changed: map[string]PropertyChange{"fs.defaultFS": {From: "hdfs://dev", To: "hdfs://prod"}},
+		},
+		{
+			Type:    "ranger-env",
+			Added:   map[string]string{},
+			Removed: map[string]string{"ranger_user": "ranger"},
+			Changed: map[string]PropertyChange{},
+		},
+		{
+			Type:    "yarn-site",
+			Added:   map[string]string{"yarn.acl.enable": "true"},
+			Removed: map[string]string{},
+			Changed: map[string]PropertyChange{},
+		},
+	}, diffs)
+
+	// No difference
+	assert.Empty(t, DiffConfigs(from, from))
+}
+
+func TestBlueprintClusterConfigs(t *testing.T) {
+
+	blueprint := &Blueprint{
+		Configurations: []map[string]map[string]map[string]string{
+			{"core-site": {"properties": {"fs.trash.interval": "360"}}},
+			{"hdfs-site": {"properties": {"dfs.replication": "2"}}},
+		},
+		BlueprintInfo: BlueprintInfo{Name: "test"},
+	}
+
+	clusterConfigs := blueprint.ClusterConfigs()
+	assert.Equal(t, 2, len(clusterConfigs.Configurations))
+	assert.Equal(t, "core-site", clusterConfigs.Configurations[0].Type)
+	assert.Equal(t, "360", clusterConfigs.Configurations[0].Properties["fs.trash.interval"])
+	assert.Equal(t, "hdfs-site", clusterConfigs.Configurations[1].Type)
+}
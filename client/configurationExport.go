@@ -0,0 +1,328 @@
+// This file permit to export the configurations of cluster in file, and to import them on the same or another cluster
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	CONFIG_FORMAT_JSON = "json"
+	CONFIG_FORMAT_YAML = "yaml"
+)
+
+// The prefix of the values that Ambari hide, like the passwords. They are references to the value, not the value.
+const SECRET_PREFIX = "SECRET:"
+
+// ConfigImportFilter permit to choose the configurations used by ImportClusterConfigs
+type ConfigImportFilter struct {
+	// IncludeTypes are the only configuration types imported, like hdfs-site. All types are imported when it's empty.
+	IncludeTypes []string
+	// ExcludeTypes are the configuration types not imported, like cluster-env
+	ExcludeTypes []string
+}
+
+// match return true if the configuration type must be imported
+func (f *ConfigImportFilter) match(configurationType string) bool {
+
+	if f == nil {
+		return true
+	}
+	for _, excludeType := range f.ExcludeTypes {
+		if excludeType == configurationType {
+			return false
+		}
+	}
+	if len(f.IncludeTypes) == 0 {
+		return true
+	}
+	for _, includeType := range f.IncludeTypes {
+		if includeType == configurationType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClusterConfigs is all configurations currently used by cluster
+type ClusterConfigs struct {
+	ClusterName    string          `json:"cluster_name" yaml:"cluster_name"`
+	Configurations []Configuration `json:"configurations" yaml:"configurations"`
+}
+
+// String return cluster configurations as Json string
+func (cc *ClusterConfigs) String() string {
+	json, _ := json.Marshal(cc)
+	return string(json)
+}
+
+// Marshal permit to write cluster configurations in CONFIG_FORMAT_JSON or CONFIG_FORMAT_YAML
+func (cc *ClusterConfigs) Marshal(format string) ([]byte, error) {
+
+	switch format {
+	case CONFIG_FORMAT_JSON:
+		return json.MarshalIndent(cc, "", "  ")
+	case CONFIG_FORMAT_YAML:
+		return yaml.Marshal(cc)
+	default:
+		return nil, NewAmbariError(400, "Format %s is not supported", format)
+	}
+}
+
+// UnmarshalClusterConfigs permit to read cluster configurations written by Marshal
+func UnmarshalClusterConfigs(data []byte, format string) (*ClusterConfigs, error) {
+
+	clusterConfigs := &ClusterConfigs{}
+	var err error
+	switch format {
+	case CONFIG_FORMAT_JSON:
+		err = json.Unmarshal(data, clusterConfigs)
+	case CONFIG_FORMAT_YAML:
+		err = yaml.Unmarshal(data, clusterConfigs)
+	default:
+		return nil, NewAmbariError(400, "Format %s is not supported", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterConfigs, nil
+}
+
+// ExportClusterConfigs permit to get all configurations currently used by cluster, sorted by type
+// It return error if cluster not found or if something wrong when it call the API
+func (c *AmbariClient) ExportClusterConfigs(clusterName string) (*ClusterConfigs, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ExportClusterConfigs", "cluster_name", clusterName)
+
+	configurations, err := c.desiredConfigurations(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	clusterConfigs := &ClusterConfigs{
+		ClusterName:    clusterName,
+		Configurations: make([]Configuration, 0, len(configurations)),
+	}
+	for _, configuration := range configurations {
+		clusterConfigs.Configurations = append(clusterConfigs.Configurations, *configuration)
+	}
+	sort.Slice(clusterConfigs.Configurations, func(i, j int) bool {
+		return clusterConfigs.Configurations[i].Type < clusterConfigs.Configurations[j].Type
+	})
+	c.log.Debugf("Return cluster configurations: %s", clusterConfigs)
+
+	return clusterConfigs, nil
+}
+
+// ImportClusterConfigs permit to use the configurations on cluster, like the ones exported from another cluster
+// It create new configuration version only for the types that have not the same properties
+// The filter permit to import only some types, the nil filter import all types. The types of the services that are not installed on cluster are skipped.
+// The properties with SECRET: value, like the passwords exported from Ambari, are skipped because they are references to the value on the source cluster.
+// Their current value on cluster is kept, and they are logged as warning.
+// It return the change report, that is empty if the cluster already use these configurations
+// It return error if cluster not found or if something wrong when it call the API
+func (c *AmbariClient) ImportClusterConfigs(clusterName string, clusterConfigs *ClusterConfigs, filter *ConfigImportFilter) (*ChangeReport, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if clusterConfigs == nil {
+		panic("ClusterConfigs can't be nil")
+	}
+	c.log.Debugw("ImportClusterConfigs", "cluster_name", clusterName)
+
+	currentConfigurations, err := c.desiredConfigurations(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	notInstalledTypes, err := c.notInstalledConfigurationTypes(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	report := newChangeReport()
+	newConfigurations := make([]*Configuration, 0)
+	for _, configuration := range clusterConfigs.Configurations {
+		if configuration.Type == "" {
+			return nil, NewAmbariError(400, "Configuration type can't be empty")
+		}
+		if !filter.match(configuration.Type) {
+			c.log.Debugf("Configuration %s is filtered", configuration.Type)
+			continue
+		}
+		if serviceName, ok := notInstalledTypes[configuration.Type]; ok {
+			c.log.Infof("Configuration %s is skipped because service %s is not installed on cluster %s", configuration.Type, serviceName, clusterName)
+			continue
+		}
+		currentConfiguration, ok := currentConfigurations[configuration.Type]
+		newConfiguration := &Configuration{
+			Type:                 configuration.Type,
+			Tag:                  newConfigurationTag(),
+			Properties:           configuration.Properties,
+			PropertiesAttributes: configuration.PropertiesAttributes,
+		}
+		if secretProperties := secretPropertyNames(configuration.Properties); len(secretProperties) > 0 {
+			c.log.Warnf("Properties %s of configuration %s are skipped because they have SECRET: value", strings.Join(secretProperties, ", "), configuration.Type)
+			newConfiguration.Properties = withoutSecretProperties(configuration.Properties, currentConfiguration)
+		}
+		if !ok {
+			report.add(CHANGE_CREATE, "configuration", configuration.Type, nil, newConfiguration)
+		} else if !sameConfiguration(currentConfiguration, newConfiguration) {
+			report.add(CHANGE_UPDATE, "configuration", configuration.Type, currentConfiguration, newConfiguration)
+		} else {
+			continue
+		}
+		newConfigurations = append(newConfigurations, newConfiguration)
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, configuration := range newConfigurations {
+		if _, err = c.CreateConfigurationOnCluster(clusterName, configuration); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// notInstalledConfigurationTypes return the configuration types of the stack services that are not installed on cluster, with their service name
+// The types that are not owned by service, like cluster-env, are not returned
+func (c *AmbariClient) notInstalledConfigurationTypes(clusterName string) (map[string]string, error) {
+
+	cluster, err := c.Cluster(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, NewAmbariError(404, "Cluster %s not found", clusterName)
+	}
+	// The cluster version is like HDP-2.6
+	stack := strings.SplitN(cluster.ClusterInfo.Version, "-", 2)
+	if len(stack) != 2 {
+		return map[string]string{}, nil
+	}
+	stackServices, err := c.StackServices(stack[0], stack[1], Fields("StackServices/service_name", "StackServices/config_types"))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.get(fmt.Sprintf("/clusters/%s/services", clusterName), nil, Fields("ServiceInfo/service_name"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	servicesResponse := &ServicesResponse{}
+	if err = json.Unmarshal(resp.Body(), servicesResponse); err != nil {
+		return nil, err
+	}
+	installedServices := make(map[string]bool, len(servicesResponse.Items))
+	for _, service := range servicesResponse.Items {
+		installedServices[service.ServiceInfo.ServiceName] = true
+	}
+
+	notInstalledTypes := make(map[string]string)
+	for _, stackService := range stackServices {
+		if installedServices[stackService.StackServiceInfo.ServiceName] {
+			continue
+		}
+		for configurationType := range stackService.StackServiceInfo.ConfigTypes {
+			notInstalledTypes[configurationType] = stackService.StackServiceInfo.ServiceName
+		}
+	}
+	// The types shared with installed service are imported, like core-site
+	for _, stackService := range stackServices {
+		if installedServices[stackService.StackServiceInfo.ServiceName] {
+			for configurationType := range stackService.StackServiceInfo.ConfigTypes {
+				delete(notInstalledTypes, configurationType)
+			}
+		}
+	}
+
+	return notInstalledTypes, nil
+}
+
+// secretPropertyNames return the sorted names of the properties with SECRET: value
+func secretPropertyNames(properties map[string]string) []string {
+
+	names := make([]string, 0)
+	for name, value := range properties {
+		if strings.HasPrefix(value, SECRET_PREFIX) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// withoutSecretProperties return the properties where the SECRET: values are replaced by the current values on cluster
+// The property is removed if it's not set on cluster
+func withoutSecretProperties(properties map[string]string, currentConfiguration *Configuration) map[string]string {
+
+	newProperties := make(map[string]string, len(properties))
+	for name, value := range properties {
+		if !strings.HasPrefix(value, SECRET_PREFIX) {
+			newProperties[name] = value
+		} else if currentConfiguration != nil {
+			if currentValue, ok := currentConfiguration.Properties[name]; ok {
+				newProperties[name] = currentValue
+			}
+		}
+	}
+
+	return newProperties
+}
+
+// desiredConfigurations return the configurations currently used by cluster by type
+func (c *AmbariClient) desiredConfigurations(clusterName string) (map[string]*Configuration, error) {
+
+	cluster, err := c.Cluster(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if cluster == nil {
+		return nil, NewAmbariError(404, "Cluster %s not found", clusterName)
+	}
+
+	configurations := make(map[string]*Configuration, len(cluster.ClusterInfo.DesiredConfigs))
+	for configurationType, desiredConfig := range cluster.ClusterInfo.DesiredConfigs {
+		configuration, err := c.ConfigurationOnCluster(clusterName, configurationType, desiredConfig.Tag)
+		if err != nil {
+			return nil, err
+		}
+		if configuration == nil {
+			return nil, NewAmbariError(404, "Configuration %s with tag %s not found on cluster %s", configurationType, desiredConfig.Tag, clusterName)
+		}
+		configurations[configurationType] = configuration
+	}
+
+	return configurations, nil
+}
+
+// sameConfiguration return true if the configurations have the same properties and attributes, whatever their tag
+func sameConfiguration(configuration1 *Configuration, configuration2 *Configuration) bool {
+
+	if (len(configuration1.Properties) > 0 || len(configuration2.Properties) > 0) && !reflect.DeepEqual(configuration1.Properties, configuration2.Properties) {
+		return false
+	}
+	if (len(configuration1.PropertiesAttributes) > 0 || len(configuration2.PropertiesAttributes) > 0) && !reflect.DeepEqual(configuration1.PropertiesAttributes, configuration2.PropertiesAttributes) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,123 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestExportImportClusterConfigs(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddConfiguration("test", "core-site", "version1", map[string]string{"fs.defaultFS": "hdfs://test"})
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:                 "cluster-env",
+		Tag:                  "version2",
+		Properties:           map[string]string{"recovery_enabled": "true"},
+		PropertiesAttributes: map[string]map[string]string{"final": {"recovery_enabled": "true"}},
+	})
+	assert.NoError(t, err)
+
+	// Export
+	clusterConfigs, err := client.ExportClusterConfigs("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(clusterConfigs.Configurations))
+	assert.Equal(t, "cluster-env", clusterConfigs.Configurations[0].Type)
+	assert.Equal(t, "true", clusterConfigs.Configurations[0].PropertiesAttributes["final"]["recovery_enabled"])
+	assert.Equal(t, "core-site", clusterConfigs.Configurations[1].Type)
+	assert.Equal(t, "hdfs://test", clusterConfigs.Configurations[1].Properties["fs.defaultFS"])
+
+	_, err = client.ExportClusterConfigs("other")
+	assert.True(t, IsNotFound(err))
+
+	// Marshal and unmarshal
+	for _, format := range []string{CONFIG_FORMAT_JSON, CONFIG_FORMAT_YAML} {
+		data, err := clusterConfigs.Marshal(format)
+		assert.NoError(t, err)
+		clusterConfigsRead, err := UnmarshalClusterConfigs(data, format)
+		assert.NoError(t, err)
+		assert.Equal(t, clusterConfigs, clusterConfigsRead)
+	}
+	_, err = clusterConfigs.Marshal("xml")
+	assert.Error(t, err)
+
+	// Import only what changed
+	client.SetDryRun(NewDryRunRecorder())
+	report, err := client.ImportClusterConfigs("test", clusterConfigs, nil)
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+
+	clusterConfigs.Configurations[1].Properties["fs.defaultFS"] = "hdfs://prod"
+	clusterConfigs.Configurations = append(clusterConfigs.Configurations, Configuration{
+		Type:       "hdfs-site",
+		Properties: map[string]string{"dfs.replication": "3"},
+	})
+	report, err = client.ImportClusterConfigs("test", clusterConfigs, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+	assert.Equal(t, "core-site", report.Changes[0].Name)
+	assert.Equal(t, CHANGE_CREATE, report.Changes[1].Action)
+	assert.Equal(t, "hdfs-site", report.Changes[1].Name)
+}
+
+func TestImportClusterConfigsFilter(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddComponent("test", "HDFS", "NAMENODE", COMPONENT_MASTER)
+	server.AddStackService("HDP", "2.6", "HDFS", "core-site", "hdfs-site")
+	server.AddStackService("HDP", "2.6", "HIVE", "core-site", "hive-site")
+	server.AddConfiguration("test", "core-site", "version1", map[string]string{"fs.defaultFS": "hdfs://test"})
+	server.AddConfiguration("test", "hdfs-site", "version1", map[string]string{
+		"dfs.replication":              "3",
+		"ssl.server.keystore.password": "SECRET:hdfs-site:1:ssl.server.keystore.password",
+		"dfs.namenode.name.dir":        "/hadoop/hdfs/namenode",
+	})
+	client := New(server.BaseURL(), "admin", "admin")
+	clusterConfigs := &ClusterConfigs{
+		ClusterName: "prod",
+		Configurations: []Configuration{
+			{Type: "core-site", Properties: map[string]string{"fs.defaultFS": "hdfs://prod"}},
+			{Type: "hdfs-site", Properties: map[string]string{
+				"dfs.replication":                "2",
+				"ssl.server.keystore.password":   "SECRET:hdfs-site:5:ssl.server.keystore.password",
+				"ssl.client.truststore.password": "SECRET:hdfs-site:5:ssl.client.truststore.password",
+				"dfs.namenode.name.dir":          "/hadoop/hdfs/namenode",
+			}},
+			{Type: "hive-site", Properties: map[string]string{"hive.metastore.uris": "thrift://prod:9083"}},
+			{Type: "cluster-env", Properties: map[string]string{"recovery_enabled": "true"}},
+		},
+	}
+
+	// The types of services not installed are skipped, and the secrets keep the cluster value
+	client.SetDryRun(NewDryRunRecorder())
+	report, err := client.ImportClusterConfigs("test", clusterConfigs, nil)
+	assert.NoError(t, err)
+	names := make([]string, 0)
+	for _, change := range report.Changes {
+		names = append(names, change.Name)
+	}
+	assert.Equal(t, []string{"core-site", "hdfs-site", "cluster-env"}, names)
+	hdfsSite := report.Changes[1].After.(*Configuration)
+	assert.Equal(t, "2", hdfsSite.Properties["dfs.replication"])
+	assert.Equal(t, "SECRET:hdfs-site:1:ssl.server.keystore.password", hdfsSite.Properties["ssl.server.keystore.password"])
+	assert.NotContains(t, hdfsSite.Properties, "ssl.client.truststore.password")
+
+	// Include and exclude types
+	report, err = client.ImportClusterConfigs("test", clusterConfigs, &ConfigImportFilter{IncludeTypes: []string{"core-site", "hdfs-site", "hive-site"}, ExcludeTypes: []string{"hdfs-site"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, "core-site", report.Changes[0].Name)
+
+	// Only the secrets are different
+	client.SetDryRun(nil)
+	clusterConfigs.Configurations[1].Properties["dfs.replication"] = "3"
+	report, err = client.ImportClusterConfigs("test", clusterConfigs, &ConfigImportFilter{IncludeTypes: []string{"hdfs-site"}})
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+}
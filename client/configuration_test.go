@@ -0,0 +1,66 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestUpdateConfigurationProperties(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       "core-site",
+		Tag:        "version1",
+		Properties: map[string]string{"fs.defaultFS": "hdfs://test", "fs.trash.interval": "360"},
+	})
+	assert.NoError(t, err)
+
+	configuration, err := client.UpdateConfigurationProperties("test", "core-site", map[string]string{"fs.defaultFS": "hdfs://prod"}, []string{"fs.trash.interval"}, "Use prod namenode")
+	assert.NoError(t, err)
+	assert.NotNil(t, configuration)
+	if configuration != nil {
+		assert.NotEqual(t, "version1", configuration.Tag)
+		assert.Equal(t, map[string]string{"fs.defaultFS": "hdfs://prod"}, configuration.Properties)
+	}
+
+	// Same properties, no new version
+	sameConfiguration, err := client.UpdateConfigurationProperties("test", "core-site", map[string]string{"fs.defaultFS": "hdfs://prod"}, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, configuration.Tag, sameConfiguration.Tag)
+
+	_, err = client.UpdateConfigurationProperties("test", "hdfs-site", map[string]string{"dfs.replication": "3"}, nil, "")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestConfigurationTagInSameMillisecond(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       "core-site",
+		Tag:        "version1",
+		Properties: map[string]string{"fs.trash.interval": "360"},
+	})
+	assert.NoError(t, err)
+
+	// The updates are faster than one millisecond, their tags must be different
+	tags := map[string]bool{}
+	for _, interval := range []string{"60", "120", "180", "240"} {
+		configuration, err := client.UpdateConfigurationProperties("test", "core-site", map[string]string{"fs.trash.interval": interval}, nil, "")
+		assert.NoError(t, err)
+		if configuration != nil {
+			assert.False(t, tags[configuration.Tag], configuration.Tag)
+			tags[configuration.Tag] = true
+		}
+	}
+	assert.Equal(t, 4, len(tags))
+
+	tag := newConfigurationTag()
+	assert.NotEqual(t, tag, newConfigurationTag())
+}
@@ -6,7 +6,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 // Credential object
@@ -53,28 +52,27 @@ func (c *Credential) CleanBeforeSave() *Credential {
 // It return the credential if is found
 // It return nil if not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Credential(clusterName string, alias string) (*Credential, error) {
+func (c *AmbariClient) Credential(clusterName string, alias string, opts ...RequestOption) (*Credential, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("Credential", "cluster_name", clusterName, "alias", alias)
 	if alias == "" {
 		panic("Alias can't be empty")
 	}
-	log.Debug("Alias: ", alias)
 
 	path := fmt.Sprintf("/clusters/%s/credentials/%s", clusterName, alias)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Result : ", resp)
+	c.log.Debug("Result : ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	credential := &Credential{}
@@ -83,7 +81,7 @@ func (c *AmbariClient) Credential(clusterName string, alias string) (*Credential
 		return nil, err
 	}
 
-	log.Debug("Credential: ", credential)
+	c.log.Debug("Credential: ", credential)
 
 	return credential, nil
 
@@ -93,24 +91,24 @@ func (c *AmbariClient) Credential(clusterName string, alias string) (*Credential
 // It return the list of credential.
 //  If not credential, it return empty list.
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Credentials(clusterName string) ([]Credential, error) {
+func (c *AmbariClient) Credentials(clusterName string, opts ...RequestOption) ([]Credential, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("Credentials", "cluster_name", clusterName)
 
 	path := fmt.Sprintf("/clusters/%s/credentials", clusterName)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Result : ", resp)
+	c.log.Debug("Result : ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	credentialResponse := &CredentialResponse{}
@@ -119,7 +117,7 @@ func (c *AmbariClient) Credentials(clusterName string) ([]Credential, error) {
 		return nil, err
 	}
 
-	log.Debug("Credentials: ", credentialResponse.Items)
+	c.log.Debug("Credentials: ", credentialResponse.Items)
 
 	return credentialResponse.Items, nil
 
@@ -139,13 +137,13 @@ func (c *AmbariClient) CreateCredential(credential *Credential) (*Credential, er
 	if credential.CredentialInfo.Alias == "" {
 		panic("Alias can't be empty")
 	}
-	log.Debug("Credential: ", credential)
+	c.log.Debugw("CreateCredential", "credential", credential)
 
 	// Create the credential
 	path := fmt.Sprintf("/clusters/%s/credentials/%s", credential.CredentialInfo.ClusterName, credential.CredentialInfo.Alias)
 
 	credentialPayload := credential.CleanBeforeSave()
-	log.Debug("Credential payload: ", credentialPayload)
+	c.log.Debug("Credential payload: ", credentialPayload)
 	jsonData, err := json.Marshal(credentialPayload)
 	if err != nil {
 		return nil, err
@@ -154,9 +152,9 @@ func (c *AmbariClient) CreateCredential(credential *Credential) (*Credential, er
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the credential
@@ -179,20 +177,19 @@ func (c *AmbariClient) DeleteCredential(clusterName string, alias string) error
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("DeleteCredential", "cluster_name", clusterName, "alias", alias)
 	if alias == "" {
 		panic("Alias can't be empty")
 	}
-	log.Debug("Alias: ", alias)
 
 	path := fmt.Sprintf("/clusters/%s/credentials/%s", clusterName, alias)
 	resp, err := c.Client().R().Delete(path)
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete credential: ", resp)
+	c.log.Debug("Response to delete credential: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -213,12 +210,12 @@ func (c *AmbariClient) UpdateCredential(credential *Credential) (*Credential, er
 	if credential.CredentialInfo.Alias == "" {
 		panic("Alias can't be empty")
 	}
-	log.Debug("Credential: ", credential)
+	c.log.Debugw("UpdateCredential", "credential", credential)
 
 	// Update the credential
 	path := fmt.Sprintf("/clusters/%s/credentials/%s", credential.CredentialInfo.ClusterName, credential.CredentialInfo.Alias)
 	credentialPayload := credential.CleanBeforeSave()
-	log.Debug("Credential payload: ", credentialPayload)
+	c.log.Debug("Credential payload: ", credentialPayload)
 	jsonData, err := json.Marshal(credentialPayload)
 	if err != nil {
 		return nil, err
@@ -227,9 +224,9 @@ func (c *AmbariClient) UpdateCredential(credential *Credential) (*Credential, er
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the credential after update
@@ -0,0 +1,161 @@
+// This file permit to execute custom commands on components, like REFRESHQUEUES on YARN ResourceManager or CONFIGURE on clients
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	COMMAND_REFRESHQUEUES = "REFRESHQUEUES"
+	COMMAND_CONFIGURE     = "CONFIGURE"
+	COMMAND_RESTART       = "RESTART"
+)
+
+// CustomCommandRequest is the request to execute command on components
+type CustomCommandRequest struct {
+	RequestInfo     *CustomCommandInfo `json:"RequestInfo"`
+	ResourceFilters []ResourceFilter   `json:"Requests/resource_filters"`
+}
+type CustomCommandInfo struct {
+	Context    string            `json:"context"`
+	Command    string            `json:"command"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// ResourceFilter is the components where the command is executed, hosts is the list of hostname separated by comma
+type ResourceFilter struct {
+	ServiceName   string `json:"service_name,omitempty"`
+	ComponentName string `json:"component_name,omitempty"`
+	Hosts         string `json:"hosts,omitempty"`
+}
+
+// String permit to get custom command request as Json string
+func (r *CustomCommandRequest) String() string {
+	json, _ := json.Marshal(r)
+	return string(json)
+}
+
+// ExecuteCustomCommand permit to execute command, like REFRESHQUEUES, RESTART_LLAP or SET_KEYTAB, on component of service
+// When hosts is empty, the command is executed on all hosts where the component is installed.
+// Parameters are given to the command script, it can be nil.
+// It wait the end of request and return it
+// It return error if component is not found, if the request not completed or if something wrong when it call the API
+func (c *AmbariClient) ExecuteCustomCommand(clusterName string, serviceName string, componentName string, hosts []string, command string, parameters map[string]string) (*RequestTask, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	if command == "" {
+		panic("Command can't be empty")
+	}
+	c.log.Debugw("ExecuteCustomCommand", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName, "hosts", hosts, "command", command, "parameters", parameters)
+
+	// Use all hosts of component
+	if len(hosts) == 0 {
+		component, err := c.Component(clusterName, serviceName, componentName)
+		if err != nil {
+			return nil, err
+		}
+		if component == nil {
+			return nil, NewAmbariError(404, "Component %s not found in service %s on cluster %s", componentName, serviceName, clusterName)
+		}
+		hosts = make([]string, 0, len(component.HostComponents))
+		for _, hostComponent := range component.HostComponents {
+			hosts = append(hosts, hostComponent.HostComponentInfo.Hostname)
+		}
+		if len(hosts) == 0 {
+			return nil, NewAmbariError(404, "Component %s is not installed on hosts in cluster %s", componentName, clusterName)
+		}
+	}
+
+	request := &CustomCommandRequest{
+		RequestInfo: &CustomCommandInfo{
+			Context:    fmt.Sprintf("Execute %s on %s from API", command, componentName),
+			Command:    command,
+			Parameters: parameters,
+		},
+		ResourceFilters: []ResourceFilter{
+			{
+				ServiceName:   serviceName,
+				ComponentName: componentName,
+				Hosts:         strings.Join(hosts, ","),
+			},
+		},
+	}
+	c.log.Debug("Sended Request: ", request)
+
+	path := fmt.Sprintf("/clusters/%s/requests", clusterName)
+	jsonData, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to post: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	requestTask := &RequestTask{}
+	err = json.Unmarshal(resp.Body(), requestTask)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait the end of the request
+	err = requestTask.Wait(c, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
+		return nil, NewRequestError(requestTask)
+	}
+	c.log.Debugf("Return request: %s", requestTask)
+
+	return requestTask, nil
+}
+
+// RefreshQueues permit to reload the capacity scheduler queues on all YARN ResourceManagers, after updating capacity-scheduler configuration
+// It return the request
+// It return error if the request not completed or if something wrong when it call the API
+func (c *AmbariClient) RefreshQueues(clusterName string) (*RequestTask, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("RefreshQueues", "cluster_name", clusterName)
+
+	return c.ExecuteCustomCommand(clusterName, "YARN", "RESOURCEMANAGER", nil, COMMAND_REFRESHQUEUES, map[string]string{
+		"forceRefreshConfigTags": "capacity-scheduler",
+	})
+}
+
+// RefreshConfigs permit to write the current configurations on hosts for component, like Ambari UI do with "Refresh configs" on clients.
+// When hosts is empty, the configurations are refreshed on all hosts where the component is installed.
+// It return the request
+// It return error if the request not completed or if something wrong when it call the API
+func (c *AmbariClient) RefreshConfigs(clusterName string, serviceName string, componentName string, hosts []string) (*RequestTask, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	c.log.Debugw("RefreshConfigs", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName, "hosts", hosts)
+
+	return c.ExecuteCustomCommand(clusterName, serviceName, componentName, hosts, COMMAND_CONFIGURE, nil)
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestExecuteCustomCommand(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("master01", "test")
+	server.AddHost("master02", "test")
+	server.AddHost("worker01", "test")
+	server.AddComponent("test", "YARN", "RESOURCEMANAGER", COMPONENT_MASTER)
+	server.AddComponent("test", "HDFS", "HDFS_CLIENT", COMPONENT_CLIENT)
+	server.AddHostComponent("test", "master01", "RESOURCEMANAGER", SERVICE_STARTED)
+	server.AddHostComponent("test", "master02", "RESOURCEMANAGER", SERVICE_STARTED)
+	server.AddHostComponent("test", "worker01", "HDFS_CLIENT", SERVICE_INSTALLED)
+
+	// Keep the custom command requests sent to server
+	requests := make([]CustomCommandRequest, 0)
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/clusters/test/requests" {
+			body, _ := ioutil.ReadAll(r.Body)
+			request := CustomCommandRequest{}
+			json.Unmarshal(body, &request)
+			requests = append(requests, request)
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		handler.ServeHTTP(w, r)
+	})
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Refresh queues on all ResourceManagers
+	requestTask, err := client.RefreshQueues("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, requestTask)
+	if requestTask != nil {
+		assert.Equal(t, REQUEST_COMPLETED, requestTask.RequestTaskInfo.Status)
+	}
+	assert.Equal(t, 1, len(requests))
+	if len(requests) == 1 {
+		assert.Equal(t, COMMAND_REFRESHQUEUES, requests[0].RequestInfo.Command)
+		assert.Equal(t, map[string]string{"forceRefreshConfigTags": "capacity-scheduler"}, requests[0].RequestInfo.Parameters)
+		assert.Equal(t, []ResourceFilter{{ServiceName: "YARN", ComponentName: "RESOURCEMANAGER", Hosts: "master01,master02"}}, requests[0].ResourceFilters)
+	}
+
+	// Refresh configs on given hosts
+	_, err = client.RefreshConfigs("test", "HDFS", "HDFS_CLIENT", []string{"worker01"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(requests))
+	if len(requests) == 2 {
+		assert.Equal(t, COMMAND_CONFIGURE, requests[1].RequestInfo.Command)
+		assert.Nil(t, requests[1].RequestInfo.Parameters)
+		assert.Equal(t, []ResourceFilter{{ServiceName: "HDFS", ComponentName: "HDFS_CLIENT", Hosts: "worker01"}}, requests[1].ResourceFilters)
+	}
+
+	// Component not found
+	_, err = client.ExecuteCustomCommand("test", "HIVE", "HIVE_SERVER_INTERACTIVE", nil, "RESTART_LLAP", nil)
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(requests))
+}
@@ -0,0 +1,147 @@
+// This file permit to preview the changes on Ambari API without do them (dry run)
+
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// DryRunCall is a call that change Ambari, not sent because of dry run
+type DryRunCall struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   string `json:"body,omitempty"`
+}
+
+// DryRunRecorder record the calls not sent because of dry run
+// It can be used by many goroutines
+type DryRunRecorder struct {
+	mutex sync.Mutex
+	calls []DryRunCall
+}
+
+type dryRunTransport struct {
+	next http.RoundTripper
+	log  *clientLogger
+	// mutex protect the recorder, that can be changed while calls are sent
+	mutex    sync.RWMutex
+	recorder *DryRunRecorder
+}
+
+// NewDryRunRecorder permit to create recorder for SetDryRun
+func NewDryRunRecorder() *DryRunRecorder {
+	return &DryRunRecorder{
+		calls: make([]DryRunCall, 0),
+	}
+}
+
+// Calls return the recorded calls, in the order they are done
+func (r *DryRunRecorder) Calls() []DryRunCall {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	calls := make([]DryRunCall, len(r.calls))
+	copy(calls, r.calls)
+	return calls
+}
+
+// Reset permit to remove the recorded calls
+func (r *DryRunRecorder) Reset() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.calls = make([]DryRunCall, 0)
+}
+
+func (r *DryRunRecorder) record(call DryRunCall) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.calls = append(r.calls, call)
+}
+
+// SetDryRun permit to not send the calls that change Ambari (POST, PUT, DELETE), they are logged and recorded on the recorder instead.
+// The calls that read Ambari are sent as usual. The not sent calls get 202 response with empty object,
+// so the methods that read again the resource after change it can return error or not found. Use the ApplyXxx methods to get the full plan.
+// The nil recorder disable the dry run
+// The first call must be done before use the client from many goroutines, the next calls can be done while calls are sent
+func (c *AmbariClient) SetDryRun(recorder *DryRunRecorder) {
+
+	c.checkOwnTransport()
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*dryRunTransport)
+		return ok
+	})
+	if transport != nil {
+		transport.(*dryRunTransport).setRecorder(recorder)
+		return
+	}
+
+	if recorder != nil {
+		c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return &dryRunTransport{
+				next:     next,
+				recorder: recorder,
+				log:      c.log,
+			}
+		})
+	}
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *dryRunTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+func (t *dryRunTransport) setRecorder(recorder *DryRunRecorder) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.recorder = recorder
+}
+
+func (t *dryRunTransport) currentRecorder() *DryRunRecorder {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.recorder
+}
+
+// RoundTrip send the request if it only read Ambari, else it record it
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	recorder := t.currentRecorder()
+	if recorder == nil || req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions {
+		return t.next.RoundTrip(req)
+	}
+
+	call := DryRunCall{
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		call.Body = string(body)
+	}
+	t.log.Infof("Dry run, not send %s %s %s", call.Method, call.Path, call.Body)
+	recorder.record(call)
+
+	return &http.Response{
+		Status:        "202 Accepted",
+		StatusCode:    http.StatusAccepted,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewBufferString("{}")),
+		ContentLength: 2,
+		Request:       req,
+	}, nil
+}
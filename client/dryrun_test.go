@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetDryRun(t *testing.T) {
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"Hosts": {"host_name": "worker01", "cluster_name": "test", "rack_info": "/rack1"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	recorder := NewDryRunRecorder()
+	client.SetDryRun(recorder)
+
+	// Read is sent
+	host, err := client.HostOnCluster("test", "worker01")
+	assert.NoError(t, err)
+	assert.NotNil(t, host)
+	assert.Equal(t, 1, calls)
+
+	// Change is recorded
+	host.HostInfo.Rack = "/rack2"
+	_, err = client.UpdateHost(host)
+	assert.NoError(t, err)
+	err = client.DeleteRepository("HDP", "2.6", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+	recordedCalls := recorder.Calls()
+	assert.Equal(t, 2, len(recordedCalls))
+	assert.Equal(t, "PUT", recordedCalls[0].Method)
+	assert.Equal(t, "/api/v1/clusters/test/hosts/worker01", recordedCalls[0].Path)
+	assert.Contains(t, recordedCalls[0].Body, "/rack2")
+	assert.Equal(t, "DELETE", recordedCalls[1].Method)
+
+	recorder.Reset()
+	assert.Empty(t, recorder.Calls())
+
+	// Disable dry run
+	client.SetDryRun(nil)
+	err = client.DeleteRepository("HDP", "2.6", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Empty(t, recorder.Calls())
+}
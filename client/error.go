@@ -1,21 +1,120 @@
 package client
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"gopkg.in/resty.v1"
+)
+
+// Sentinel errors to use with errors.Is, like errors.Is(err, ErrNotFound)
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrServer       = errors.New("server error")
+	ErrTimeout      = errors.New("timeout")
 )
 
 type AmbariError struct {
 	Code    int
 	Message string
+	Method  string
+	Path    string
+	// RequestId is the Ambari request that failed or not finished in time, 0 else
+	RequestId int
+}
+
+// ambariErrorBody is the body return by Ambari when the call failed
+type ambariErrorBody struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
 }
 
 func (e AmbariError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("%s (%s %s)", e.Message, e.Method, e.Path)
+	}
 	return e.Message
 }
 
+// Is permit to compare the error with the sentinel errors
+func (e AmbariError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.Code == 400
+	case ErrUnauthorized:
+		return e.Code == 401
+	case ErrForbidden:
+		return e.Code == 403
+	case ErrNotFound:
+		return e.Code == 404
+	case ErrConflict:
+		return e.Code == 409
+	case ErrServer:
+		return e.Code >= 500
+	case ErrTimeout:
+		return e.Code == 408
+	}
+	return false
+}
+
 func NewAmbariError(code int, message string, params ...interface{}) AmbariError {
 	return AmbariError{
 		Code:    code,
 		Message: fmt.Sprintf(message, params...),
 	}
 }
+
+// NewAmbariErrorFromResponse permit to create error from the response of Ambari API
+// It read the message from the body if Ambari give it, else it use the HTTP status
+func NewAmbariErrorFromResponse(resp *resty.Response) AmbariError {
+	return newAmbariErrorFromBody(resp, resp.Body())
+}
+
+// newAmbariErrorFromBody permit to create AmbariError when the body is not read by resty, like for the streamed responses
+func newAmbariErrorFromBody(resp *resty.Response, respBody []byte) AmbariError {
+
+	ambariError := AmbariError{
+		Code:    resp.StatusCode(),
+		Message: resp.Status(),
+	}
+
+	body := &ambariErrorBody{}
+	if err := json.Unmarshal(respBody, body); err == nil && body.Message != "" {
+		ambariError.Message = body.Message
+	}
+
+	if resp.Request != nil {
+		ambariError.Method = resp.Request.Method
+		if resp.Request.RawRequest != nil && resp.Request.RawRequest.URL != nil {
+			ambariError.Path = resp.Request.RawRequest.URL.Path
+		} else {
+			ambariError.Path = resp.Request.URL
+		}
+	}
+
+	return ambariError
+}
+
+// IsNotFound return true if the error is because the resource not exist
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict return true if the error is because the resource already exist or is in wrong state
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsForbidden return true if the error is because the user has not the right
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsTimeout return true if the error is because the request is not finished in time
+func IsTimeout(err error) bool {
+	return errors.Is(err, ErrTimeout)
+}
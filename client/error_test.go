@@ -0,0 +1,74 @@
+package client
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func (s *ClientTestSuite) TestError() {
+
+	// Create the same cluster twice
+	_, err := s.client.CreateCluster(&Cluster{
+		ClusterInfo: &ClusterInfo{
+			Version:     "HDP-2.6",
+			ClusterName: "test",
+		},
+	})
+	assert.Error(s.T(), err)
+	assert.True(s.T(), IsConflict(err))
+
+	var ambariError AmbariError
+	assert.True(s.T(), errors.As(err, &ambariError))
+	assert.Equal(s.T(), "/api/v1/clusters/test", ambariError.Path)
+	assert.NotEmpty(s.T(), ambariError.Message)
+}
+
+func TestAmbariErrorIs(t *testing.T) {
+
+	err := NewAmbariError(404, "Host %s not found", "host1")
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsConflict(err))
+	assert.False(t, IsForbidden(err))
+	assert.Equal(t, "Host host1 not found", err.Error())
+
+	assert.True(t, IsConflict(NewAmbariError(409, "Conflict")))
+	assert.True(t, IsForbidden(NewAmbariError(403, "Forbidden")))
+	assert.True(t, errors.Is(NewAmbariError(503, "Service Unavailable"), ErrServer))
+	assert.False(t, IsNotFound(errors.New("not found")))
+}
+
+func TestNewAmbariErrorFromResponse(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/clusters/test":
+			w.WriteHeader(409)
+			w.Write([]byte(`{"status": 409, "message": "Attempted to create a Cluster which already exists, clusterName=test"}`))
+		default:
+			w.WriteHeader(403)
+		}
+	}))
+	defer server.Close()
+	client := New(server.URL+"/api/v1", "admin", "admin")
+
+	// Error with body
+	_, err := client.CreateCluster(&Cluster{ClusterInfo: &ClusterInfo{ClusterName: "test"}})
+	assert.Error(t, err)
+	assert.True(t, IsConflict(err))
+	var ambariError AmbariError
+	assert.True(t, errors.As(err, &ambariError))
+	assert.Equal(t, 409, ambariError.Code)
+	assert.Equal(t, "Attempted to create a Cluster which already exists, clusterName=test", ambariError.Message)
+	assert.Equal(t, "POST", ambariError.Method)
+	assert.Equal(t, "/api/v1/clusters/test", ambariError.Path)
+	assert.Equal(t, "Attempted to create a Cluster which already exists, clusterName=test (POST /api/v1/clusters/test)", err.Error())
+
+	// Error without body
+	_, err = client.Cluster("other")
+	assert.True(t, IsForbidden(err))
+	assert.True(t, errors.As(err, &ambariError))
+	assert.Equal(t, "403 Forbidden", ambariError.Message)
+}
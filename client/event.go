@@ -0,0 +1,526 @@
+// This file permit to receive the events sent by Ambari (2.7 and later) when resources change, like the request progress
+// Ambari send the events with STOMP over WebSocket, on /api/stomp/v1/websocket
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"golang.org/x/net/websocket"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	EVENT_TOPIC_REQUESTS        = "/events/requests"
+	EVENT_TOPIC_SERVICES        = "/events/services"
+	EVENT_TOPIC_HOSTS           = "/events/hosts"
+	EVENT_TOPIC_HOST_COMPONENTS = "/events/hostcomponents"
+	EVENT_TOPIC_ALERTS          = "/events/alerts"
+)
+
+// Event is event sent by Ambari
+// The field that match the topic is set, like Request for EVENT_TOPIC_REQUESTS. Body is the raw event, for the other topics.
+type Event struct {
+	Topic          string
+	Body           []byte
+	Request        *RequestEvent
+	Service        *ServiceEvent
+	Host           *HostEvent
+	HostComponents *HostComponentsEvent
+	Alert          *AlertEvent
+}
+type RequestEvent struct {
+	ClusterId       int64              `json:"clusterId,omitempty"`
+	RequestId       int                `json:"requestId,omitempty"`
+	RequestContext  string             `json:"requestContext,omitempty"`
+	RequestStatus   string             `json:"requestStatus,omitempty"`
+	ProgressPercent float64            `json:"progressPercent,omitempty"`
+	StartTime       int64              `json:"startTime,omitempty"`
+	EndTime         int64              `json:"endTime,omitempty"`
+	UserName        string             `json:"userName,omitempty"`
+	Tasks           []RequestEventTask `json:"Tasks,omitempty"`
+}
+type RequestEventTask struct {
+	Id       int64  `json:"id,omitempty"`
+	Hostname string `json:"hostName,omitempty"`
+	Status   string `json:"status,omitempty"`
+}
+type ServiceEvent struct {
+	ClusterName      string `json:"clusterName,omitempty"`
+	ServiceName      string `json:"serviceName,omitempty"`
+	State            string `json:"state,omitempty"`
+	MaintenanceState string `json:"maintenanceState,omitempty"`
+}
+type HostEvent struct {
+	ClusterId         int64  `json:"clusterId,omitempty"`
+	Hostname          string `json:"hostName,omitempty"`
+	HostStatus        string `json:"hostStatus,omitempty"`
+	HostState         string `json:"hostState,omitempty"`
+	MaintenanceState  string `json:"maintenanceState,omitempty"`
+	LastHeartbeatTime int64  `json:"lastHeartbeatTime,omitempty"`
+}
+type HostComponentsEvent struct {
+	HostComponents []HostComponentEvent `json:"hostComponents,omitempty"`
+}
+type HostComponentEvent struct {
+	Id               int64  `json:"id,omitempty"`
+	ClusterId        int64  `json:"clusterId,omitempty"`
+	ServiceName      string `json:"serviceName,omitempty"`
+	Hostname         string `json:"hostName,omitempty"`
+	ComponentName    string `json:"componentName,omitempty"`
+	CurrentState     string `json:"currentState,omitempty"`
+	PreviousState    string `json:"previousState,omitempty"`
+	MaintenanceState string `json:"maintenanceState,omitempty"`
+	StaleConfigs     bool   `json:"staleConfigs,omitempty"`
+}
+type AlertEvent struct {
+	Alerts []AlertEventItem `json:"alerts,omitempty"`
+}
+type AlertEventItem struct {
+	ClusterId     int64  `json:"clusterId,omitempty"`
+	Name          string `json:"name,omitempty"`
+	State         string `json:"state,omitempty"`
+	ServiceName   string `json:"service,omitempty"`
+	ComponentName string `json:"component,omitempty"`
+	Hostname      string `json:"host,omitempty"`
+	Text          string `json:"text,omitempty"`
+	Timestamp     int64  `json:"timestamp,omitempty"`
+}
+
+// EventSubscription permit to read the events of the subscribed topics
+type EventSubscription struct {
+	conn    *websocket.Conn
+	events  chan Event
+	closing chan struct{}
+	mutex   sync.Mutex
+	closed  bool
+	err     error
+	log     *clientLogger
+}
+
+// String return event as Json string
+func (e *Event) String() string {
+	json, _ := json.Marshal(e)
+	return string(json)
+}
+
+// Subscribe permit to receive the events of the topics, like EVENT_TOPIC_REQUESTS
+// The events are sent on Events() until the context is done, Close is called or the connection is lost. Use Err to know why the events stop.
+// It use the authentication (basic, token or Kerberos), the TLS options and the proxy of the client. The context is also used to connect.
+// It return error if it can't connect on Ambari or if Ambari refuse the subscription
+func (c *AmbariClient) Subscribe(ctx context.Context, topics ...string) (*EventSubscription, error) {
+
+	if len(topics) == 0 {
+		panic("Topics can't be empty")
+	}
+	c.log.Debugw("Subscribe", "topics", topics)
+
+	config, err := c.websocketConfig()
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Connect on %s", config.Location)
+	conn, err := c.dialWebsocket(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	subscription := &EventSubscription{
+		conn:    conn,
+		events:  make(chan Event, 100),
+		closing: make(chan struct{}),
+		log:     c.log,
+	}
+
+	// Connect
+	err = subscription.send(newStompFrame(stompConnect, map[string]string{
+		"accept-version": "1.2",
+		"host":           config.Location.Hostname(),
+		"heart-beat":     "0,0",
+	}))
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	reader := &stompReader{}
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	frames := []*stompFrame{}
+	for len(frames) == 0 {
+		var data []byte
+		if err = websocket.Message.Receive(conn, &data); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if frames, err = reader.read(data); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	conn.SetReadDeadline(time.Time{})
+	if frames[0].command != stompConnected {
+		conn.Close()
+		return nil, NewAmbariError(401, "Ambari refuse the connection: %s %s", frames[0].headers["message"], string(frames[0].body))
+	}
+
+	// Subscribe
+	for index, topic := range topics {
+		err = subscription.send(newStompFrame(stompSubscribe, map[string]string{
+			"id":          fmt.Sprintf("sub-%d", index),
+			"destination": topic,
+			"ack":         "auto",
+		}))
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	go subscription.receive(reader, frames[1:])
+	go func() {
+		select {
+		case <-ctx.Done():
+			subscription.Close()
+		case <-subscription.closing:
+		}
+	}()
+
+	return subscription, nil
+}
+
+// Events return the channel where the events are sent
+// It's closed when the subscription stop
+func (s *EventSubscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err return why the subscription stop, or nil if it's closed by Close or the context
+func (s *EventSubscription) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.err
+}
+
+// Close permit to stop the subscription
+func (s *EventSubscription) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	close(s.closing)
+	s.conn.Write(newStompFrame(stompDisconnect, map[string]string{}).marshal())
+
+	return s.conn.Close()
+}
+
+func (s *EventSubscription) send(frame *stompFrame) error {
+	_, err := s.conn.Write(frame.marshal())
+	return err
+}
+
+// stop permit to stop the subscription because of error
+func (s *EventSubscription) stop(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.closed {
+		s.log.Debugf("Subscription stopped: %s", err.Error())
+		s.err = err
+		s.closed = true
+		close(s.closing)
+		s.conn.Close()
+	}
+}
+
+// receive read the frames until the subscription stop
+func (s *EventSubscription) receive(reader *stompReader, frames []*stompFrame) {
+
+	defer close(s.events)
+
+	for {
+		for _, frame := range frames {
+			switch frame.command {
+			case stompMessage:
+				event, err := newEvent(frame.headers["destination"], frame.body)
+				if err != nil {
+					s.stop(err)
+					return
+				}
+				s.log.Debugf("Receive event: %s", event)
+				select {
+				case s.events <- *event:
+				case <-s.closing:
+					return
+				}
+			case stompError:
+				s.stop(NewAmbariError(500, "Ambari send error: %s %s", frame.headers["message"], string(frame.body)))
+				return
+			}
+		}
+
+		var data []byte
+		if err := websocket.Message.Receive(s.conn, &data); err != nil {
+			s.stop(err)
+			return
+		}
+		var err error
+		if frames, err = reader.read(data); err != nil {
+			s.stop(err)
+			return
+		}
+	}
+}
+
+// newEvent read the event sent on the topic
+func newEvent(topic string, body []byte) (*Event, error) {
+
+	event := &Event{
+		Topic: topic,
+		Body:  body,
+	}
+	var typedEvent interface{}
+	switch topic {
+	case EVENT_TOPIC_REQUESTS:
+		event.Request = &RequestEvent{}
+		typedEvent = event.Request
+	case EVENT_TOPIC_SERVICES:
+		event.Service = &ServiceEvent{}
+		typedEvent = event.Service
+	case EVENT_TOPIC_HOSTS:
+		event.Host = &HostEvent{}
+		typedEvent = event.Host
+	case EVENT_TOPIC_HOST_COMPONENTS:
+		event.HostComponents = &HostComponentsEvent{}
+		typedEvent = event.HostComponents
+	case EVENT_TOPIC_ALERTS:
+		event.Alert = &AlertEvent{}
+		typedEvent = event.Alert
+	default:
+		return event, nil
+	}
+	if err := json.Unmarshal(body, typedEvent); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// websocketConfig return the config to connect on Ambari events, with the credentials and TLS options of the client
+func (c *AmbariClient) websocketConfig() (*websocket.Config, error) {
+
+	baseUrl, err := url.Parse(c.client.HostURL)
+	if err != nil {
+		return nil, err
+	}
+	origin := &url.URL{Scheme: baseUrl.Scheme, Host: baseUrl.Host}
+	location := &url.URL{
+		Scheme: "ws",
+		Host:   baseUrl.Host,
+		Path:   strings.TrimSuffix(strings.TrimSuffix(baseUrl.Path, "/"), "/v1") + "/stomp/v1/websocket",
+	}
+	if baseUrl.Scheme == "https" {
+		location.Scheme = "wss"
+	}
+
+	config, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return nil, err
+	}
+	config.Header = http.Header{}
+	config.Header.Set("X-Requested-By", "ambari")
+	if err = c.websocketAuth(config); err != nil {
+		return nil, err
+	}
+	if transport, err := c.transport(); err == nil && transport.TLSClientConfig != nil {
+		config.TlsConfig = transport.TLSClientConfig.Clone()
+	} else {
+		config.TlsConfig = &tls.Config{}
+	}
+
+	return config, nil
+}
+
+// websocketAuth set the credentials of the client on the WebSocket handshake
+// The handshake can't be sent again, so the token and the Kerberos ticket are sent on the first call, without wait that Ambari ask them.
+func (c *AmbariClient) websocketAuth(config *websocket.Config) error {
+
+	req := &http.Request{Method: http.MethodGet, URL: httpLocation(config.Location), Header: config.Header}
+	if c.client.UserInfo != nil {
+		req.SetBasicAuth(c.client.UserInfo.Username, c.client.UserInfo.Password)
+	}
+	if transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*tokenTransport)
+		return ok
+	}); transport != nil {
+		token, err := transport.(*tokenTransport).provider.Token()
+		if err != nil {
+			return err
+		}
+		req = transport.(*tokenTransport).withToken(req, token)
+	}
+	if transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*spnegoTransport)
+		return ok
+	}); transport != nil {
+		if err := transport.(*spnegoTransport).negotiate(req); err != nil {
+			return fmt.Errorf("Can't negotiate Kerberos authentication: %s", err.Error())
+		}
+	}
+	config.Header = req.Header
+
+	return nil
+}
+
+// dialWebsocket open the WebSocket connection, through the HTTP or HTTPS proxy of the client if Ambari is not called directly
+// The connection is closed if the context is done before the end of the handshake.
+func (c *AmbariClient) dialWebsocket(ctx context.Context, config *websocket.Config) (*websocket.Conn, error) {
+
+	target := config.Location.Host
+	if config.Location.Port() == "" {
+		if config.Location.Scheme == "wss" {
+			target += ":443"
+		} else {
+			target += ":80"
+		}
+	}
+	var proxy *url.URL
+	if transport, err := c.transport(); err == nil && transport.Proxy != nil {
+		if proxy, err = transport.Proxy(&http.Request{URL: httpLocation(config.Location)}); err != nil {
+			return nil, err
+		}
+	}
+	address := target
+	if proxy != nil {
+		c.log.Debugw("Proxy", "proxy", proxy.Redacted())
+		var err error
+		if address, err = proxyAddress(proxy); err != nil {
+			return nil, err
+		}
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if proxy != nil {
+		// Like http.Transport, the TLS options of the client are used to connect on HTTPS proxy
+		if proxy.Scheme == "https" {
+			tlsConfig := config.TlsConfig.Clone()
+			tlsConfig.ServerName = proxy.Hostname()
+			tlsConn := tls.Client(conn, tlsConfig)
+			if err = tlsConn.Handshake(); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			conn = tlsConn
+		}
+		if err = connectProxy(conn, proxy, target); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if config.Location.Scheme == "wss" {
+		tlsConfig := config.TlsConfig.Clone()
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = config.Location.Hostname()
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err = tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	wsConn, err := websocket.NewClient(config, conn)
+	if err != nil {
+		conn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+
+	return wsConn, nil
+}
+
+// proxyAddress return the host and port to connect on the proxy, the port is the default one of the proxy scheme when it's not set
+// It return error if the proxy is not HTTP or HTTPS proxy, like SOCKS proxy
+func proxyAddress(proxy *url.URL) (string, error) {
+
+	port := proxy.Port()
+	switch proxy.Scheme {
+	case "http", "":
+		if port == "" {
+			port = "80"
+		}
+	case "https":
+		if port == "" {
+			port = "443"
+		}
+	default:
+		return "", fmt.Errorf("The proxy scheme %s is not supported to subscribe on events, only http and https", proxy.Scheme)
+	}
+
+	return net.JoinHostPort(proxy.Hostname(), port), nil
+}
+
+// connectProxy open the tunnel to the target through the HTTP proxy, with CONNECT method
+func connectProxy(conn net.Conn, proxy *url.URL, target string) error {
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: http.Header{},
+	}
+	if proxy.User != nil {
+		password, _ := proxy.User.Password()
+		req.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(proxy.User.Username()+":"+password)))
+	}
+	if err := req.Write(conn); err != nil {
+		return err
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return NewAmbariError(resp.StatusCode, "Proxy refuse the connection to %s: %s", target, resp.Status)
+	}
+
+	return nil
+}
+
+// httpLocation return the HTTP URL of the WebSocket location, like the proxy and the authentication expect it
+func httpLocation(location *url.URL) *url.URL {
+
+	httpLocation := *location
+	httpLocation.Scheme = "http"
+	if location.Scheme == "wss" {
+		httpLocation.Scheme = "https"
+	}
+
+	return &httpLocation
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSubscribe(t *testing.T) {
+
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		assert.Equal(t, "/api/stomp/v1/websocket", conn.Request().URL.Path)
+		assert.Equal(t, "ambari", conn.Request().Header.Get("X-Requested-By"))
+		login, password, _ := conn.Request().BasicAuth()
+		assert.Equal(t, "admin", login)
+		assert.Equal(t, "admin", password)
+
+		reader := &stompReader{}
+		subscribed := 0
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(conn, &data); err != nil {
+				return
+			}
+			frames, err := reader.read(data)
+			assert.NoError(t, err)
+			for _, frame := range frames {
+				switch frame.command {
+				case stompConnect:
+					websocket.Message.Send(conn, string(newStompFrame(stompConnected, map[string]string{"version": "1.2"}).marshal()))
+				case stompSubscribe:
+					subscribed++
+					if subscribed == 2 {
+						message := newStompFrame(stompMessage, map[string]string{"destination": EVENT_TOPIC_REQUESTS, "subscription": "sub-0"})
+						message.body = []byte(`{"clusterId": 2, "requestId": 12, "requestStatus": "IN_PROGRESS", "progressPercent": 50.0}`)
+						websocket.Message.Send(conn, string(message.marshal()))
+						message = newStompFrame(stompMessage, map[string]string{"destination": EVENT_TOPIC_HOST_COMPONENTS, "subscription": "sub-1"})
+						message.body = []byte(`{"hostComponents": [{"hostName": "worker01", "componentName": "DATANODE", "currentState": "STARTED", "previousState": "STARTING"}]}`)
+						websocket.Message.Send(conn, string(message.marshal()))
+					}
+				case stompDisconnect:
+					return
+				}
+			}
+		}
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	subscription, err := client.Subscribe(ctx, EVENT_TOPIC_REQUESTS, EVENT_TOPIC_HOST_COMPONENTS)
+	assert.NoError(t, err)
+	if subscription == nil {
+		return
+	}
+
+	event := <-subscription.Events()
+	assert.Equal(t, EVENT_TOPIC_REQUESTS, event.Topic)
+	assert.NotNil(t, event.Request)
+	if event.Request != nil {
+		assert.Equal(t, 12, event.Request.RequestId)
+		assert.Equal(t, "IN_PROGRESS", event.Request.RequestStatus)
+		assert.Equal(t, float64(50), event.Request.ProgressPercent)
+	}
+
+	event = <-subscription.Events()
+	assert.Equal(t, EVENT_TOPIC_HOST_COMPONENTS, event.Topic)
+	assert.NotNil(t, event.HostComponents)
+	if event.HostComponents != nil {
+		assert.Equal(t, 1, len(event.HostComponents.HostComponents))
+		assert.Equal(t, "DATANODE", event.HostComponents.HostComponents[0].ComponentName)
+		assert.Equal(t, "STARTED", event.HostComponents.HostComponents[0].CurrentState)
+	}
+
+	// Stop with context
+	cancel()
+	_, ok := <-subscription.Events()
+	assert.False(t, ok)
+	assert.NoError(t, subscription.Err())
+}
+
+// newTestConnectProxy return proxy that only accept CONNECT
+func newTestConnectProxy(tunnels *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		target, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		*tunnels++
+		conn, _, _ := w.(http.Hijacker).Hijack()
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go io.Copy(target, conn)
+		go io.Copy(conn, target)
+	})
+}
+
+func TestSubscribeWithTokenAndProxy(t *testing.T) {
+
+	authorization := ""
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		authorization = conn.Request().Header.Get("Authorization")
+		reader := &stompReader{}
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(conn, &data); err != nil {
+				return
+			}
+			frames, _ := reader.read(data)
+			for _, frame := range frames {
+				if frame.command == stompConnect {
+					websocket.Message.Send(conn, string(newStompFrame(stompConnected, map[string]string{"version": "1.2"}).marshal()))
+				}
+			}
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tunnels := 0
+	proxy := httptest.NewServer(newTestConnectProxy(&tunnels))
+	defer proxy.Close()
+
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	client.SetBearerTokenAuth(StaticToken("my-token"))
+	// SetProxy never use the proxy for 127.0.0.1, so the proxy is set on transport
+	transport, err := client.transport()
+	assert.NoError(t, err)
+	proxyURL, _ := url.Parse(proxy.URL)
+	transport.Proxy = http.ProxyURL(proxyURL)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	subscription, err := client.Subscribe(ctx, EVENT_TOPIC_REQUESTS)
+	assert.NoError(t, err)
+	if subscription != nil {
+		subscription.Close()
+	}
+	assert.Equal(t, "Bearer my-token", authorization)
+	assert.Equal(t, 1, tunnels)
+
+	// The context is used to connect
+	cancel()
+	_, err = client.Subscribe(ctx, EVENT_TOPIC_REQUESTS)
+	assert.Error(t, err)
+}
+
+func TestSubscribeWithHTTPSProxy(t *testing.T) {
+
+	handler := websocket.Handler(func(conn *websocket.Conn) {
+		reader := &stompReader{}
+		for {
+			var data []byte
+			if err := websocket.Message.Receive(conn, &data); err != nil {
+				return
+			}
+			frames, _ := reader.read(data)
+			for _, frame := range frames {
+				if frame.command == stompConnect {
+					websocket.Message.Send(conn, string(newStompFrame(stompConnected, map[string]string{"version": "1.2"}).marshal()))
+				}
+			}
+		}
+	})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+	tunnels := 0
+	proxy := httptest.NewTLSServer(newTestConnectProxy(&tunnels))
+	defer proxy.Close()
+
+	// The TLS options of client are used to connect on proxy
+	client := New(server.URL+"/api/v1", "admin", "admin")
+	client.DisableVerifySSL()
+	transport, err := client.transport()
+	assert.NoError(t, err)
+	proxyURL, _ := url.Parse(proxy.URL)
+	transport.Proxy = http.ProxyURL(proxyURL)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	subscription, err := client.Subscribe(ctx, EVENT_TOPIC_REQUESTS)
+	assert.NoError(t, err)
+	if subscription != nil {
+		subscription.Close()
+	}
+	assert.Equal(t, 1, tunnels)
+
+	// SOCKS proxy is not supported
+	proxyURL, _ = url.Parse("socks5://127.0.0.1:1080")
+	transport.Proxy = http.ProxyURL(proxyURL)
+	_, err = client.Subscribe(ctx, EVENT_TOPIC_REQUESTS)
+	assert.Error(t, err)
+}
+
+func TestProxyAddress(t *testing.T) {
+
+	address, err := proxyAddress(&url.URL{Scheme: "http", Host: "proxy"})
+	assert.NoError(t, err)
+	assert.Equal(t, "proxy:80", address)
+
+	address, err = proxyAddress(&url.URL{Scheme: "https", Host: "proxy"})
+	assert.NoError(t, err)
+	assert.Equal(t, "proxy:443", address)
+
+	address, err = proxyAddress(&url.URL{Scheme: "http", Host: "proxy:3128"})
+	assert.NoError(t, err)
+	assert.Equal(t, "proxy:3128", address)
+
+	_, err = proxyAddress(&url.URL{Scheme: "socks5", Host: "proxy:1080"})
+	assert.Error(t, err)
+}
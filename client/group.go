@@ -0,0 +1,228 @@
+// This file permit to manage the groups of Ambari and their members
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/groups.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GROUP_TYPE_LOCAL = "LOCAL"
+	GROUP_TYPE_LDAP  = "LDAP"
+)
+
+// Group object
+type Group struct {
+	GroupInfo *GroupInfo `json:"Groups"`
+	Members   []Member   `json:"members,omitempty"`
+}
+type GroupsResponse struct {
+	Response
+	Items []Group `json:"items"`
+}
+type GroupInfo struct {
+	GroupName string `json:"group_name,omitempty"`
+	LdapGroup bool   `json:"ldap_group,omitempty"`
+	GroupType string `json:"group_type,omitempty"`
+}
+type Member struct {
+	MemberInfo *MemberInfo `json:"MemberInfo"`
+}
+type MemberInfo struct {
+	UserName  string `json:"user_name,omitempty"`
+	GroupName string `json:"group_name,omitempty"`
+}
+
+// String return group object as Json string
+func (g *Group) String() string {
+	json, _ := json.Marshal(g)
+	return string(json)
+}
+
+// IsLocal return true if the group is managed by Ambari, false if it come from LDAP or another directory
+func (g *GroupInfo) IsLocal() bool {
+	return !g.LdapGroup && (g.GroupType == "" || g.GroupType == GROUP_TYPE_LOCAL)
+}
+
+// MemberNames return the name of users that are member of group
+func (g *Group) MemberNames() []string {
+	names := make([]string, 0, len(g.Members))
+	for _, member := range g.Members {
+		names = append(names, member.MemberInfo.UserName)
+	}
+	return names
+}
+
+// Groups permit to get all groups of Ambari with their members
+// It return the list of groups
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Groups(opts ...RequestOption) ([]Group, error) {
+
+	resp, err := c.get("/groups", opts, Fields("Groups/*", "members/MemberInfo/user_name"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	groupsResponse := &GroupsResponse{}
+	err = json.Unmarshal(resp.Body(), groupsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return groups: %v", groupsResponse.Items)
+
+	return groupsResponse.Items, nil
+}
+
+// Group permit to get group of Ambari with its members
+// It return the group if found
+// It return nil if group not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Group(groupName string, opts ...RequestOption) (*Group, error) {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("Group", "group_name", groupName)
+
+	path := fmt.Sprintf("/groups/%s", groupName)
+	resp, err := c.get(path, opts, Fields("Groups/*", "members/MemberInfo/user_name"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	group := &Group{}
+	err = json.Unmarshal(resp.Body(), group)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return group: %s", group)
+
+	return group, nil
+}
+
+// CreateGroup permit to create local group in Ambari, without members
+// It return the group if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateGroup(groupName string) (*Group, error) {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("CreateGroup", "group_name", groupName)
+
+	jsonData, err := json.Marshal(&Group{GroupInfo: &GroupInfo{GroupName: groupName}})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post("/groups")
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	return c.Group(groupName)
+}
+
+// DeleteGroup permit to delete group of Ambari
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteGroup(groupName string) error {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("DeleteGroup", "group_name", groupName)
+
+	path := fmt.Sprintf("/groups/%s", groupName)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete group: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// AddGroupMember permit to add user in local group
+// It return error if something wrong when it call the API
+func (c *AmbariClient) AddGroupMember(groupName string, userName string) error {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("AddGroupMember", "group_name", groupName, "user_name", userName)
+
+	path := fmt.Sprintf("/groups/%s/members", groupName)
+	jsonData, err := json.Marshal(&Member{MemberInfo: &MemberInfo{GroupName: groupName, UserName: userName}})
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// RemoveGroupMember permit to remove user from local group
+// It return error if something wrong when it call the API
+func (c *AmbariClient) RemoveGroupMember(groupName string, userName string) error {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("RemoveGroupMember", "group_name", groupName, "user_name", userName)
+
+	path := fmt.Sprintf("/groups/%s/members/%s", groupName, userName)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete member: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// GroupPrivileges permit to get the privileges given to the group, on Ambari, on clusters and on view instances
+// It return the list of privileges, with their scope
+// It return error if something wrong when it call the API
+func (c *AmbariClient) GroupPrivileges(groupName string, opts ...RequestOption) ([]Privilege, error) {
+
+	if groupName == "" {
+		panic("GroupName can't be empty")
+	}
+	c.log.Debugw("GroupPrivileges", "group_name", groupName)
+
+	return c.scopedPrivileges(fmt.Sprintf("/groups/%s/privileges", groupName), opts)
+}
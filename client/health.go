@@ -0,0 +1,130 @@
+// This file permit to get the state of all services and components of cluster in one call, for dashboards and checks before maintenance
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ClusterHealthSummary is the state of services of cluster, with the number of hosts where their components are started
+type ClusterHealthSummary struct {
+	ClusterName string          `json:"cluster_name"`
+	Services    []ServiceHealth `json:"services"`
+}
+type ServiceHealth struct {
+	ServiceName      string            `json:"service_name"`
+	State            string            `json:"state"`
+	MaintenanceState string            `json:"maintenance_state"`
+	Components       []ComponentHealth `json:"components"`
+}
+type ComponentHealth struct {
+	ComponentName  string `json:"component_name"`
+	Category       string `json:"category"`
+	State          string `json:"state"`
+	StartedCount   int    `json:"started_count"`
+	InstalledCount int    `json:"installed_count"`
+	TotalCount     int    `json:"total_count"`
+}
+
+// String permit to get cluster health summary as Json string
+func (h *ClusterHealthSummary) String() string {
+	json, _ := json.Marshal(h)
+	return string(json)
+}
+
+// IsHealthy return true when the component is started on all its hosts
+// The clients are always healthy because they can't start
+func (h *ComponentHealth) IsHealthy() bool {
+	return h.Category == COMPONENT_CLIENT || h.StartedCount == h.TotalCount
+}
+
+// IsHealthy return true when service is in maintenance or when all its components are started on all their hosts
+func (h *ServiceHealth) IsHealthy() bool {
+	if h.MaintenanceState == MAINTENANCE_STATE_ON {
+		return true
+	}
+	for _, component := range h.Components {
+		if !component.IsHealthy() {
+			return false
+		}
+	}
+	return true
+}
+
+// UnhealthyServices return the services that are not healthy, like before to start maintenance
+func (h *ClusterHealthSummary) UnhealthyServices() []ServiceHealth {
+	services := make([]ServiceHealth, 0)
+	for _, service := range h.Services {
+		if !service.IsHealthy() {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// GetClusterHealthSummary permit to get the state and the maintenance state of all services of cluster,
+// and for each component the number of hosts where it's started, installed and the total number of hosts.
+// It return the summary
+// It return error if cluster is not found or if something wrong when it call the API
+func (c *AmbariClient) GetClusterHealthSummary(clusterName string) (*ClusterHealthSummary, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("GetClusterHealthSummary", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/services", clusterName)
+	resp, err := c.get(path, nil, Fields(
+		"ServiceInfo/service_name",
+		"ServiceInfo/state",
+		"ServiceInfo/maintenance_state",
+		"components/ServiceComponentInfo/component_name",
+		"components/ServiceComponentInfo/category",
+		"components/ServiceComponentInfo/state",
+		"components/ServiceComponentInfo/started_count",
+		"components/ServiceComponentInfo/installed_count",
+		"components/ServiceComponentInfo/total_count",
+	))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	services := &struct {
+		Items []Service `json:"items"`
+	}{}
+	err = json.Unmarshal(resp.Body(), services)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ClusterHealthSummary{
+		ClusterName: clusterName,
+		Services:    make([]ServiceHealth, 0, len(services.Items)),
+	}
+	for _, service := range services.Items {
+		serviceHealth := ServiceHealth{
+			ServiceName:      service.ServiceInfo.ServiceName,
+			State:            service.ServiceInfo.State,
+			MaintenanceState: service.ServiceInfo.MaintenanceState,
+			Components:       make([]ComponentHealth, 0, len(service.Components)),
+		}
+		for _, component := range service.Components {
+			serviceHealth.Components = append(serviceHealth.Components, ComponentHealth{
+				ComponentName:  component.ComponentInfo.ComponentName,
+				Category:       component.ComponentInfo.Category,
+				State:          component.ComponentInfo.State,
+				StartedCount:   component.ComponentInfo.StartedCount,
+				InstalledCount: component.ComponentInfo.InstalledCount,
+				TotalCount:     component.ComponentInfo.TotalCount,
+			})
+		}
+		summary.Services = append(summary.Services, serviceHealth)
+	}
+	c.log.Debugf("Return cluster health summary: %s", summary)
+
+	return summary, nil
+}
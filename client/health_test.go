@@ -0,0 +1,56 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestGetClusterHealthSummary(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("master01", "test")
+	server.AddHost("worker01", "test")
+	server.AddHost("worker02", "test")
+	server.AddComponent("test", "HDFS", "NAMENODE", COMPONENT_MASTER)
+	server.AddComponent("test", "HDFS", "DATANODE", COMPONENT_SLAVE)
+	server.AddComponent("test", "HDFS", "HDFS_CLIENT", COMPONENT_CLIENT)
+	server.AddComponent("test", "ZOOKEEPER", "ZOOKEEPER_SERVER", COMPONENT_MASTER)
+	server.AddHostComponent("test", "master01", "NAMENODE", SERVICE_STARTED)
+	server.AddHostComponent("test", "worker01", "DATANODE", SERVICE_STARTED)
+	server.AddHostComponent("test", "worker02", "DATANODE", SERVICE_INSTALLED)
+	server.AddHostComponent("test", "worker01", "HDFS_CLIENT", SERVICE_INSTALLED)
+	server.AddHostComponent("test", "master01", "ZOOKEEPER_SERVER", SERVICE_STARTED)
+	client := New(server.BaseURL(), "admin", "admin")
+
+	summary, err := client.GetClusterHealthSummary("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, summary)
+	if summary != nil {
+		assert.Equal(t, "test", summary.ClusterName)
+		assert.Equal(t, 2, len(summary.Services))
+		if len(summary.Services) == 2 {
+			hdfs := summary.Services[0]
+			assert.Equal(t, "HDFS", hdfs.ServiceName)
+			assert.Equal(t, SERVICE_INSTALLED, hdfs.State)
+			assert.Equal(t, MAINTENANCE_STATE_OFF, hdfs.MaintenanceState)
+			assert.Contains(t, hdfs.Components, ComponentHealth{ComponentName: "DATANODE", Category: COMPONENT_SLAVE, StartedCount: 1, InstalledCount: 1, TotalCount: 2})
+			assert.False(t, hdfs.IsHealthy())
+			assert.True(t, summary.Services[1].IsHealthy())
+
+			unhealthy := summary.UnhealthyServices()
+			assert.Equal(t, 1, len(unhealthy))
+			assert.Equal(t, "HDFS", unhealthy[0].ServiceName)
+
+			// Service in maintenance
+			hdfs.MaintenanceState = MAINTENANCE_STATE_ON
+			assert.True(t, hdfs.IsHealthy())
+		}
+	}
+
+	// Cluster not found
+	_, err = client.GetClusterHealthSummary("other")
+	assert.Error(t, err)
+}
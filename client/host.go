@@ -4,13 +4,21 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 	"strings"
 	"time"
 )
 
+const (
+	HOST_STATUS_HEALTHY   = "HEALTHY"
+	HOST_STATUS_UNHEALTHY = "UNHEALTHY"
+	HOST_STATUS_ALERT     = "ALERT"
+	HOST_STATUS_UNKNOWN   = "UNKNOWN"
+)
+
 // Host object
 type Host struct {
 	HostInfo       *HostInfo       `json:"Hosts"`
@@ -20,10 +28,13 @@ type Hosts struct {
 	Items []Host `json:"items,omitempty"`
 }
 type HostInfo struct {
-	ClusterName      string `json:"cluster_name,omitempty"`
-	Hostname         string `json:"host_name,omitempty"`
-	MaintenanceState string `json:"maintenance_state,omitempty"`
-	Rack             string `json:"rack_info,omitempty"`
+	ClusterName       string `json:"cluster_name,omitempty"`
+	Hostname          string `json:"host_name,omitempty"`
+	MaintenanceState  string `json:"maintenance_state,omitempty"`
+	Rack              string `json:"rack_info,omitempty"`
+	HostStatus        string `json:"host_status,omitempty"`
+	HostState         string `json:"host_state,omitempty"`
+	LastHeartbeatTime int64  `json:"last_heartbeat_time,omitempty"`
 }
 type HostBlueprint struct {
 	Blueprint string `json:"blueprint,omitempty"`
@@ -36,9 +47,23 @@ func (h *Host) String() string {
 	return string(json)
 }
 
+// LastHeartbeat return the time of the last heartbeat sent by Ambari agent, zero time if it's not known
+func (h *HostInfo) LastHeartbeat() time.Time {
+	if h.LastHeartbeatTime <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, h.LastHeartbeatTime*int64(time.Millisecond))
+}
+
 // CleanBeforeSave permit to remove some attribute before save or update host
+// The status and the heartbeat are computed by Ambari, so they are removed
 func (h *Host) CleanBeforeSave() {
 	h.HostComponents = make([]HostComponent, 0, 0)
+	if h.HostInfo != nil {
+		h.HostInfo.HostStatus = ""
+		h.HostInfo.HostState = ""
+		h.HostInfo.LastHeartbeatTime = 0
+	}
 }
 
 // CreateHost permit to create host (attach existing Ambari host on existing cluster)
@@ -50,7 +75,7 @@ func (c *AmbariClient) CreateHost(host *Host) (*Host, error) {
 	if host == nil {
 		panic("Host can't be nil")
 	}
-	log.Debugf("Host: %s", host.String())
+	c.log.Debugf("Host: %s", host.String())
 
 	host.CleanBeforeSave()
 	path := fmt.Sprintf("/clusters/%s/hosts/%s", host.HostInfo.ClusterName, host.HostInfo.Hostname)
@@ -62,9 +87,9 @@ func (c *AmbariClient) CreateHost(host *Host) (*Host, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	host, err = c.HostOnCluster(host.HostInfo.ClusterName, host.HostInfo.Hostname)
@@ -75,7 +100,7 @@ func (c *AmbariClient) CreateHost(host *Host) (*Host, error) {
 		return nil, NewAmbariError(500, "Can't get host that just created")
 	}
 
-	log.Debugf("Return host: %s", host)
+	c.log.Debugf("Return host: %s", host)
 
 	return host, nil
 
@@ -85,7 +110,7 @@ func (c *AmbariClient) CreateHost(host *Host) (*Host, error) {
 // It return host object if host is found on cluster
 // It return nil if host not found on cluster
 // It return error if somethink wrong when it cal the API
-func (c *AmbariClient) HostOnCluster(clusterName string, hostname string) (*Host, error) {
+func (c *AmbariClient) HostOnCluster(clusterName string, hostname string, opts ...RequestOption) (*Host, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -93,20 +118,19 @@ func (c *AmbariClient) HostOnCluster(clusterName string, hostname string) (*Host
 	if hostname == "" {
 		panic("HostName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
+	c.log.Debugw("HostOnCluster", "cluster_name", clusterName, "hostname", hostname)
 
 	path := fmt.Sprintf("/clusters/%s/hosts/%s", clusterName, hostname)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	host := &Host{}
@@ -114,7 +138,7 @@ func (c *AmbariClient) HostOnCluster(clusterName string, hostname string) (*Host
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return host: %s", host)
+	c.log.Debugf("Return host: %s", host)
 
 	return host, nil
 }
@@ -122,24 +146,24 @@ func (c *AmbariClient) HostOnCluster(clusterName string, hostname string) (*Host
 // HostsOnCluster permit to get all hosts in cluster
 // It return slice of host (the slice can't be empty if there are no host)
 // It return error if something wrong in API call
-func (c *AmbariClient) HostsOnCluster(clusterName string) ([]Host, error) {
+func (c *AmbariClient) HostsOnCluster(clusterName string, opts ...RequestOption) ([]Host, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("HostsOnCluster", "cluster_name", clusterName)
 
 	path := fmt.Sprintf("/clusters/%s/hosts", clusterName)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	hosts := &Hosts{}
@@ -147,33 +171,65 @@ func (c *AmbariClient) HostsOnCluster(clusterName string) ([]Host, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return host: %s", hosts)
+	c.log.Debug("Return hosts: ", hosts)
 
 	return hosts.Items, nil
 }
 
+// ListHosts permit to get the hosts of cluster with their status, their last heartbeat, their maintenance state and the state of their components, in one call.
+// The predicate permit to filter the hosts, like Eq("Hosts/host_status", HOST_STATUS_UNHEALTHY), it can be nil.
+// The option Fields permit to ask other fields.
+// It return slice of host (the slice can be empty if there are no host)
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ListHosts(clusterName string, predicate *Predicate, opts ...RequestOption) ([]Host, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ListHosts", "cluster_name", clusterName, "predicate", predicate)
+
+	hostOpts := []RequestOption{
+		Where(predicate),
+		Fields(
+			"Hosts/host_name",
+			"Hosts/cluster_name",
+			"Hosts/host_status",
+			"Hosts/host_state",
+			"Hosts/last_heartbeat_time",
+			"Hosts/maintenance_state",
+			"Hosts/rack_info",
+			"host_components/HostRoles/component_name",
+			"host_components/HostRoles/service_name",
+			"host_components/HostRoles/state",
+			"host_components/HostRoles/desired_state",
+		),
+	}
+
+	return c.HostsOnCluster(clusterName, append(hostOpts, opts...)...)
+}
+
 // Host permit to get host from hostname
 // It return host if is found
 // It return nil if is not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Host(hostname string) (*Host, error) {
+func (c *AmbariClient) Host(hostname string, opts ...RequestOption) (*Host, error) {
 
 	if hostname == "" {
 		panic("HostName can't be empty")
 	}
-	log.Debug("Hostname: ", hostname)
+	c.log.Debugw("Host", "hostname", hostname)
 
 	path := fmt.Sprintf("/hosts/%s", hostname)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	host := &Host{}
@@ -181,7 +237,7 @@ func (c *AmbariClient) Host(hostname string) (*Host, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return host: %s", host)
+	c.log.Debugf("Return host: %s", host)
 
 	return host, nil
 }
@@ -189,21 +245,21 @@ func (c *AmbariClient) Host(hostname string) (*Host, error) {
 // Hosts permit to get all ambari agent hosts
 // It return slice of hosts (slice can be empty if there are no ambari agent)
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Hosts() ([]Host, error) {
+func (c *AmbariClient) Hosts(opts ...RequestOption) ([]Host, error) {
 
 	path := fmt.Sprintf("/hosts")
 
 	// Get the host components
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	hosts := &Hosts{}
@@ -211,7 +267,7 @@ func (c *AmbariClient) Hosts() ([]Host, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return host: %s", hosts)
+	c.log.Debug("Return hosts: ", hosts)
 
 	return hosts.Items, nil
 }
@@ -224,7 +280,7 @@ func (c *AmbariClient) UpdateHost(host *Host) (*Host, error) {
 	if host == nil {
 		panic("Host can't be nil")
 	}
-	log.Debug("Host: ", host)
+	c.log.Debugw("UpdateHost", "host", host)
 
 	host.CleanBeforeSave()
 	path := fmt.Sprintf("/clusters/%s/hosts/%s", host.HostInfo.ClusterName, host.HostInfo.Hostname)
@@ -236,9 +292,9 @@ func (c *AmbariClient) UpdateHost(host *Host) (*Host, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the Host
@@ -250,7 +306,7 @@ func (c *AmbariClient) UpdateHost(host *Host) (*Host, error) {
 		return nil, NewAmbariError(500, "Can't get host that just updated")
 	}
 
-	log.Debugf("Return host: %s", host.String())
+	c.log.Debugf("Return host: %s", host.String())
 
 	return host, err
 
@@ -266,8 +322,7 @@ func (c *AmbariClient) DeleteHost(clusterName string, hostname string) error {
 	if hostname == "" {
 		panic("Hostname can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
+	c.log.Debugw("DeleteHost", "cluster_name", clusterName, "hostname", hostname)
 
 	// Check if host exist on cluster
 	host, err := c.HostOnCluster(clusterName, hostname)
@@ -290,9 +345,9 @@ func (c *AmbariClient) DeleteHost(clusterName string, hostname string) error {
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete host: ", resp)
+	c.log.Debug("Response to delete host: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -316,10 +371,7 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if role == "" {
 		panic("Role can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("BlueprintName: ", blueprintName)
-	log.Debug("Role: ", role)
+	c.log.Debugw("RegisterHostOnCluster", "cluster_name", clusterName, "hostname", hostname, "blueprint_name", blueprintName, "role", role)
 
 	// Check if host exist
 	host, err := c.Host(hostname)
@@ -329,7 +381,7 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if host == nil {
 		return nil, NewAmbariError(404, "Host %s not found", hostname)
 	}
-	log.Debugf("Host %s found", hostname)
+	c.log.Debugf("Host %s found", hostname)
 
 	// Check if cluster exist
 	cluster, err := c.Cluster(clusterName)
@@ -339,7 +391,7 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if cluster == nil {
 		return nil, NewAmbariError(404, "Cluster %s not found", clusterName)
 	}
-	log.Debugf("Cluster %s found", clusterName)
+	c.log.Debugf("Cluster %s found", clusterName)
 
 	// Check if blueprint exit
 	blueprint, err := c.Blueprint(blueprintName)
@@ -349,7 +401,7 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if blueprint == nil {
 		return nil, NewAmbariError(404, "Blueprint %s not found", blueprintName)
 	}
-	log.Debugf("Blueprint %s found", blueprintName)
+	c.log.Debugf("Blueprint %s found", blueprintName)
 
 	// Check if role exist on blueprint
 	hostGroupFound := false
@@ -362,7 +414,7 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if hostGroupFound == false {
 		return nil, NewAmbariError(404, "Role %s not found in blueprint %s", role, blueprintName)
 	}
-	log.Debugf("Role %s found in blueprint %s", role, blueprintName)
+	c.log.Debugf("Role %s found in blueprint %s", role, blueprintName)
 
 	// Associate host to blueprint role
 	path := fmt.Sprintf("/clusters/%s/hosts/%s", clusterName, hostname)
@@ -378,24 +430,23 @@ func (c *AmbariClient) RegisterHostOnCluster(clusterName string, hostname string
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Wait host join the cluster
-	isJoinCluster := false
-	for isJoinCluster == false {
+	ctx, cancel := c.waitTimeout(context.Background())
+	defer cancel()
+	err = c.poll(ctx, func() (bool, error) {
 		host, err = c.HostOnCluster(clusterName, hostname)
-		if err != nil {
-			return nil, err
-		}
-
-		if host != nil {
-			isJoinCluster = true
-		} else {
-			time.Sleep(5)
-		}
+		return host != nil, err
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, NewAmbariError(408, "Host %s not join cluster %s after %s", hostname, clusterName, c.requestTimeout)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	return host, nil
@@ -414,10 +465,7 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 		panic("Hostname can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("EnableMaintenanceMode: ", enableMaintenanceMode)
-	log.Debug("Force: ", force)
+	c.log.Debugw("StopAllComponentsInHost", "cluster_name", clusterName, "hostname", hostname, "enable_maintenance_mode", enableMaintenanceMode, "force", force)
 
 	// Check if host exist
 	host, err := c.HostOnCluster(clusterName, hostname)
@@ -427,7 +475,7 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 	if host == nil {
 		return NewAmbariError(404, "Host %s not found in cluster %s", hostname, clusterName)
 	}
-	log.Debugf("Host %s found in cluster %s", hostname, clusterName)
+	c.log.Debugf("Host %s found in cluster %s", hostname, clusterName)
 
 	// Disable maintenance state in host if needed
 	if force == true && host.HostInfo.MaintenanceState != MAINTENANCE_STATE_OFF {
@@ -436,7 +484,7 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 		if err != nil {
 			return err
 		}
-		log.Debugf("Maintenace state is disable on host %s", hostname)
+		c.log.Debugf("Maintenace state is disable on host %s", hostname)
 	}
 
 	// Extract the components on host and exlude all client components
@@ -475,7 +523,7 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 		},
 		Body: hostComponent,
 	}
-	log.Debugf("Request sended : %s", request)
+	c.log.Debugf("Request sended : %s", request)
 	path := fmt.Sprintf("/clusters/%s/hosts/%s/host_components", clusterName, hostname)
 	jsonData, err := json.Marshal(request)
 	if err != nil {
@@ -485,12 +533,12 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to stop all components: ", resp)
+	c.log.Debug("Response to stop all components: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
-		log.Debugf("All components already stopped")
+		c.log.Debugf("All components already stopped")
 		return nil
 	}
 	requestTask := &RequestTask{}
@@ -498,25 +546,17 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 	if err != nil {
 		return err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	// Wait the end of the request
-	for requestTask.RequestTaskInfo.ProgressPercent < 100 {
-
-		requestTask, err = c.Request(clusterName, requestTask.RequestTaskInfo.Id)
-		if err != nil {
-			return err
-		}
-		if requestTask == nil {
-			return NewAmbariError(404, "Request with Id %d not found", requestTask.RequestTaskInfo.Id)
-		}
-
-		time.Sleep(10 * time.Second)
+	err = requestTask.Wait(c, clusterName)
+	if err != nil {
+		return err
 	}
 
 	// Check the status
 	if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-		return NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+		return NewRequestError(requestTask)
 	}
 
 	// Enable host maintenance if needed
@@ -527,7 +567,7 @@ func (c *AmbariClient) StopAllComponentsInHost(clusterName string, hostname stri
 			return err
 		}
 
-		log.Debugf("Maintenace state is enable on host %s", hostname)
+		c.log.Debugf("Maintenace state is enable on host %s", hostname)
 	}
 
 	return nil
@@ -546,9 +586,7 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 	if hostname == "" {
 		panic("Hostname can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("DisableMaintenanceMode: ", disableMaintenanceMode)
+	c.log.Debugw("StartAllComponentsInHost", "cluster_name", clusterName, "hostname", hostname, "disable_maintenance_mode", disableMaintenanceMode)
 
 	// Check if host exist
 	host, err := c.HostOnCluster(clusterName, hostname)
@@ -558,7 +596,7 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 	if host == nil {
 		return NewAmbariError(404, "Host %s not found in cluster %s", hostname, clusterName)
 	}
-	log.Debugf("Host %s found in cluster %s", hostname, clusterName)
+	c.log.Debugf("Host %s found in cluster %s", hostname, clusterName)
 
 	// Disable maintenance state in host if needed
 	if disableMaintenanceMode == true && host.HostInfo.MaintenanceState != MAINTENANCE_STATE_OFF {
@@ -567,7 +605,7 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 		if err != nil {
 			return err
 		}
-		log.Debugf("Maintenace state is disable on host %s", hostname)
+		c.log.Debugf("Maintenace state is disable on host %s", hostname)
 	}
 
 	// Start all components in host
@@ -583,7 +621,7 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 		},
 		Body: hostComponent,
 	}
-	log.Debugf("Request sended : %s", request)
+	c.log.Debugf("Request sended : %s", request)
 	path := fmt.Sprintf("/clusters/%s/hosts/%s/host_components", clusterName, hostname)
 	jsonData, err := json.Marshal(request)
 	if err != nil {
@@ -593,12 +631,12 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to start all components: ", resp)
+	c.log.Debug("Response to start all components: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
-		log.Debugf("All components already started")
+		c.log.Debugf("All components already started")
 		return nil
 	}
 	requestTask := &RequestTask{}
@@ -606,25 +644,17 @@ func (c *AmbariClient) StartAllComponentsInHost(clusterName string, hostname str
 	if err != nil {
 		return err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	// Wait the end of the request
-	for requestTask.RequestTaskInfo.ProgressPercent < 100 {
-
-		requestTask, err = c.Request(clusterName, requestTask.RequestTaskInfo.Id)
-		if err != nil {
-			return err
-		}
-		if requestTask == nil {
-			return NewAmbariError(404, "Request with Id %d not found", requestTask.RequestTaskInfo.Id)
-		}
-
-		time.Sleep(10 * time.Second)
+	err = requestTask.Wait(c, clusterName)
+	if err != nil {
+		return err
 	}
 
 	// Check the status
 	if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-		return NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+		return NewRequestError(requestTask)
 	}
 
 	return nil
@@ -642,9 +672,7 @@ func (c *AmbariClient) DeleteAllComponentsInHost(clusterName string, hostname st
 		panic("Hostname can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("DisableMaintenanceMode: ", disableMaintenanceMode)
+	c.log.Debugw("DeleteAllComponentsInHost", "cluster_name", clusterName, "hostname", hostname, "disable_maintenance_mode", disableMaintenanceMode)
 
 	// Check if host exist
 	host, err := c.HostOnCluster(clusterName, hostname)
@@ -654,7 +682,7 @@ func (c *AmbariClient) DeleteAllComponentsInHost(clusterName string, hostname st
 	if host == nil {
 		return NewAmbariError(404, "Host %s not found in cluster %s", hostname, clusterName)
 	}
-	log.Debugf("Host %s found in cluster %s", hostname, clusterName)
+	c.log.Debugf("Host %s found in cluster %s", hostname, clusterName)
 
 	// Disable maintenance state in host if needed
 	if disableMaintenanceMode == true && host.HostInfo.MaintenanceState != MAINTENANCE_STATE_OFF {
@@ -663,7 +691,7 @@ func (c *AmbariClient) DeleteAllComponentsInHost(clusterName string, hostname st
 		if err != nil {
 			return err
 		}
-		log.Debugf("Maintenace state is disable on host %s", hostname)
+		c.log.Debugf("Maintenace state is disable on host %s", hostname)
 	}
 
 	// Stop and delete all components in host and wait
@@ -672,12 +700,12 @@ func (c *AmbariClient) DeleteAllComponentsInHost(clusterName string, hostname st
 		if err != nil {
 			return err
 		}
-		log.Infof("Component %s is stopped", hostComponent.HostComponentInfo.ComponentName)
+		c.log.Infof("Component %s is stopped", hostComponent.HostComponentInfo.ComponentName)
 		err = c.DeleteHostComponent(clusterName, hostname, hostComponent.HostComponentInfo.ComponentName)
 		if err != nil {
 			return err
 		}
-		log.Infof("Component %s is deleted", hostComponent.HostComponentInfo.ComponentName)
+		c.log.Infof("Component %s is deleted", hostComponent.HostComponentInfo.ComponentName)
 	}
 
 	return nil
@@ -6,7 +6,17 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
+)
+
+const (
+	ADMIN_STATE_INSERVICE      = "INSERVICE"
+	ADMIN_STATE_DECOMMISSIONED = "DECOMMISSIONED"
+
+	UPGRADE_STATE_NONE             = "NONE"
+	UPGRADE_STATE_IN_PROGRESS      = "IN_PROGRESS"
+	UPGRADE_STATE_COMPLETE         = "COMPLETE"
+	UPGRADE_STATE_FAILED           = "FAILED"
+	UPGRADE_STATE_VERSION_MISMATCH = "VERSION_MISMATCH"
 )
 
 // Object that reflect the Ambari API
@@ -14,18 +24,44 @@ type HostComponent struct {
 	HostComponentInfo *HostComponentInfo `json:"HostRoles"`
 }
 type HostComponentInfo struct {
-	ClusterName   string                 `json:"cluster_name,omitempty"`
-	ComponentName string                 `json:"component_name,omitempty"`
-	Hostname      string                 `json:"host_name,omitempty"`
-	State         string                 `json:"state,omitempty"`
-	DesiredState  string                 `json:"desired_state,omitempty"`
-	ServiceName   string                 `json:"service_name,omitempty"`
-	HaState       string                 `json:"ha_state,omitempty"`
-	Metrics       map[string]interface{} `json:"metrics,omitempty"`
+	ClusterName              string                 `json:"cluster_name,omitempty"`
+	ComponentName            string                 `json:"component_name,omitempty"`
+	Hostname                 string                 `json:"host_name,omitempty"`
+	State                    string                 `json:"state,omitempty"`
+	DesiredState             string                 `json:"desired_state,omitempty"`
+	ServiceName              string                 `json:"service_name,omitempty"`
+	HaState                  string                 `json:"ha_state,omitempty"`
+	DesiredAdminState        string                 `json:"desired_admin_state,omitempty"`
+	StaleConfigs             bool                   `json:"stale_configs,omitempty"`
+	ReloadConfigs            bool                   `json:"reload_configs,omitempty"`
+	UpgradeState             string                 `json:"upgrade_state,omitempty"`
+	Version                  string                 `json:"version,omitempty"`
+	DesiredRepositoryVersion string                 `json:"desired_repository_version,omitempty"`
+	Metrics                  map[string]interface{} `json:"metrics,omitempty"`
 }
 
+// CleanBeforeSave permit to remove the fields that are computed by Ambari
 func (h *HostComponent) CleanBeforeSave() {
 	h.HostComponentInfo.DesiredState = ""
+	h.HostComponentInfo.DesiredAdminState = ""
+	h.HostComponentInfo.StaleConfigs = false
+	h.HostComponentInfo.ReloadConfigs = false
+	h.HostComponentInfo.UpgradeState = ""
+	h.HostComponentInfo.Version = ""
+	h.HostComponentInfo.DesiredRepositoryVersion = ""
+}
+
+// StaleCode return true if the component not run the version of stack expected by the cluster, like after an upgrade not finished on host
+func (h *HostComponentInfo) StaleCode() bool {
+	if h.UpgradeState == UPGRADE_STATE_VERSION_MISMATCH {
+		return true
+	}
+	return h.Version != "" && h.Version != "UNKNOWN" && h.DesiredRepositoryVersion != "" && h.Version != h.DesiredRepositoryVersion
+}
+
+// RestartRequired return true if the component must be restarted to use its current configurations or the expected version of stack
+func (h *HostComponentInfo) RestartRequired() bool {
+	return h.StaleConfigs || h.StaleCode()
 }
 
 // String permit to display the struct as JSON object
@@ -42,7 +78,7 @@ func (c *AmbariClient) CreateHostComponent(hostComponent *HostComponent) (*HostC
 	if hostComponent == nil {
 		panic("HostComponent can't be nil")
 	}
-	log.Debugf("HostComponent: %s", hostComponent.String())
+	c.log.Debugf("HostComponent: %s", hostComponent.String())
 
 	// Check if hostcomponent is already installed
 	hostComponentTemp, err := c.HostComponent(hostComponent.HostComponentInfo.ClusterName, hostComponent.HostComponentInfo.Hostname, hostComponent.HostComponentInfo.ComponentName)
@@ -61,9 +97,9 @@ func (c *AmbariClient) CreateHostComponent(hostComponent *HostComponent) (*HostC
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	hostComponent, err = c.HostComponent(hostComponent.HostComponentInfo.ClusterName, hostComponent.HostComponentInfo.Hostname, hostComponent.HostComponentInfo.ComponentName)
@@ -94,7 +130,7 @@ func (c *AmbariClient) CreateHostComponent(hostComponent *HostComponent) (*HostC
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 	}
 
@@ -111,7 +147,7 @@ func (c *AmbariClient) CreateHostComponent(hostComponent *HostComponent) (*HostC
 // HostComponent permit to load the host component
 // It return the host component or nil if the component is not found
 // It return error if there are some error in API call.
-func (c *AmbariClient) HostComponent(clusterName string, hostname string, componentName string) (*HostComponent, error) {
+func (c *AmbariClient) HostComponent(clusterName string, hostname string, componentName string, opts ...RequestOption) (*HostComponent, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -125,16 +161,16 @@ func (c *AmbariClient) HostComponent(clusterName string, hostname string, compon
 	path := fmt.Sprintf("/clusters/%s/hosts/%s/host_components/%s", clusterName, hostname, componentName)
 
 	// Get the host components
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	hostComponent := &HostComponent{}
@@ -142,7 +178,7 @@ func (c *AmbariClient) HostComponent(clusterName string, hostname string, compon
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("HostComponent: ", hostComponent)
+	c.log.Debug("HostComponent: ", hostComponent)
 
 	return hostComponent, nil
 }
@@ -155,7 +191,12 @@ func (c *AmbariClient) UpdateHostComponent(hostComponent *HostComponent) (*HostC
 	if hostComponent == nil {
 		panic("HostComponent can't be nil")
 	}
-	log.Debug("HostComponent: ", hostComponent)
+	c.log.Debugw("UpdateHostComponent", "host_component", hostComponent)
+	if c.validation {
+		if err := ValidateHostComponent(hostComponent); err != nil {
+			return nil, err
+		}
+	}
 
 	// Update the Cluster
 	hostComponent.CleanBeforeSave()
@@ -168,9 +209,9 @@ func (c *AmbariClient) UpdateHostComponent(hostComponent *HostComponent) (*HostC
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the HostComponent
@@ -182,7 +223,7 @@ func (c *AmbariClient) UpdateHostComponent(hostComponent *HostComponent) (*HostC
 		return nil, NewAmbariError(500, "Can't get hostComponent that just updated")
 	}
 
-	log.Debug("HostComponent: ", hostComponent)
+	c.log.Debug("HostComponent: ", hostComponent)
 
 	return hostComponent, err
 
@@ -198,8 +239,13 @@ func (c *AmbariClient) SendRequestHostComponent(request *Request) (*RequestTask,
 	if request == nil {
 		panic("Request can't be nil")
 	}
-	log.Debug("Request: ", request)
+	c.log.Debugw("SendRequestHostComponent", "request", request)
 	hostComponent := request.Body.(*HostComponent)
+	if c.validation {
+		if err := ValidateHostComponent(hostComponent); err != nil {
+			return nil, err
+		}
+	}
 	hostComponentTemp := &HostComponent{
 		HostComponentInfo: &HostComponentInfo{
 			State: hostComponent.HostComponentInfo.State,
@@ -207,7 +253,7 @@ func (c *AmbariClient) SendRequestHostComponent(request *Request) (*RequestTask,
 	}
 	request.Body = hostComponentTemp
 
-	log.Debug("Sended Request: ", request)
+	c.log.Debug("Sended Request: ", request)
 
 	path := fmt.Sprintf("/clusters/%s/hosts/%s/host_components/%s", hostComponent.HostComponentInfo.ClusterName, hostComponent.HostComponentInfo.Hostname, hostComponent.HostComponentInfo.ComponentName)
 	jsonData, err := json.Marshal(request)
@@ -218,9 +264,9 @@ func (c *AmbariClient) SendRequestHostComponent(request *Request) (*RequestTask,
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response when send request: ", resp)
+	c.log.Debug("Response when send request: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
 		return nil, nil
@@ -230,7 +276,7 @@ func (c *AmbariClient) SendRequestHostComponent(request *Request) (*RequestTask,
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	return requestTask, err
 }
@@ -250,9 +296,7 @@ func (c *AmbariClient) StopHostComponent(clusterName string, hostname string, co
 	if componentName == "" {
 		panic("ComponentName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("ComponentName: ", componentName)
+	c.log.Debugw("StopHostComponent", "cluster_name", clusterName, "hostname", hostname, "component_name", componentName)
 
 	// Load the hostComponent
 	hostComponent, err := c.HostComponent(clusterName, hostname, componentName)
@@ -265,7 +309,7 @@ func (c *AmbariClient) StopHostComponent(clusterName string, hostname string, co
 
 	// Check if components is already stopped
 	if hostComponent.HostComponentInfo.State == SERVICE_STOPPED && hostComponent.HostComponentInfo.DesiredState == SERVICE_STOPPED {
-		log.Debugf("Component %s on host %s is already stopped", componentName, hostname)
+		c.log.Debugf("Component %s on host %s is already stopped", componentName, hostname)
 		return hostComponent, nil
 	}
 
@@ -292,7 +336,7 @@ func (c *AmbariClient) StopHostComponent(clusterName string, hostname string, co
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 	}
 
@@ -321,9 +365,7 @@ func (c *AmbariClient) StartHostComponent(clusterName string, hostname string, c
 	if componentName == "" {
 		panic("ComponentName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Hostname: ", hostname)
-	log.Debug("ComponentName: ", componentName)
+	c.log.Debugw("StartHostComponent", "cluster_name", clusterName, "hostname", hostname, "component_name", componentName)
 
 	// Load the hostComponent
 	hostComponent, err := c.HostComponent(clusterName, hostname, componentName)
@@ -336,7 +378,7 @@ func (c *AmbariClient) StartHostComponent(clusterName string, hostname string, c
 
 	// Check if components is already started
 	if hostComponent.HostComponentInfo.State == SERVICE_STARTED && hostComponent.HostComponentInfo.DesiredState == SERVICE_STARTED {
-		log.Debugf("Component %s on host %s is already started", componentName, hostname)
+		c.log.Debugf("Component %s on host %s is already started", componentName, hostname)
 		return hostComponent, nil
 	}
 
@@ -349,7 +391,7 @@ func (c *AmbariClient) StartHostComponent(clusterName string, hostname string, c
 		return nil, NewAmbariError(404, "Component %s not found in service %s on cluster %s", componentName, hostComponent.HostComponentInfo.ServiceName, clusterName)
 	}
 	if component.ComponentInfo.Category == COMPONENT_CLIENT {
-		log.Debugf("Component %s is client, it can't start", componentName)
+		c.log.Debugf("Component %s is client, it can't start", componentName)
 		return hostComponent, nil
 	}
 
@@ -376,7 +418,7 @@ func (c *AmbariClient) StartHostComponent(clusterName string, hostname string, c
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 	}
 
@@ -415,11 +457,43 @@ func (c *AmbariClient) DeleteHostComponent(clusterName string, hostname string,
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete hostComponent: ", resp)
+	c.log.Debug("Response to delete hostComponent: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
 
 }
+
+// ListComponentsNeedingRestart permit to get the started components on all hosts of cluster that must be restarted,
+// because they not use their current configurations (stale_configs) or not run the expected version of stack (upgrade_state or version)
+// The components are read one by one, so it use little memory on big cluster
+// It return the list of host components, empty if all components are up to date
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ListComponentsNeedingRestart(clusterName string, opts ...RequestOption) ([]HostComponent, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ListComponentsNeedingRestart", "cluster_name", clusterName)
+
+	hostComponents := make([]HostComponent, 0)
+	path := fmt.Sprintf("/clusters/%s/host_components", clusterName)
+	err := c.stream(path, func(item json.RawMessage) error {
+		hostComponent := HostComponent{}
+		if err := json.Unmarshal(item, &hostComponent); err != nil {
+			return err
+		}
+		if hostComponent.HostComponentInfo.State == SERVICE_STARTED && hostComponent.HostComponentInfo.RestartRequired() {
+			hostComponents = append(hostComponents, hostComponent)
+		}
+		return nil
+	}, opts, Fields("HostRoles/cluster_name", "HostRoles/service_name", "HostRoles/component_name", "HostRoles/host_name", "HostRoles/state", "HostRoles/desired_admin_state", "HostRoles/stale_configs", "HostRoles/reload_configs", "HostRoles/upgrade_state", "HostRoles/version", "HostRoles/desired_repository_version"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Return host components: ", hostComponents)
+
+	return hostComponents, nil
+}
@@ -0,0 +1,224 @@
+// This file permit to move master component from one host to another host, like Ambari UI do with the "Move Master" wizard
+
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// hostnamePattern match the words that can be hostname in property value
+var hostnamePattern = regexp.MustCompile(`[A-Za-z0-9.-]+`)
+
+// masterComponentProperties is the properties that reference the host of master component, by configuration type, like the Ambari "Move Master" wizard update them
+// The other properties are not changed, because they can reference other components on the same host, like fs.defaultFS for NAMENODE.
+var masterComponentProperties = map[string]map[string][]string{
+	"NAMENODE": {
+		"core-site": {"fs.defaultFS"},
+		"hdfs-site": {"dfs.namenode.http-address", "dfs.namenode.https-address", "dfs.namenode.rpc-address", "dfs.namenode.servicerpc-address"},
+	},
+	"SECONDARY_NAMENODE": {
+		"hdfs-site": {"dfs.namenode.secondary.http-address"},
+	},
+	"RESOURCEMANAGER": {
+		"yarn-site": {
+			"yarn.resourcemanager.hostname",
+			"yarn.resourcemanager.address",
+			"yarn.resourcemanager.admin.address",
+			"yarn.resourcemanager.resource-tracker.address",
+			"yarn.resourcemanager.scheduler.address",
+			"yarn.resourcemanager.webapp.address",
+			"yarn.resourcemanager.webapp.https.address",
+		},
+	},
+	"APP_TIMELINE_SERVER": {
+		"yarn-site": {"yarn.timeline-service.address", "yarn.timeline-service.webapp.address", "yarn.timeline-service.webapp.https.address"},
+	},
+	"HISTORYSERVER": {
+		"mapred-site": {"mapreduce.jobhistory.address", "mapreduce.jobhistory.webapp.address", "mapreduce.jobhistory.webapp.https.address"},
+		"yarn-site":   {"yarn.log.server.url"},
+	},
+	"OOZIE_SERVER": {
+		"oozie-site": {"oozie.base.url"},
+		"core-site":  {"hadoop.proxyuser.oozie.hosts"},
+	},
+	"HIVE_METASTORE": {
+		"hive-site": {"hive.metastore.uris"},
+		"core-site": {"hadoop.proxyuser.hive.hosts"},
+	},
+	"HIVE_SERVER": {
+		"core-site": {"hadoop.proxyuser.hive.hosts"},
+	},
+	"WEBHCAT_SERVER": {
+		"core-site": {"hadoop.proxyuser.HTTP.hosts", "hadoop.proxyuser.hcat.hosts"},
+	},
+	"MYSQL_SERVER": {
+		"hive-site": {"javax.jdo.option.ConnectionURL"},
+	},
+	"METRICS_COLLECTOR": {
+		"ams-site": {"timeline.metrics.service.webapp.address"},
+	},
+}
+
+// MoveMasterComponent permit to move master component, like RESOURCEMANAGER or OOZIE_SERVER, from sourceHostname to targetHostname
+// It add and install the component on the target host, stop it on the source host, update the configurations, start it on the target host, then delete it from the source host.
+// The source hostname is replaced by the target hostname only in the properties that reference the component, like yarn.resourcemanager.hostname (see masterComponentProperties),
+// so the other components on the source host are not affected.
+// ConfigUpdates permit to set other properties by configuration type, it can be nil. It's needed for the components not known, like ZOOKEEPER_SERVER.
+// The services that use the component, like the clients, must be restarted to read the new configurations.
+// The component is kept on the source host until it's started on the target host, so if a step failed, call it again to resume the move:
+// the component not started on the target host is installed if needed, and the configurations already updated are not changed.
+// If the component is already on target host and not on source host, it do nothing.
+// It return the host component on target host
+// It return error if component is not master, if target host is not in cluster, if component is already started on target host or if something wrong when it call the API
+func (c *AmbariClient) MoveMasterComponent(clusterName string, componentName string, sourceHostname string, targetHostname string, configUpdates map[string]map[string]string) (*HostComponent, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	if sourceHostname == "" {
+		panic("SourceHostname can't be empty")
+	}
+	if targetHostname == "" {
+		panic("TargetHostname can't be empty")
+	}
+	c.log.Debugw("MoveMasterComponent", "cluster_name", clusterName, "component_name", componentName, "source_hostname", sourceHostname, "target_hostname", targetHostname, "config_updates", configUpdates)
+
+	if sourceHostname == targetHostname {
+		return nil, NewAmbariError(400, "Source and target host are the same host %s", sourceHostname)
+	}
+
+	// Check the component and the hosts
+	sourceHostComponent, err := c.HostComponent(clusterName, sourceHostname, componentName)
+	if err != nil {
+		return nil, err
+	}
+	targetHostComponent, err := c.HostComponent(clusterName, targetHostname, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if sourceHostComponent == nil {
+		if targetHostComponent != nil {
+			c.log.Debugf("Component %s is already moved on host %s", componentName, targetHostname)
+			return targetHostComponent, nil
+		}
+		return nil, NewAmbariError(404, "Component %s not found on host %s in cluster %s", componentName, sourceHostname, clusterName)
+	}
+	if targetHostComponent != nil && targetHostComponent.HostComponentInfo.State == SERVICE_STARTED {
+		return nil, NewAmbariError(409, "Component %s is already started on host %s in cluster %s", componentName, targetHostname, clusterName)
+	}
+	component, err := c.Component(clusterName, sourceHostComponent.HostComponentInfo.ServiceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if component == nil {
+		return nil, NewAmbariError(404, "Component %s not found in service %s on cluster %s", componentName, sourceHostComponent.HostComponentInfo.ServiceName, clusterName)
+	}
+	if component.ComponentInfo.Category != COMPONENT_MASTER {
+		return nil, NewAmbariError(400, "Component %s is not master component, its category is %s", componentName, component.ComponentInfo.Category)
+	}
+	targetHost, err := c.HostOnCluster(clusterName, targetHostname)
+	if err != nil {
+		return nil, err
+	}
+	if targetHost == nil {
+		return nil, NewAmbariError(404, "Host %s not found in cluster %s", targetHostname, clusterName)
+	}
+	configurations, err := c.desiredConfigurations(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	for configurationType := range configUpdates {
+		if _, ok := configurations[configurationType]; !ok {
+			return nil, NewAmbariError(404, "Configuration %s not found on cluster %s", configurationType, clusterName)
+		}
+	}
+
+	// Add and install the component on target host, or resume the previous move
+	if targetHostComponent == nil {
+		c.log.Infof("Add and install component %s on host %s", componentName, targetHostname)
+		_, err = c.CreateHostComponent(&HostComponent{
+			HostComponentInfo: &HostComponentInfo{
+				ClusterName:   clusterName,
+				Hostname:      targetHostname,
+				ComponentName: componentName,
+			},
+		})
+	} else if targetHostComponent.HostComponentInfo.State != SERVICE_INSTALLED {
+		// The INSTALLED state install the component that is in INIT or INSTALL_FAILED state
+		c.log.Infof("Resume move, install component %s on host %s", componentName, targetHostname)
+		_, err = c.StopHostComponent(clusterName, targetHostname, componentName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Stop the component on source host
+	c.log.Infof("Stop component %s on host %s", componentName, sourceHostname)
+	if _, err = c.StopHostComponent(clusterName, sourceHostname, componentName); err != nil {
+		return nil, err
+	}
+
+	// Update the configurations that reference the component on source host
+	if _, ok := masterComponentProperties[componentName]; !ok {
+		c.log.Infof("No known configuration reference component %s, only the given configurations are updated", componentName)
+	}
+	note := fmt.Sprintf("Move %s from %s to %s", componentName, sourceHostname, targetHostname)
+	types := make([]string, 0, len(configurations))
+	for configurationType := range configurations {
+		types = append(types, configurationType)
+	}
+	sort.Strings(types)
+	for _, configurationType := range types {
+		configuration := configurations[configurationType]
+		properties := map[string]string{}
+		for _, key := range masterComponentProperties[componentName][configurationType] {
+			value, ok := configuration.Properties[key]
+			if !ok {
+				continue
+			}
+			if newValue := replaceHostname(value, sourceHostname, targetHostname); newValue != value {
+				properties[key] = newValue
+			}
+		}
+		for key, value := range configUpdates[configurationType] {
+			properties[key] = value
+		}
+		if len(properties) == 0 {
+			continue
+		}
+		c.log.Infof("Update configuration %s with %v", configurationType, properties)
+		if _, err = c.UpdateConfigurationProperties(clusterName, configurationType, properties, nil, note); err != nil {
+			return nil, err
+		}
+	}
+
+	// Start the component on target host, then remove it from source host
+	c.log.Infof("Start component %s on host %s", componentName, targetHostname)
+	hostComponent, err := c.StartHostComponent(clusterName, targetHostname, componentName)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Infof("Delete component %s on host %s", componentName, sourceHostname)
+	if err = c.DeleteHostComponent(clusterName, sourceHostname, componentName); err != nil {
+		return nil, err
+	}
+
+	return hostComponent, nil
+}
+
+// replaceHostname return the value where the hostname is replaced, only when it's the full word
+// So host1 is not replaced in host10 or host1.domain.com
+func replaceHostname(value string, hostname string, newHostname string) string {
+	return hostnamePattern.ReplaceAllStringFunc(value, func(word string) string {
+		if strings.EqualFold(word, hostname) {
+			return newHostname
+		}
+		return word
+	})
+}
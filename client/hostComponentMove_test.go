@@ -0,0 +1,164 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestMoveMasterComponent(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("master01", "test")
+	server.AddHost("master02", "test")
+	server.AddComponent("test", "YARN", "RESOURCEMANAGER", COMPONENT_MASTER)
+	server.AddComponent("test", "YARN", "NODEMANAGER", COMPONENT_SLAVE)
+	server.AddHostComponent("test", "master01", "RESOURCEMANAGER", SERVICE_STARTED)
+	server.AddHostComponent("test", "master01", "NODEMANAGER", SERVICE_STARTED)
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type: "yarn-site",
+		Tag:  "version1",
+		Properties: map[string]string{
+			"yarn.resourcemanager.hostname": "master01",
+			"yarn.resourcemanager.address":  "master01:8050",
+			"yarn.nodemanager.address":      "master010:45454",
+		},
+	})
+	assert.NoError(t, err)
+
+	// Not master
+	_, err = client.MoveMasterComponent("test", "NODEMANAGER", "master01", "master02", nil)
+	assert.Error(t, err)
+
+	hostComponent, err := client.MoveMasterComponent("test", "RESOURCEMANAGER", "master01", "master02", map[string]map[string]string{"yarn-site": {"yarn.resourcemanager.ha.enabled": "false"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, hostComponent)
+	if hostComponent != nil {
+		assert.Equal(t, "master02", hostComponent.HostComponentInfo.Hostname)
+		assert.Equal(t, SERVICE_STARTED, hostComponent.HostComponentInfo.State)
+	}
+	sourceHostComponent, err := client.HostComponent("test", "master01", "RESOURCEMANAGER")
+	assert.NoError(t, err)
+	assert.Nil(t, sourceHostComponent)
+	configuration, err := client.DesiredConfigurationOnCluster("test", "yarn-site")
+	assert.NoError(t, err)
+	assert.Equal(t, "master02", configuration.Properties["yarn.resourcemanager.hostname"])
+	assert.Equal(t, "master02:8050", configuration.Properties["yarn.resourcemanager.address"])
+	assert.Equal(t, "master010:45454", configuration.Properties["yarn.nodemanager.address"])
+	assert.Equal(t, "false", configuration.Properties["yarn.resourcemanager.ha.enabled"])
+
+	// Already moved
+	hostComponent, err = client.MoveMasterComponent("test", "RESOURCEMANAGER", "master01", "master02", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "master02", hostComponent.HostComponentInfo.Hostname)
+}
+
+func TestMoveMasterComponentResume(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("master01", "test")
+	server.AddHost("master02", "test")
+	server.AddHost("master03", "test")
+	server.AddComponent("test", "OOZIE", "OOZIE_SERVER", COMPONENT_MASTER)
+	server.AddHostComponent("test", "master01", "OOZIE_SERVER", SERVICE_STARTED)
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       "oozie-site",
+		Tag:        "version1",
+		Properties: map[string]string{"oozie.base.url": "http://master01:11000/oozie"},
+	})
+	assert.NoError(t, err)
+
+	// The previous move failed after the component was added on target host
+	server.AddHostComponent("test", "master02", "OOZIE_SERVER", SERVICE_INIT)
+	hostComponent, err := client.MoveMasterComponent("test", "OOZIE_SERVER", "master01", "master02", nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, hostComponent)
+	if hostComponent != nil {
+		assert.Equal(t, SERVICE_STARTED, hostComponent.HostComponentInfo.State)
+	}
+	sourceHostComponent, err := client.HostComponent("test", "master01", "OOZIE_SERVER")
+	assert.NoError(t, err)
+	assert.Nil(t, sourceHostComponent)
+	configuration, err := client.DesiredConfigurationOnCluster("test", "oozie-site")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://master02:11000/oozie", configuration.Properties["oozie.base.url"])
+
+	// The component is already started on target host, like with high availability
+	server.AddHostComponent("test", "master03", "OOZIE_SERVER", SERVICE_STARTED)
+	_, err = client.MoveMasterComponent("test", "OOZIE_SERVER", "master02", "master03", nil)
+	assert.True(t, IsConflict(err))
+	sourceHostComponent, err = client.HostComponent("test", "master02", "OOZIE_SERVER")
+	assert.NoError(t, err)
+	assert.NotNil(t, sourceHostComponent)
+}
+
+func TestMoveMasterComponentWithOtherMaster(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("master01", "test")
+	server.AddHost("master02", "test")
+	server.AddComponent("test", "HDFS", "NAMENODE", COMPONENT_MASTER)
+	server.AddComponent("test", "YARN", "RESOURCEMANAGER", COMPONENT_MASTER)
+	server.AddComponent("test", "ZOOKEEPER", "ZOOKEEPER_SERVER", COMPONENT_MASTER)
+	server.AddHostComponent("test", "master01", "NAMENODE", SERVICE_STARTED)
+	server.AddHostComponent("test", "master01", "RESOURCEMANAGER", SERVICE_STARTED)
+	server.AddHostComponent("test", "master01", "ZOOKEEPER_SERVER", SERVICE_STARTED)
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       "core-site",
+		Tag:        "version1",
+		Properties: map[string]string{"fs.defaultFS": "hdfs://master01:8020"},
+	})
+	assert.NoError(t, err)
+	_, err = client.CreateConfigurationOnCluster("test", &Configuration{
+		Type: "yarn-site",
+		Tag:  "version1",
+		Properties: map[string]string{
+			"yarn.resourcemanager.hostname":       "master01",
+			"yarn.resourcemanager.webapp.address": "master01:8088",
+			"hadoop.registry.zk.quorum":           "master01:2181",
+			"yarn.nodemanager.address":            "master01:45454",
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = client.MoveMasterComponent("test", "RESOURCEMANAGER", "master01", "master02", nil)
+	assert.NoError(t, err)
+
+	// Only the properties of RESOURCEMANAGER are updated
+	configuration, err := client.DesiredConfigurationOnCluster("test", "yarn-site")
+	assert.NoError(t, err)
+	assert.Equal(t, "master02", configuration.Properties["yarn.resourcemanager.hostname"])
+	assert.Equal(t, "master02:8088", configuration.Properties["yarn.resourcemanager.webapp.address"])
+	assert.Equal(t, "master01:2181", configuration.Properties["hadoop.registry.zk.quorum"])
+	assert.Equal(t, "master01:45454", configuration.Properties["yarn.nodemanager.address"])
+	configuration, err = client.DesiredConfigurationOnCluster("test", "core-site")
+	assert.NoError(t, err)
+	assert.Equal(t, "version1", configuration.Tag)
+	assert.Equal(t, "hdfs://master01:8020", configuration.Properties["fs.defaultFS"])
+	for _, componentName := range []string{"NAMENODE", "ZOOKEEPER_SERVER"} {
+		hostComponent, err := client.HostComponent("test", "master01", componentName)
+		assert.NoError(t, err)
+		assert.NotNil(t, hostComponent)
+		if hostComponent != nil {
+			assert.Equal(t, SERVICE_STARTED, hostComponent.HostComponentInfo.State)
+		}
+	}
+
+	// Component without known configurations, only the given configurations are updated
+	hostComponent, err := client.MoveMasterComponent("test", "ZOOKEEPER_SERVER", "master01", "master02", map[string]map[string]string{"yarn-site": {"hadoop.registry.zk.quorum": "master02:2181"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, hostComponent)
+	configuration, err = client.DesiredConfigurationOnCluster("test", "yarn-site")
+	assert.NoError(t, err)
+	assert.Equal(t, "master02:2181", configuration.Properties["hadoop.registry.zk.quorum"])
+	assert.Equal(t, "master01:45454", configuration.Properties["yarn.nodemanager.address"])
+}
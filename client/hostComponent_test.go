@@ -2,6 +2,9 @@ package client
 
 import (
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
 )
 
 func (s *ClientTestSuite) TestHostComponent() {
@@ -73,3 +76,38 @@ func (s *ClientTestSuite) TestHostComponent() {
 	assert.Nil(s.T(), hostComponent)
 
 }
+
+func TestRestartRequired(t *testing.T) {
+
+	assert.False(t, (&HostComponentInfo{UpgradeState: UPGRADE_STATE_NONE, Version: "2.6.5.0-292", DesiredRepositoryVersion: "2.6.5.0-292"}).RestartRequired())
+	assert.False(t, (&HostComponentInfo{Version: "UNKNOWN", DesiredRepositoryVersion: "2.6.5.0-292"}).RestartRequired())
+	assert.True(t, (&HostComponentInfo{StaleConfigs: true}).RestartRequired())
+	assert.True(t, (&HostComponentInfo{UpgradeState: UPGRADE_STATE_VERSION_MISMATCH}).StaleCode())
+	assert.True(t, (&HostComponentInfo{Version: "2.6.4.0-91", DesiredRepositoryVersion: "2.6.5.0-292"}).StaleCode())
+}
+
+func TestListComponentsNeedingRestart(t *testing.T) {
+
+	path := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"items": [
+			{"HostRoles": {"cluster_name": "test", "component_name": "DATANODE", "host_name": "worker01", "state": "STARTED", "desired_admin_state": "INSERVICE", "stale_configs": true, "upgrade_state": "NONE"}},
+			{"HostRoles": {"cluster_name": "test", "component_name": "DATANODE", "host_name": "worker02", "state": "STARTED", "desired_admin_state": "DECOMMISSIONED", "stale_configs": false, "upgrade_state": "NONE"}},
+			{"HostRoles": {"cluster_name": "test", "component_name": "NODEMANAGER", "host_name": "worker02", "state": "STARTED", "stale_configs": false, "upgrade_state": "VERSION_MISMATCH"}},
+			{"HostRoles": {"cluster_name": "test", "component_name": "HDFS_CLIENT", "host_name": "worker02", "state": "INSTALLED", "stale_configs": true, "upgrade_state": "NONE"}}
+		]}`))
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	hostComponents, err := client.ListComponentsNeedingRestart("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "/clusters/test/host_components", path)
+	assert.Equal(t, 2, len(hostComponents))
+	assert.Equal(t, "worker01/DATANODE", hostComponents[0].HostComponentInfo.Hostname+"/"+hostComponents[0].HostComponentInfo.ComponentName)
+	assert.Equal(t, ADMIN_STATE_INSERVICE, hostComponents[0].HostComponentInfo.DesiredAdminState)
+	assert.True(t, hostComponents[0].HostComponentInfo.StaleConfigs)
+	assert.Equal(t, "worker02/NODEMANAGER", hostComponents[1].HostComponentInfo.Hostname+"/"+hostComponents[1].HostComponentInfo.ComponentName)
+	assert.Equal(t, UPGRADE_STATE_VERSION_MISMATCH, hostComponents[1].HostComponentInfo.UpgradeState)
+}
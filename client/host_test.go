@@ -2,6 +2,8 @@ package client
 
 import (
 	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
 	"time"
 )
 
@@ -81,3 +83,44 @@ func (s *ClientTestSuite) TestHost() {
 		assert.Equal(s.T(), "ambari-agent3", host.HostInfo.Hostname)
 	}
 }
+
+func TestListHosts(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("worker01", "test")
+	server.AddHost("worker02", "test")
+	server.AddHost("edge01")
+	server.AddComponent("test", "HDFS", "DATANODE", COMPONENT_SLAVE)
+	server.AddComponent("test", "HDFS", "HDFS_CLIENT", COMPONENT_CLIENT)
+	server.AddHostComponent("test", "worker01", "DATANODE", SERVICE_STARTED)
+	server.AddHostComponent("test", "worker01", "HDFS_CLIENT", SERVICE_INSTALLED)
+	server.AddHostComponent("test", "worker02", "DATANODE", SERVICE_INSTALLED)
+	server.SetHostStatus("worker02", HOST_STATUS_UNKNOWN)
+	client := New(server.BaseURL(), "admin", "admin")
+
+	hosts, err := client.ListHosts("test", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(hosts))
+	if len(hosts) == 2 {
+		assert.Equal(t, "worker01", hosts[0].HostInfo.Hostname)
+		assert.Equal(t, HOST_STATUS_HEALTHY, hosts[0].HostInfo.HostStatus)
+		assert.Equal(t, "OFF", hosts[0].HostInfo.MaintenanceState)
+		assert.WithinDuration(t, time.Now(), hosts[0].HostInfo.LastHeartbeat(), time.Minute)
+		assert.Equal(t, 2, len(hosts[0].HostComponents))
+		if len(hosts[0].HostComponents) == 2 {
+			assert.Equal(t, "DATANODE", hosts[0].HostComponents[0].HostComponentInfo.ComponentName)
+			assert.Equal(t, SERVICE_STARTED, hosts[0].HostComponents[0].HostComponentInfo.State)
+		}
+	}
+
+	// With predicate
+	hosts, err = client.ListHosts("test", Eq("Hosts/host_status", HOST_STATUS_UNKNOWN))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(hosts))
+	if len(hosts) == 1 {
+		assert.Equal(t, "worker02", hosts[0].HostInfo.Hostname)
+		assert.Equal(t, SERVICE_INSTALLED, hosts[0].HostComponents[0].HostComponentInfo.State)
+	}
+}
@@ -0,0 +1,338 @@
+// This file permit to use the client through interfaces, one per Ambari resource
+// Applications can depend on the interfaces they need and use the mocks from go-ambari-rest/client/mocks in their tests
+
+package client
+
+//go:generate mockery --dir . --name (Service|API)$ --output ./mocks --outpkg mocks --case underscore --disable-version-string
+
+import (
+	"context"
+	"time"
+)
+
+// ClusterService permit to manage clusters
+type ClusterService interface {
+	CreateCluster(cluster *Cluster) (*Cluster, error)
+	CreateClusterFromTemplate(name string, jsonClusterTemplate string) (*Cluster, error)
+	ProvisionClusterFromTemplate(name string, jsonClusterTemplate string) (*RequestTask, error)
+	Cluster(clusterName string, opts ...RequestOption) (*Cluster, error)
+	RenameCluster(oldClusterName string, cluster *Cluster) (*Cluster, error)
+	ManageKerberosOnCluster(cluster *Cluster) (*Cluster, error)
+	RegenerateKeytabs(clusterName string, scope *KeytabScope, onlyMissing bool) (int, error)
+	DeleteCluster(clusterName string) error
+	SendRequestCluster(request *Request) (*RequestTask, error)
+	ClusterByID(id string, opts ...RequestOption) (*Cluster, error)
+}
+
+// HostService permit to manage hosts
+type HostService interface {
+	CreateHost(host *Host) (*Host, error)
+	HostOnCluster(clusterName string, hostname string, opts ...RequestOption) (*Host, error)
+	HostsOnCluster(clusterName string, opts ...RequestOption) ([]Host, error)
+	ListHosts(clusterName string, predicate *Predicate, opts ...RequestOption) ([]Host, error)
+	Host(hostname string, opts ...RequestOption) (*Host, error)
+	Hosts(opts ...RequestOption) ([]Host, error)
+	UpdateHost(host *Host) (*Host, error)
+	DeleteHost(clusterName string, hostname string) error
+	RegisterHostOnCluster(clusterName string, hostname string, blueprintName string, role string) (*Host, error)
+	StopAllComponentsInHost(clusterName string, hostname string, enableMaintenanceMode bool, force bool) error
+	StartAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error
+	DeleteAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error
+	HostByID(id string, opts ...RequestOption) (*Host, error)
+}
+
+// HostComponentService permit to manage components on hosts
+type HostComponentService interface {
+	CreateHostComponent(hostComponent *HostComponent) (*HostComponent, error)
+	HostComponent(clusterName string, hostname string, componentName string, opts ...RequestOption) (*HostComponent, error)
+	UpdateHostComponent(hostComponent *HostComponent) (*HostComponent, error)
+	SendRequestHostComponent(request *Request) (*RequestTask, error)
+	StopHostComponent(clusterName string, hostname string, componentName string) (*HostComponent, error)
+	StartHostComponent(clusterName string, hostname string, componentName string) (*HostComponent, error)
+	DeleteHostComponent(clusterName string, hostname string, componentName string) error
+	MoveMasterComponent(clusterName string, componentName string, sourceHostname string, targetHostname string, configUpdates map[string]map[string]string) (*HostComponent, error)
+	WaitForHostComponentState(ctx context.Context, clusterName string, hostname string, componentName string, state string) (*HostComponent, error)
+	HostComponentByID(id string, opts ...RequestOption) (*HostComponent, error)
+	ListComponentsNeedingRestart(clusterName string, opts ...RequestOption) ([]HostComponent, error)
+}
+
+// ServiceService permit to manage services
+type ServiceService interface {
+	CreateService(service *Service) (*Service, error)
+	Service(clusterName string, serviceName string, opts ...RequestOption) (*Service, error)
+	UpdateService(service *Service) (*Service, error)
+	DeleteService(clusterName string, serviceName string) error
+	SendRequestService(request *Request) (*RequestTask, error)
+	InstallService(service *Service) (*Service, error)
+	StartService(clusterName string, serviceName string, disableMaintenanceMode bool) (*Service, error)
+	StopService(clusterName string, serviceName string, enableMaintenanceMode bool, force bool) (*Service, error)
+	StopAllServices(cluster *Cluster, enableMaintenanceMode bool, force bool) error
+	StartAllServices(cluster *Cluster, disableMaintenanceMode bool) error
+	GetClusterHealthSummary(clusterName string) (*ClusterHealthSummary, error)
+	WaitForServiceState(ctx context.Context, clusterName string, serviceName string, state string) (*Service, error)
+	ServiceByID(id string, opts ...RequestOption) (*Service, error)
+}
+
+// ComponentService permit to manage service components and their auto start
+type ComponentService interface {
+	CreateComponent(component *Component) (*Component, error)
+	Component(clusterName string, serviceName string, componentName string, opts ...RequestOption) (*Component, error)
+	DeleteComponent(clusterName string, serviceName string, componentName string) error
+	ClusterRecoveryEnabled(clusterName string) (bool, error)
+	SetClusterRecoveryEnabled(clusterName string, enabled bool) error
+	ComponentsRecovery(clusterName string, opts ...RequestOption) ([]Component, error)
+	SetComponentsRecoveryEnabled(clusterName string, componentNames []string, enabled bool) error
+	SetComponentRecoveryEnabled(clusterName string, serviceName string, componentName string, enabled bool) (*Component, error)
+	ExecuteCustomCommand(clusterName string, serviceName string, componentName string, hosts []string, command string, parameters map[string]string) (*RequestTask, error)
+	RefreshQueues(clusterName string) (*RequestTask, error)
+	RefreshConfigs(clusterName string, serviceName string, componentName string, hosts []string) (*RequestTask, error)
+	ComponentByID(id string, opts ...RequestOption) (*Component, error)
+}
+
+// ConfigurationService permit to manage cluster configurations
+type ConfigurationService interface {
+	CreateConfigurationOnCluster(clusterName string, configuration *Configuration) (*Cluster, error)
+	ConfigurationOnCluster(clusterName string, configurationType string, tag string, opts ...RequestOption) (*Configuration, error)
+	DesiredConfigurationOnCluster(clusterName string, configurationType string) (*Configuration, error)
+	UpdateConfigurationProperties(clusterName string, configurationType string, properties map[string]string, removedProperties []string, note string) (*Configuration, error)
+	ExportClusterConfigs(clusterName string) (*ClusterConfigs, error)
+	ImportClusterConfigs(clusterName string, clusterConfigs *ClusterConfigs, filter *ConfigImportFilter) (*ChangeReport, error)
+	DiffClusterConfigs(fromClusterName string, toClusterName string) ([]ConfigDiff, error)
+	DiffBlueprintConfigs(blueprintName string, clusterName string) ([]ConfigDiff, error)
+	LoggerLevels(clusterName string, configurationType string) ([]LoggerLevel, error)
+	LoggerLevel(clusterName string, configurationType string, logger string) (*LoggerLevel, error)
+	SetLoggerLevel(clusterName string, configurationType string, logger string, level string) (*Configuration, error)
+	ConfigurationByID(id string, opts ...RequestOption) (*Configuration, error)
+}
+
+// ConfigGroupService permit to manage config groups
+type ConfigGroupService interface {
+	ConfigGroup(clusterName string, id int64, opts ...RequestOption) (*ConfigGroup, error)
+	ConfigGroups(clusterName string, opts ...RequestOption) ([]ConfigGroup, error)
+	SearchConfigGroup(clusterName string, groupName string, opts ...RequestOption) (*ConfigGroup, error)
+	ConfigGroupByID(id string, opts ...RequestOption) (*ConfigGroup, error)
+	CreateConfigGroup(configGroup *ConfigGroup) (*ConfigGroup, error)
+	UpdateConfigGroup(configGroup *ConfigGroup) (*ConfigGroup, error)
+	DeleteConfigGroup(clusterName string, id int64) error
+	ApplyConfigGroup(configGroup *ConfigGroup) (*ChangeReport, error)
+}
+
+// BlueprintService permit to manage blueprints
+type BlueprintService interface {
+	CreateBlueprint(name string, jsonBlueprint string) (*Blueprint, error)
+	Blueprint(name string, opts ...RequestOption) (*Blueprint, error)
+	BlueprintByID(id string, opts ...RequestOption) (*Blueprint, error)
+	DeleteBlueprint(name string) error
+}
+
+// CredentialService permit to manage credentials stored by Ambari
+type CredentialService interface {
+	Credential(clusterName string, alias string, opts ...RequestOption) (*Credential, error)
+	Credentials(clusterName string, opts ...RequestOption) ([]Credential, error)
+	CreateCredential(credential *Credential) (*Credential, error)
+	UpdateCredential(credential *Credential) (*Credential, error)
+	DeleteCredential(clusterName string, alias string) error
+}
+
+// PrivilegeService permit to manage privileges on cluster
+type PrivilegeService interface {
+	Privilege(clusterName string, id int64, opts ...RequestOption) (*Privilege, error)
+	Privileges(clusterName string, opts ...RequestOption) ([]Privilege, error)
+	SearchPrivilege(clusterName string, permissionName string, principalName string, principalType string, opts ...RequestOption) (*Privilege, error)
+	CreatePrivilege(clusterName string, privilege *Privilege) (*Privilege, error)
+	UpdatePrivilege(clusterName string, privilege *Privilege) (*Privilege, error)
+	DeletePrivilege(clusterName string, id int64) error
+	ApplyPrivilege(clusterName string, privilege *Privilege) (*ChangeReport, error)
+	ApplyPrivileges(clusterName string, privileges []Privilege) (*ChangeReport, error)
+	AmbariPrivileges(opts ...RequestOption) ([]Privilege, error)
+	CreateAmbariPrivilege(privilege *Privilege) (*Privilege, error)
+	DeleteAmbariPrivilege(id int64) error
+	ViewPrivileges(viewName string, version string, instanceName string, opts ...RequestOption) ([]Privilege, error)
+	CreateViewPrivilege(viewName string, version string, instanceName string, privilege *Privilege) (*Privilege, error)
+	DeleteViewPrivilege(viewName string, version string, instanceName string, id int64) error
+	PrivilegeByID(id string, opts ...RequestOption) (*Privilege, error)
+	AmbariPrivilegeByID(id string, opts ...RequestOption) (*Privilege, error)
+	ViewPrivilegeByID(id string, opts ...RequestOption) (*Privilege, error)
+	ExportSecurityModel(clusterName string) (*SecurityModel, error)
+	ImportSecurityModel(clusterName string, securityModel *SecurityModel, newUserPassword string, deletePrivileges bool) (*ChangeReport, error)
+}
+
+// UserService permit to manage users and groups of Ambari
+type UserService interface {
+	User(userName string, opts ...RequestOption) (*User, error)
+	UserByID(id string, opts ...RequestOption) (*User, error)
+	Users(opts ...RequestOption) ([]User, error)
+	CreateUser(user *User) (*User, error)
+	ActivateUser(userName string, active bool) error
+	ApplyUser(user *User) (*ChangeReport, error)
+	DeleteUser(userName string) error
+	UserPrivileges(userName string, opts ...RequestOption) ([]Privilege, error)
+	Group(groupName string, opts ...RequestOption) (*Group, error)
+	GroupByID(id string, opts ...RequestOption) (*Group, error)
+	Groups(opts ...RequestOption) ([]Group, error)
+	CreateGroup(groupName string) (*Group, error)
+	DeleteGroup(groupName string) error
+	AddGroupMember(groupName string, userName string) error
+	RemoveGroupMember(groupName string, userName string) error
+	GroupPrivileges(groupName string, opts ...RequestOption) ([]Privilege, error)
+}
+
+// RepositoryService permit to manage stack repositories
+type RepositoryService interface {
+	CreateRepository(repository *Repository) (*Repository, error)
+	Repository(stackName string, stackVersion string, repositoryId int, opts ...RequestOption) (*Repository, error)
+	SearchRepository(stackName string, stackVersion string, repositoryName string, repositoryVersion string, opts ...RequestOption) (*Repository, error)
+	RepositoryByID(id string, opts ...RequestOption) (*Repository, error)
+	UpdateRepository(repository *Repository) (*Repository, error)
+	DeleteRepository(stackName string, stackVersion string, repositoryId int) error
+}
+
+// RequestService permit to follow and abort requests and their tasks
+type RequestService interface {
+	Request(clusterName string, Id int, opts ...RequestOption) (*RequestTask, error)
+	Requests(clusterName string, opts ...RequestOption) ([]RequestTask, error)
+	AbortRequest(clusterName string, Id int) (*RequestTask, error)
+	Task(clusterName string, requestId int, taskId int, opts ...RequestOption) (*Task, error)
+	Tasks(clusterName string, requestId int, opts ...RequestOption) ([]Task, error)
+	StreamTasks(clusterName string, requestId int, callback func(task *Task) error, opts ...RequestOption) error
+}
+
+// RequestScheduleService permit to manage request schedules, like rolling restart
+type RequestScheduleService interface {
+	CreateRequestSchedule(requestSchedule *RequestSchedule) (*RequestSchedule, error)
+	RequestSchedule(clusterName string, id int64, opts ...RequestOption) (*RequestSchedule, error)
+	RequestSchedules(clusterName string, opts ...RequestOption) ([]RequestSchedule, error)
+	DeleteRequestSchedule(clusterName string, id int64) error
+}
+
+// SettingService permit to manage Ambari settings
+type SettingService interface {
+	Setting(name string, opts ...RequestOption) (*Setting, error)
+	SettingByID(id string, opts ...RequestOption) (*Setting, error)
+	Settings(opts ...RequestOption) ([]Setting, error)
+	CreateSetting(setting *Setting) (*Setting, error)
+	UpdateSetting(setting *Setting) (*Setting, error)
+	DeleteSetting(name string) error
+	ApplySetting(setting *Setting) (*ChangeReport, error)
+}
+
+// StackDefinitionService permit to read stack definitions
+type StackDefinitionService interface {
+	Stacks(opts ...RequestOption) ([]Stack, error)
+	StackVersions(stackName string, opts ...RequestOption) ([]StackVersion, error)
+	StackServices(stackName string, stackVersion string, opts ...RequestOption) ([]StackService, error)
+	StackComponents(stackName string, stackVersion string, serviceName string, opts ...RequestOption) ([]StackComponent, error)
+	StackConfigurations(stackName string, stackVersion string, serviceName string, opts ...RequestOption) ([]StackConfiguration, error)
+	StackDefaultConfigurations(stackName string, stackVersion string, serviceName string) ([]Configuration, error)
+}
+
+// ViewService permit to manage view instances
+type ViewService interface {
+	ViewInstance(viewName string, version string, instanceName string, opts ...RequestOption) (*ViewInstance, error)
+	ViewInstanceByID(id string, opts ...RequestOption) (*ViewInstance, error)
+	ViewInstances(viewName string, version string, opts ...RequestOption) ([]ViewInstance, error)
+	CreateViewInstance(viewInstance *ViewInstance) (*ViewInstance, error)
+	UpdateViewInstance(viewInstance *ViewInstance) (*ViewInstance, error)
+	DeleteViewInstance(viewName string, version string, instanceName string) error
+}
+
+// WidgetService permit to manage widgets and widget layouts
+type WidgetService interface {
+	Widget(clusterName string, id int64, opts ...RequestOption) (*Widget, error)
+	Widgets(clusterName string, opts ...RequestOption) ([]Widget, error)
+	SearchWidget(clusterName string, widgetName string, opts ...RequestOption) (*Widget, error)
+	WidgetByID(id string, opts ...RequestOption) (*Widget, error)
+	CreateWidget(widget *Widget) (*Widget, error)
+	UpdateWidget(widget *Widget) (*Widget, error)
+	DeleteWidget(clusterName string, id int64) error
+	WidgetLayout(clusterName string, id int64, opts ...RequestOption) (*WidgetLayout, error)
+	WidgetLayouts(clusterName string, opts ...RequestOption) ([]WidgetLayout, error)
+	SearchWidgetLayout(clusterName string, layoutName string, opts ...RequestOption) (*WidgetLayout, error)
+	CreateWidgetLayout(widgetLayout *WidgetLayout) (*WidgetLayout, error)
+	UpdateWidgetLayout(widgetLayout *WidgetLayout) (*WidgetLayout, error)
+	DeleteWidgetLayout(clusterName string, id int64) error
+}
+
+// AlertService permit to read alerts and to manage alert targets
+type AlertService interface {
+	Alerts(clusterName string, opts ...RequestOption) ([]Alert, error)
+	AlertsInCluster(clusterName string, opts ...RequestOption) ([]Alert, error)
+	AlertsInService(clusterName string, serviceName string, opts ...RequestOption) ([]Alert, error)
+	AlertsInHost(clusterName string, hostname string, opts ...RequestOption) ([]Alert, error)
+	StreamAlertHistory(clusterName string, callback func(alertHistory *AlertHistory) error, opts ...RequestOption) error
+	AlertTarget(id int64, opts ...RequestOption) (*AlertTarget, error)
+	AlertTargets(opts ...RequestOption) ([]AlertTarget, error)
+	SearchAlertTarget(name string, opts ...RequestOption) (*AlertTarget, error)
+	AlertTargetByID(id string, opts ...RequestOption) (*AlertTarget, error)
+	CreateAlertTarget(alertTarget *AlertTarget) (*AlertTarget, error)
+	UpdateAlertTarget(alertTarget *AlertTarget) (*AlertTarget, error)
+	DeleteAlertTarget(id int64) error
+	ApplyAlertTarget(alertTarget *AlertTarget) (*ChangeReport, error)
+}
+
+// MetricService permit to read metrics
+type MetricService interface {
+	HostMetrics(clusterName string, hostname string, query *MetricQuery) ([]MetricSeries, error)
+	ServiceMetrics(clusterName string, serviceName string, query *MetricQuery) ([]MetricSeries, error)
+	ComponentMetrics(clusterName string, serviceName string, componentName string, query *MetricQuery) ([]MetricSeries, error)
+	HostComponentMetrics(clusterName string, hostname string, componentName string, query *MetricQuery) ([]MetricSeries, error)
+	StreamHostsMetrics(clusterName string, query *MetricQuery, callback func(hostname string, series []MetricSeries) error) error
+}
+
+// QuickLinksProfileService permit to manage the quick links profile
+type QuickLinksProfileService interface {
+	QuickLinksProfile() (*QuickLinksProfile, error)
+	SaveQuickLinksProfile(quickLinksProfile *QuickLinksProfile) (*QuickLinksProfile, error)
+	DeleteQuickLinksProfile() error
+}
+
+// UpgradeService permit to read the upgrades history and to finalize the upgrades
+type UpgradeService interface {
+	Upgrades(clusterName string, opts ...RequestOption) ([]Upgrade, error)
+	Upgrade(clusterName string, id int, opts ...RequestOption) (*Upgrade, error)
+	FinalizeUpgrade(clusterName string, id int) (*Upgrade, error)
+	SkipAndFinalizeUpgrade(clusterName string, id int) (*Upgrade, error)
+}
+
+// ServerService permit to read Ambari server informations
+type ServerService interface {
+	ServerInfo(opts ...RequestOption) (*ServerInfo, error)
+	Ping(timeout time.Duration) error
+	ServerVersion() (string, error)
+	Supports(feature Feature) bool
+}
+
+// EventService permit to receive Ambari events
+type EventService interface {
+	Subscribe(ctx context.Context, topics ...string) (*EventSubscription, error)
+}
+
+// API is all the Ambari resources that the client can manage
+type API interface {
+	ClusterService
+	HostService
+	HostComponentService
+	ServiceService
+	ComponentService
+	ConfigurationService
+	ConfigGroupService
+	BlueprintService
+	CredentialService
+	PrivilegeService
+	UserService
+	RepositoryService
+	RequestService
+	RequestScheduleService
+	SettingService
+	StackDefinitionService
+	ViewService
+	WidgetService
+	AlertService
+	MetricService
+	QuickLinksProfileService
+	UpgradeService
+	ServerService
+	EventService
+}
+
+var _ API = &AmbariClient{}
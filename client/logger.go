@@ -0,0 +1,358 @@
+// This file permit to choose the logger used by the client, its level and to hide the credentials in logs
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Logger is the logger used by the client
+// logrus.Logger, logrus.Entry and zap.SugaredLogger can be used as is
+// The fields, like the cluster name or the path of the call, are added at the end of the message, use FieldLogger to get them as key/values
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+// FieldLogger is the logger that write the fields as key/values instead of in the message
+// NewLogrusLogger and NewKeyValueLogger return it
+type FieldLogger interface {
+	Logger
+	WithFields(fields LogFields) FieldLogger
+}
+
+// KeyValueLogger is the slog style logger, that take the message and the list of key/values
+// slog.Logger can be used as is
+type KeyValueLogger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// LogFields are the key/values added on logs, like the cluster name, the method or the path of the call
+type LogFields map[string]interface{}
+
+// LogLevel is the minimal level of the logs written by the client
+type LogLevel int
+
+const (
+	LOG_LEVEL_DEBUG LogLevel = iota
+	LOG_LEVEL_INFO
+	LOG_LEVEL_WARN
+	LOG_LEVEL_ERROR
+	LOG_LEVEL_NONE
+)
+
+var logrusLevels = map[LogLevel]logrus.Level{
+	LOG_LEVEL_DEBUG: logrus.DebugLevel,
+	LOG_LEVEL_INFO:  logrus.InfoLevel,
+	LOG_LEVEL_WARN:  logrus.WarnLevel,
+	LOG_LEVEL_ERROR: logrus.ErrorLevel,
+}
+
+// The value of JSON attribute like password, principal_password, secret or token, and the credential key
+var redactRegexp = regexp.MustCompile(`("(?:[^"]*(?i:password|passwd|secret|token)[^"]*|key)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// The field keys that have credential as value
+var redactKeyRegexp = regexp.MustCompile(`(?i)password|passwd|secret|token`)
+
+// logrusLogger write the fields as logrus fields
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+// NewLogrusLogger return the FieldLogger that write the fields as logrus fields
+// SetLogger use it when it get logrus.Logger or logrus.Entry
+func NewLogrusLogger(logger logrus.FieldLogger) FieldLogger {
+
+	if logger == nil {
+		panic("Logger can't be nil")
+	}
+
+	return &logrusLogger{logger: logger}
+}
+
+func (l *logrusLogger) WithFields(fields LogFields) FieldLogger {
+	return &logrusLogger{logger: l.logger.WithFields(logrus.Fields(fields))}
+}
+
+func (l *logrusLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debugf(format, args...)
+}
+
+func (l *logrusLogger) Infof(format string, args ...interface{}) {
+	l.logger.Infof(format, args...)
+}
+
+func (l *logrusLogger) Warnf(format string, args ...interface{}) {
+	l.logger.Warnf(format, args...)
+}
+
+func (l *logrusLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Errorf(format, args...)
+}
+
+// IsLevelEnabled return false if the logrus logger not write the logs at this level
+func (l *logrusLogger) IsLevelEnabled(level logrus.Level) bool {
+	switch logger := l.logger.(type) {
+	case *logrus.Logger:
+		return logger.IsLevelEnabled(level)
+	case *logrus.Entry:
+		return logger.Logger.IsLevelEnabled(level)
+	}
+
+	return true
+}
+
+// keyValueLogger write the fields as key/values of slog style logger
+type keyValueLogger struct {
+	logger        KeyValueLogger
+	keysAndValues []interface{}
+}
+
+// NewKeyValueLogger return the FieldLogger that write the fields as key/values of the slog style logger, like slog.Logger
+func NewKeyValueLogger(logger KeyValueLogger) FieldLogger {
+
+	if logger == nil {
+		panic("Logger can't be nil")
+	}
+
+	return &keyValueLogger{logger: logger}
+}
+
+func (l *keyValueLogger) WithFields(fields LogFields) FieldLogger {
+	keysAndValues := append([]interface{}(nil), l.keysAndValues...)
+	for _, key := range fields.keys() {
+		keysAndValues = append(keysAndValues, key, fields[key])
+	}
+
+	return &keyValueLogger{logger: l.logger, keysAndValues: keysAndValues}
+}
+
+func (l *keyValueLogger) Debugf(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...), l.keysAndValues...)
+}
+
+func (l *keyValueLogger) Infof(format string, args ...interface{}) {
+	l.logger.Info(fmt.Sprintf(format, args...), l.keysAndValues...)
+}
+
+func (l *keyValueLogger) Warnf(format string, args ...interface{}) {
+	l.logger.Warn(fmt.Sprintf(format, args...), l.keysAndValues...)
+}
+
+func (l *keyValueLogger) Errorf(format string, args ...interface{}) {
+	l.logger.Error(fmt.Sprintf(format, args...), l.keysAndValues...)
+}
+
+// keys return the keys of fields sorted, to always write them on the same order
+func (f LogFields) keys() []string {
+	keys := make([]string, 0, len(f))
+	for key := range f {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// String return the fields as key=value list
+func (f LogFields) String() string {
+	fields := make([]string, 0, len(f))
+	for _, key := range f.keys() {
+		fields = append(fields, fmt.Sprintf("%s=%v", key, f[key]))
+	}
+
+	return strings.Join(fields, " ")
+}
+
+// clientLogger write the logs of the client on its logger
+type clientLogger struct {
+	logger Logger
+	level  LogLevel
+}
+
+// newClientLogger return logger that use the logrus standard logger, to keep the behavior of the client before Logger
+func newClientLogger() *clientLogger {
+	return &clientLogger{
+		logger: NewLogrusLogger(logrus.StandardLogger()),
+		level:  LOG_LEVEL_DEBUG,
+	}
+}
+
+// SetLogger permit to use your own logger instead of the logrus standard logger
+// Use NewKeyValueLogger to write the fields as key/values of slog style logger. logrus.Logger and logrus.Entry write them as logrus fields.
+func (c *AmbariClient) SetLogger(logger Logger) {
+
+	if logger == nil {
+		panic("Logger can't be nil")
+	}
+
+	switch l := logger.(type) {
+	case *logrus.Logger:
+		logger = NewLogrusLogger(l)
+	case *logrus.Entry:
+		logger = NewLogrusLogger(l)
+	}
+	c.log.logger = logger
+}
+
+// SetLogLevel permit to set the minimal level of the logs written by this client, whatever the level of the logger
+// Use LOG_LEVEL_NONE to not write logs
+func (c *AmbariClient) SetLogLevel(level LogLevel) {
+	c.log.level = level
+}
+
+// redact permit to hide the credentials in the message, like the passwords in request body
+func redact(message string) string {
+	return redactRegexp.ReplaceAllString(message, `$1"****"`)
+}
+
+// redactFields permit to hide the credentials in the field values
+// The values that are not number or boolean are written as string, the resources and the maps have their JSON form
+func redactFields(keysAndValues []interface{}) LogFields {
+
+	fields := make(LogFields, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		if i+1 == len(keysAndValues) {
+			fields[key] = nil
+			break
+		}
+		if redactKeyRegexp.MatchString(key) {
+			fields[key] = "****"
+			continue
+		}
+		switch value := keysAndValues[i+1].(type) {
+		case nil, bool, int, int64, float64:
+			fields[key] = value
+		case string:
+			fields[key] = redact(value)
+		case fmt.Stringer, error:
+			fields[key] = redact(fmt.Sprint(value))
+		default:
+			if jsonValue, err := json.Marshal(value); err == nil {
+				fields[key] = redact(string(jsonValue))
+			} else {
+				fields[key] = redact(fmt.Sprint(value))
+			}
+		}
+	}
+
+	return fields
+}
+
+// enabled return true if the logs at this level are written
+// It avoid to compute big messages, like the responses, when the logger not write them
+func (l *clientLogger) enabled(level LogLevel) bool {
+
+	if level < l.level || l.level == LOG_LEVEL_NONE {
+		return false
+	}
+	if logger, ok := l.logger.(interface{ IsLevelEnabled(logrus.Level) bool }); ok {
+		return logger.IsLevelEnabled(logrusLevels[level])
+	}
+
+	return true
+}
+
+// withFields return the logger and the message to write the key/values
+// The fields are added at the end of the message when the logger is not FieldLogger
+func (l *clientLogger) withFields(msg string, keysAndValues []interface{}) (Logger, string) {
+
+	msg = redact(msg)
+	if len(keysAndValues) == 0 {
+		return l.logger, msg
+	}
+	fields := redactFields(keysAndValues)
+	if logger, ok := l.logger.(FieldLogger); ok {
+		return logger.WithFields(fields), msg
+	}
+
+	return l.logger, fmt.Sprintf("%s %s", msg, fields)
+}
+
+func (l *clientLogger) Debug(args ...interface{}) {
+	if l.enabled(LOG_LEVEL_DEBUG) {
+		l.logger.Debugf("%s", redact(fmt.Sprint(args...)))
+	}
+}
+
+func (l *clientLogger) Debugf(format string, args ...interface{}) {
+	if l.enabled(LOG_LEVEL_DEBUG) {
+		l.logger.Debugf("%s", redact(fmt.Sprintf(format, args...)))
+	}
+}
+
+// Debugw write the message with the key/values, like the cluster name or the path of the call
+func (l *clientLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	if l.enabled(LOG_LEVEL_DEBUG) {
+		logger, msg := l.withFields(msg, keysAndValues)
+		logger.Debugf("%s", msg)
+	}
+}
+
+func (l *clientLogger) Info(args ...interface{}) {
+	if l.enabled(LOG_LEVEL_INFO) {
+		l.logger.Infof("%s", redact(fmt.Sprint(args...)))
+	}
+}
+
+func (l *clientLogger) Infof(format string, args ...interface{}) {
+	if l.enabled(LOG_LEVEL_INFO) {
+		l.logger.Infof("%s", redact(fmt.Sprintf(format, args...)))
+	}
+}
+
+func (l *clientLogger) Infow(msg string, keysAndValues ...interface{}) {
+	if l.enabled(LOG_LEVEL_INFO) {
+		logger, msg := l.withFields(msg, keysAndValues)
+		logger.Infof("%s", msg)
+	}
+}
+
+func (l *clientLogger) Warn(args ...interface{}) {
+	if l.enabled(LOG_LEVEL_WARN) {
+		l.logger.Warnf("%s", redact(fmt.Sprint(args...)))
+	}
+}
+
+func (l *clientLogger) Warnf(format string, args ...interface{}) {
+	if l.enabled(LOG_LEVEL_WARN) {
+		l.logger.Warnf("%s", redact(fmt.Sprintf(format, args...)))
+	}
+}
+
+func (l *clientLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	if l.enabled(LOG_LEVEL_WARN) {
+		logger, msg := l.withFields(msg, keysAndValues)
+		logger.Warnf("%s", msg)
+	}
+}
+
+func (l *clientLogger) Error(args ...interface{}) {
+	if l.enabled(LOG_LEVEL_ERROR) {
+		l.logger.Errorf("%s", redact(fmt.Sprint(args...)))
+	}
+}
+
+func (l *clientLogger) Errorf(format string, args ...interface{}) {
+	if l.enabled(LOG_LEVEL_ERROR) {
+		l.logger.Errorf("%s", redact(fmt.Sprintf(format, args...)))
+	}
+}
+
+func (l *clientLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	if l.enabled(LOG_LEVEL_ERROR) {
+		logger, msg := l.withFields(msg, keysAndValues)
+		logger.Errorf("%s", msg)
+	}
+}
@@ -0,0 +1,252 @@
+// This file permit to read and change the log levels of services, in the log4j configuration of Ambari like hdfs-log4j
+// Ambari write the log4j file on the hosts when the components restart, so the new level is used after the restart.
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	LOG4J_LEVEL_ALL   = "ALL"
+	LOG4J_LEVEL_TRACE = "TRACE"
+	LOG4J_LEVEL_DEBUG = "DEBUG"
+	LOG4J_LEVEL_INFO  = "INFO"
+	LOG4J_LEVEL_WARN  = "WARN"
+	LOG4J_LEVEL_ERROR = "ERROR"
+	LOG4J_LEVEL_FATAL = "FATAL"
+	LOG4J_LEVEL_OFF   = "OFF"
+
+	// ROOT_LOGGER is the name to use for the root logger of log4j
+	ROOT_LOGGER = "root"
+)
+
+// LoggerLevel is the level of one logger in log4j configuration
+type LoggerLevel struct {
+	Logger string `json:"logger"`
+	Level  string `json:"level"`
+	// Property is the log4j property where the level is set. It can be variable used by the logger, like hadoop.root.logger
+	Property string `json:"property"`
+}
+
+// log4jContent is the log4j properties file, stored in the content property of log4j configuration
+// It keep the lines as is, to change only the level of loggers
+type log4jContent struct {
+	lines []string
+}
+
+// LogConfigurationType return the log4j configuration type of service, like hdfs-log4j for HDFS
+func LogConfigurationType(serviceName string) string {
+	return fmt.Sprintf("%s-log4j", strings.ToLower(serviceName))
+}
+
+// loggerProperty return the log4j property of logger
+func loggerProperty(logger string) string {
+	if logger == ROOT_LOGGER {
+		return "log4j.rootLogger"
+	}
+	return "log4j.logger." + logger
+}
+
+// property return the line index and the value of property, -1 if not found
+func (l *log4jContent) property(key string) (int, string) {
+	for i, line := range l.lines {
+		trimLine := strings.TrimSpace(line)
+		if trimLine == "" || strings.HasPrefix(trimLine, "#") {
+			continue
+		}
+		keyValue := strings.SplitN(trimLine, "=", 2)
+		if len(keyValue) == 2 && strings.TrimSpace(keyValue[0]) == key {
+			return i, strings.TrimSpace(keyValue[1])
+		}
+	}
+	return -1, ""
+}
+
+// level return the log level of logger and the property where it's set
+// The level can come from variable, like log4j.rootLogger=${hadoop.root.logger}, EventCounter
+// It return empty level if logger is not set
+func (l *log4jContent) level(logger string) (string, string) {
+
+	key := loggerProperty(logger)
+	i, value := l.property(key)
+	if i < 0 {
+		return "", key
+	}
+	level := strings.TrimSpace(strings.Split(value, ",")[0])
+	if strings.HasPrefix(level, "${") && strings.HasSuffix(level, "}") {
+		variable := level[2 : len(level)-1]
+		if i, value = l.property(variable); i >= 0 {
+			return strings.TrimSpace(strings.Split(value, ",")[0]), variable
+		}
+	}
+
+	return level, key
+}
+
+// setLevel change the level of logger and keep its appenders. It add the logger if it's not set.
+func (l *log4jContent) setLevel(logger string, level string) error {
+
+	currentLevel, key := l.level(logger)
+	if strings.HasPrefix(currentLevel, "${") {
+		return NewAmbariError(400, "The level of logger %s come from variable %s that is not set in configuration", logger, currentLevel)
+	}
+	i, value := l.property(key)
+	if i < 0 {
+		l.lines = append(l.lines, fmt.Sprintf("%s=%s", key, level))
+		return nil
+	}
+
+	appenders := ""
+	if index := strings.Index(value, ","); index >= 0 {
+		appenders = value[index:]
+	}
+	// Keep the key and the spaces around =
+	line := l.lines[i]
+	start := strings.Index(line, "=") + 1
+	start += len(line[start:]) - len(strings.TrimLeft(line[start:], " \t"))
+	l.lines[i] = line[:start] + level + appenders
+
+	return nil
+}
+
+// String return the log4j properties file
+func (l *log4jContent) String() string {
+	return strings.Join(l.lines, "\n")
+}
+
+// logContent return the log4j configuration used by cluster and its content
+func (c *AmbariClient) logContent(clusterName string, configurationType string) (*Configuration, *log4jContent, error) {
+
+	configuration, err := c.DesiredConfigurationOnCluster(clusterName, configurationType)
+	if err != nil {
+		return nil, nil, err
+	}
+	if configuration == nil {
+		return nil, nil, NewAmbariError(404, "Configuration %s not found on cluster %s", configurationType, clusterName)
+	}
+	content, ok := configuration.Properties["content"]
+	if !ok {
+		return nil, nil, NewAmbariError(400, "Configuration %s has no log4j content", configurationType)
+	}
+
+	return configuration, &log4jContent{lines: strings.Split(content, "\n")}, nil
+}
+
+// LoggerLevels permit to get the level of all loggers set in log4j configuration, like hdfs-log4j
+// It return the levels sorted by logger
+// It return error if configuration is not found on cluster, if it's not log4j configuration or if something wrong when it call the API
+func (c *AmbariClient) LoggerLevels(clusterName string, configurationType string) ([]LoggerLevel, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	c.log.Debugw("LoggerLevels", "cluster_name", clusterName, "configuration_type", configurationType)
+
+	_, content, err := c.logContent(clusterName, configurationType)
+	if err != nil {
+		return nil, err
+	}
+
+	loggers := make([]string, 0)
+	for _, line := range content.lines {
+		trimLine := strings.TrimSpace(line)
+		if strings.HasPrefix(trimLine, "log4j.rootLogger") {
+			loggers = append(loggers, ROOT_LOGGER)
+		} else if strings.HasPrefix(trimLine, "log4j.logger.") && strings.Contains(trimLine, "=") {
+			loggers = append(loggers, strings.TrimSpace(trimLine[len("log4j.logger."):strings.Index(trimLine, "=")]))
+		}
+	}
+	sort.Strings(loggers)
+
+	loggerLevels := make([]LoggerLevel, 0, len(loggers))
+	for _, logger := range loggers {
+		level, property := content.level(logger)
+		loggerLevels = append(loggerLevels, LoggerLevel{
+			Logger:   logger,
+			Level:    level,
+			Property: property,
+		})
+	}
+	c.log.Debugf("Return logger levels: %v", loggerLevels)
+
+	return loggerLevels, nil
+}
+
+// LoggerLevel permit to get the level of logger in log4j configuration, like the level of org.apache.hadoop.hdfs.server.namenode in hdfs-log4j
+// Use ROOT_LOGGER to get the level of root logger.
+// It return the logger level
+// It return nil if the logger is not set in configuration
+// It return error if configuration is not found on cluster, if it's not log4j configuration or if something wrong when it call the API
+func (c *AmbariClient) LoggerLevel(clusterName string, configurationType string, logger string) (*LoggerLevel, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	if logger == "" {
+		panic("Logger can't be empty")
+	}
+	c.log.Debugw("LoggerLevel", "cluster_name", clusterName, "configuration_type", configurationType, "logger", logger)
+
+	_, content, err := c.logContent(clusterName, configurationType)
+	if err != nil {
+		return nil, err
+	}
+	level, property := content.level(logger)
+	if level == "" {
+		return nil, nil
+	}
+
+	return &LoggerLevel{
+		Logger:   logger,
+		Level:    level,
+		Property: property,
+	}, nil
+}
+
+// SetLoggerLevel permit to change the level of logger in log4j configuration, like to set org.apache.hadoop.hdfs.server.namenode to DEBUG in hdfs-log4j
+// It keep the appenders of logger and add the logger if it's not set. When the level come from variable, like hadoop.root.logger, it change the variable.
+// It create new configuration version, so you need to restart the components to use the new level.
+// It return the new configuration, or the current one if the logger already have this level
+// It return error if the level is unknown, if configuration is not found on cluster, if it's not log4j configuration or if something wrong when it call the API
+func (c *AmbariClient) SetLoggerLevel(clusterName string, configurationType string, logger string, level string) (*Configuration, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if configurationType == "" {
+		panic("ConfigurationType can't be empty")
+	}
+	if logger == "" {
+		panic("Logger can't be empty")
+	}
+	if level == "" {
+		panic("Level can't be empty")
+	}
+	c.log.Debugw("SetLoggerLevel", "cluster_name", clusterName, "configuration_type", configurationType, "logger", logger, "level", level)
+
+	level = strings.ToUpper(level)
+	switch level {
+	case LOG4J_LEVEL_ALL, LOG4J_LEVEL_TRACE, LOG4J_LEVEL_DEBUG, LOG4J_LEVEL_INFO, LOG4J_LEVEL_WARN, LOG4J_LEVEL_ERROR, LOG4J_LEVEL_FATAL, LOG4J_LEVEL_OFF:
+	default:
+		return nil, NewAmbariError(400, "Log level %s is unknown", level)
+	}
+
+	_, content, err := c.logContent(clusterName, configurationType)
+	if err != nil {
+		return nil, err
+	}
+	if err = content.setLevel(logger, level); err != nil {
+		return nil, err
+	}
+
+	return c.UpdateConfigurationProperties(clusterName, configurationType, map[string]string{"content": content.String()}, nil, fmt.Sprintf("Set log level of %s to %s", logger, level))
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+const hdfsLog4j = `# Define some default values that can be overridden by system properties
+hadoop.root.logger=INFO,console
+log4j.rootLogger=${hadoop.root.logger}, EventCounter
+log4j.threshold=ALL
+log4j.logger.org.apache.hadoop.hdfs.server.namenode.FSNamesystem.audit = INFO, DRFAAUDIT
+log4j.logger.org.apache.hadoop.metrics2=${hadoop.metrics.logger}`
+
+func TestLoggerLevels(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       LogConfigurationType("HDFS"),
+		Tag:        "version1",
+		Properties: map[string]string{"content": hdfsLog4j},
+	})
+	assert.NoError(t, err)
+
+	loggerLevels, err := client.LoggerLevels("test", "hdfs-log4j")
+	assert.NoError(t, err)
+	assert.Equal(t, []LoggerLevel{
+		{Logger: "org.apache.hadoop.hdfs.server.namenode.FSNamesystem.audit", Level: "INFO", Property: "log4j.logger.org.apache.hadoop.hdfs.server.namenode.FSNamesystem.audit"},
+		{Logger: "org.apache.hadoop.metrics2", Level: "${hadoop.metrics.logger}", Property: "log4j.logger.org.apache.hadoop.metrics2"},
+		{Logger: ROOT_LOGGER, Level: "INFO", Property: "hadoop.root.logger"},
+	}, loggerLevels)
+
+	loggerLevel, err := client.LoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.hdfs.server.namenode")
+	assert.NoError(t, err)
+	assert.Nil(t, loggerLevel)
+
+	_, err = client.LoggerLevels("test", "yarn-log4j")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestSetLoggerLevel(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+	_, err := client.CreateConfigurationOnCluster("test", &Configuration{
+		Type:       "hdfs-log4j",
+		Tag:        "version1",
+		Properties: map[string]string{"content": hdfsLog4j},
+	})
+	assert.NoError(t, err)
+
+	// Root logger come from variable, the variable is changed and the appenders are kept
+	configuration, err := client.SetLoggerLevel("test", "hdfs-log4j", ROOT_LOGGER, "debug")
+	assert.NoError(t, err)
+	assert.NotEqual(t, "version1", configuration.Tag)
+	assert.Contains(t, configuration.Properties["content"], "hadoop.root.logger=DEBUG,console\nlog4j.rootLogger=${hadoop.root.logger}, EventCounter\n")
+
+	// Existing logger keep its appenders
+	configuration, err = client.SetLoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.hdfs.server.namenode.FSNamesystem.audit", LOG4J_LEVEL_WARN)
+	assert.NoError(t, err)
+	assert.Contains(t, configuration.Properties["content"], "log4j.logger.org.apache.hadoop.hdfs.server.namenode.FSNamesystem.audit = WARN, DRFAAUDIT\n")
+
+	// New logger is added
+	configuration, err = client.SetLoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.hdfs.server.namenode", LOG4J_LEVEL_DEBUG)
+	assert.NoError(t, err)
+	loggerLevel, err := client.LoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.hdfs.server.namenode")
+	assert.NoError(t, err)
+	assert.Equal(t, &LoggerLevel{Logger: "org.apache.hadoop.hdfs.server.namenode", Level: "DEBUG", Property: "log4j.logger.org.apache.hadoop.hdfs.server.namenode"}, loggerLevel)
+
+	// Same level, no new version
+	sameConfiguration, err := client.SetLoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.hdfs.server.namenode", LOG4J_LEVEL_DEBUG)
+	assert.NoError(t, err)
+	assert.Equal(t, configuration.Tag, sameConfiguration.Tag)
+
+	// Variable not set in configuration
+	_, err = client.SetLoggerLevel("test", "hdfs-log4j", "org.apache.hadoop.metrics2", LOG4J_LEVEL_DEBUG)
+	assert.Error(t, err)
+
+	_, err = client.SetLoggerLevel("test", "hdfs-log4j", ROOT_LOGGER, "VERBOSE")
+	assert.Error(t, err)
+	assert.Equal(t, 400, err.(AmbariError).Code)
+	assert.Panics(t, func() { client.SetLoggerLevel("test", "hdfs-log4j", "", LOG4J_LEVEL_DEBUG) })
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testLogger struct {
+	logs []string
+}
+
+func (l *testLogger) Debugf(format string, args ...interface{}) {
+	l.logs = append(l.logs, "DEBUG "+fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Infof(format string, args ...interface{}) {
+	l.logs = append(l.logs, "INFO "+fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Warnf(format string, args ...interface{}) {
+	l.logs = append(l.logs, "WARN "+fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Errorf(format string, args ...interface{}) {
+	l.logs = append(l.logs, "ERROR "+fmt.Sprintf(format, args...))
+}
+
+func TestRedact(t *testing.T) {
+
+	assert.Equal(t, `{"Users":{"user_name":"admin","password":"****","old_password":"****"}}`, redact(`{"Users":{"user_name":"admin","password":"secret","old_password":"old\"secret"}}`))
+	assert.Equal(t, `{"Credential":{"principal":"admin/admin","key":"****"}}`, redact(`{"Credential":{"principal":"admin/admin","key":"secret"}}`))
+	assert.Equal(t, `{"properties":{"javax.jdo.option.ConnectionPassword" : "****","hive.metastore.uris":"thrift://host:9083"}}`, redact(`{"properties":{"javax.jdo.option.ConnectionPassword" : "secret","hive.metastore.uris":"thrift://host:9083"}}`))
+}
+
+func TestSetLogger(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Credential": {"alias": "kdc.admin.credential", "key": "secret"}}`))
+	}))
+	defer server.Close()
+
+	logger := &testLogger{}
+	client := New(server.URL, "admin", "admin")
+	client.SetLogger(logger)
+	_, err := client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, logger.logs)
+	for _, log := range logger.logs {
+		assert.True(t, strings.HasPrefix(log, "DEBUG "))
+		assert.NotContains(t, log, "secret")
+	}
+
+	// Filter by level
+	logger.logs = nil
+	client.SetLogLevel(LOG_LEVEL_INFO)
+	_, err = client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.Empty(t, logger.logs)
+
+	client.log.Warn("warn")
+	client.log.Errorf("error %d", 1)
+	assert.Equal(t, []string{"WARN warn", "ERROR error 1"}, logger.logs)
+
+	logger.logs = nil
+	client.SetLogLevel(LOG_LEVEL_NONE)
+	client.log.Error("error")
+	assert.Empty(t, logger.logs)
+}
+
+type testKeyValueLogger struct {
+	logs []string
+}
+
+func (l *testKeyValueLogger) log(level string, msg string, keysAndValues []interface{}) {
+	l.logs = append(l.logs, fmt.Sprint(level, " ", msg, " ", keysAndValues))
+}
+
+func (l *testKeyValueLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.log("DEBUG", msg, keysAndValues)
+}
+
+func (l *testKeyValueLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.log("INFO", msg, keysAndValues)
+}
+
+func (l *testKeyValueLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.log("WARN", msg, keysAndValues)
+}
+
+func (l *testKeyValueLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.log("ERROR", msg, keysAndValues)
+}
+
+func TestLogFields(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Credential": {"alias": "kdc.admin.credential", "key": "secret"}}`))
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	// Printf logger have the fields at the end of the message
+	logger := &testLogger{}
+	client.SetLogger(logger)
+	_, err := client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.Contains(t, logger.logs, "DEBUG Credential alias=kdc.admin.credential cluster_name=test")
+	assert.Contains(t, strings.Join(logger.logs, "\n"), "DEBUG Call Ambari API duration=")
+	assert.Contains(t, strings.Join(logger.logs, "\n"), " method=GET path=/clusters/test/credentials/kdc.admin.credential status=200")
+
+	// Logrus have the fields as logrus fields
+	output := &bytes.Buffer{}
+	logrusLogger := logrus.New()
+	logrusLogger.SetOutput(output)
+	logrusLogger.SetLevel(logrus.DebugLevel)
+	logrusLogger.SetFormatter(&logrus.JSONFormatter{DisableTimestamp: true})
+	client.SetLogger(logrusLogger)
+	_, err = client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.Contains(t, output.String(), `{"alias":"kdc.admin.credential","cluster_name":"test","level":"debug","msg":"Credential"}`)
+	assert.Contains(t, output.String(), `"method":"GET","msg":"Call Ambari API","path":"/clusters/test/credentials/kdc.admin.credential","status":200}`)
+	assert.NotContains(t, output.String(), "secret")
+
+	// The logrus level is used
+	output.Reset()
+	logrusLogger.SetLevel(logrus.InfoLevel)
+	_, err = client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.Empty(t, output.String())
+
+	// Slog style logger have the fields as key/values
+	keyValueLogger := &testKeyValueLogger{}
+	client.SetLogger(NewKeyValueLogger(keyValueLogger))
+	_, err = client.Credential("test", "kdc.admin.credential")
+	assert.NoError(t, err)
+	assert.Contains(t, keyValueLogger.logs, "DEBUG Credential [alias kdc.admin.credential cluster_name test]")
+
+	// The credentials are hidden in fields
+	keyValueLogger.logs = nil
+	client.log.Debugw("Test", "properties", map[string]string{"hive.metastore.password": "secret"}, "password", "secret")
+	assert.Equal(t, []string{`DEBUG Test [password **** properties {"hive.metastore.password":"****"}]`}, keyValueLogger.logs)
+}
@@ -0,0 +1,227 @@
+// This file permit to query metrics from Ambari API
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md#partial-response
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MetricQuery permit to describe the metrics to read
+// Metrics are the metric path without the metrics/ prefix, like cpu/cpu_user or jvm/memHeapUsedM
+// When Start is zero, Ambari return only the current value of each metric
+type MetricQuery struct {
+	Metrics []string
+	Start   time.Time
+	End     time.Time
+	Step    time.Duration
+}
+
+// MetricSeries is the list of points for one metric
+type MetricSeries struct {
+	Name   string        `json:"name"`
+	Points []MetricPoint `json:"points"`
+}
+
+// MetricPoint is one value of metric.
+// Timestamp is zero when it's the current value and not temporal value
+type MetricPoint struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     float64   `json:"value"`
+}
+
+// String return metric series object as Json string
+func (m *MetricSeries) String() string {
+	json, _ := json.Marshal(m)
+	return string(json)
+}
+
+// Fields permit to compute the fields parameter expected by Ambari
+func (q *MetricQuery) Fields() string {
+
+	temporal := ""
+	if !q.Start.IsZero() {
+		end := q.End
+		if end.IsZero() {
+			end = time.Now()
+		}
+		step := int64(q.Step / time.Second)
+		if step <= 0 {
+			step = 15
+		}
+		temporal = fmt.Sprintf("[%d,%d,%d]", q.Start.Unix(), end.Unix(), step)
+	}
+
+	fields := make([]string, 0, len(q.Metrics))
+	for _, metric := range q.Metrics {
+		fields = append(fields, fmt.Sprintf("metrics/%s%s", strings.Trim(metric, "/"), temporal))
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// HostMetrics permit to read metrics of host
+// It return one series per metric found
+// It return nil if host not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) HostMetrics(clusterName string, hostname string, query *MetricQuery) ([]MetricSeries, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if hostname == "" {
+		panic("Hostname can't be empty")
+	}
+	c.log.Debugw("HostMetrics", "cluster_name", clusterName, "hostname", hostname)
+
+	return c.metrics(fmt.Sprintf("/clusters/%s/hosts/%s", clusterName, hostname), query)
+}
+
+// ServiceMetrics permit to read metrics of service
+// It return one series per metric found
+// It return nil if service not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ServiceMetrics(clusterName string, serviceName string, query *MetricQuery) ([]MetricSeries, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	c.log.Debugw("ServiceMetrics", "cluster_name", clusterName, "service_name", serviceName)
+
+	return c.metrics(fmt.Sprintf("/clusters/%s/services/%s", clusterName, serviceName), query)
+}
+
+// ComponentMetrics permit to read metrics of component, aggregated by Ambari on all hosts
+// It return one series per metric found
+// It return nil if component not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ComponentMetrics(clusterName string, serviceName string, componentName string, query *MetricQuery) ([]MetricSeries, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	c.log.Debugw("ComponentMetrics", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName)
+
+	return c.metrics(fmt.Sprintf("/clusters/%s/services/%s/components/%s", clusterName, serviceName, componentName), query)
+}
+
+// HostComponentMetrics permit to read metrics of component on specific host
+// It return one series per metric found
+// It return nil if component not found on host
+// It return error if something wrong when it call the API
+func (c *AmbariClient) HostComponentMetrics(clusterName string, hostname string, componentName string, query *MetricQuery) ([]MetricSeries, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if hostname == "" {
+		panic("Hostname can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	c.log.Debugw("HostComponentMetrics", "cluster_name", clusterName, "hostname", hostname, "component_name", componentName)
+
+	return c.metrics(fmt.Sprintf("/clusters/%s/hosts/%s/host_components/%s", clusterName, hostname, componentName), query)
+}
+
+// metrics call the API on resource path and extract the metrics from the response
+func (c *AmbariClient) metrics(path string, query *MetricQuery) ([]MetricSeries, error) {
+
+	if query == nil {
+		panic("Query can't be nil")
+	}
+	if len(query.Metrics) == 0 {
+		panic("Metrics can't be empty")
+	}
+	c.log.Debugw("Metrics", "query", query)
+
+	resp, err := c.Client().R().SetQueryParam("fields", query.Fields()).Get(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+
+	series, err := parseMetrics(resp.Body())
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Return metrics: ", series)
+
+	return series, nil
+}
+
+// parseMetrics extract all metrics series from the metrics section of Ambari response
+// Temporal metric is list of [value, timestamp] and current metric is a single value
+func parseMetrics(body []byte) ([]MetricSeries, error) {
+
+	data := &struct {
+		Metrics map[string]interface{} `json:"metrics"`
+	}{}
+	err := json.Unmarshal(body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make([]MetricSeries, 0)
+	var walk func(prefix string, node interface{})
+	walk = func(prefix string, node interface{}) {
+		switch value := node.(type) {
+		case map[string]interface{}:
+			for key, child := range value {
+				name := key
+				if prefix != "" {
+					name = prefix + "/" + key
+				}
+				walk(name, child)
+			}
+		case []interface{}:
+			points := make([]MetricPoint, 0, len(value))
+			for _, item := range value {
+				point, ok := item.([]interface{})
+				if !ok || len(point) != 2 {
+					continue
+				}
+				metricValue, okValue := point[0].(float64)
+				timestamp, okTimestamp := point[1].(float64)
+				if !okValue || !okTimestamp {
+					continue
+				}
+				points = append(points, MetricPoint{
+					Timestamp: time.Unix(int64(timestamp), 0),
+					Value:     metricValue,
+				})
+			}
+			series = append(series, MetricSeries{Name: prefix, Points: points})
+		case float64:
+			series = append(series, MetricSeries{Name: prefix, Points: []MetricPoint{{Value: value}}})
+		}
+	}
+	walk("", data.Metrics)
+
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Name < series[j].Name
+	})
+
+	return series, nil
+}
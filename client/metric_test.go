@@ -0,0 +1,67 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func (s *ClientTestSuite) TestMetrics() {
+
+	query := &MetricQuery{
+		Metrics: []string{"cpu/cpu_user", "memory/mem_free"},
+		Start:   time.Now().Add(-1 * time.Hour),
+		End:     time.Now(),
+		Step:    15 * time.Second,
+	}
+
+	// Get host metrics
+	series, err := s.client.HostMetrics("test", "ambari-agent", query)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), series)
+
+	// Get component metrics
+	query.Metrics = []string{"jvm/memHeapUsedM"}
+	series, err = s.client.ComponentMetrics("test", "ZOOKEEPER", "ZOOKEEPER_SERVER", query)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), series)
+}
+
+func TestMetricQueryFields(t *testing.T) {
+
+	query := &MetricQuery{
+		Metrics: []string{"cpu/cpu_user", "/memory/mem_free"},
+	}
+	assert.Equal(t, "metrics/cpu/cpu_user,metrics/memory/mem_free", query.Fields())
+
+	query.Start = time.Unix(1430844610, 0)
+	query.End = time.Unix(1430848210, 0)
+	query.Step = 30 * time.Second
+	assert.Equal(t, "metrics/cpu/cpu_user[1430844610,1430848210,30],metrics/memory/mem_free[1430844610,1430848210,30]", query.Fields())
+}
+
+func TestParseMetrics(t *testing.T) {
+
+	body := []byte(`{
+		"href": "http://ambari-server:8080/api/v1/clusters/test/hosts/ambari-agent",
+		"Hosts": {"cluster_name": "test", "host_name": "ambari-agent"},
+		"metrics": {
+			"cpu": {
+				"cpu_user": [[1.5, 1430844610], [2.5, 1430844625]]
+			},
+			"memory": {
+				"mem_free": 1024
+			}
+		}
+	}`)
+	series, err := parseMetrics(body)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(series))
+	assert.Equal(t, "cpu/cpu_user", series[0].Name)
+	assert.Equal(t, 2, len(series[0].Points))
+	assert.Equal(t, 1.5, series[0].Points[0].Value)
+	assert.Equal(t, time.Unix(1430844610, 0), series[0].Points[0].Timestamp)
+	assert.Equal(t, "memory/mem_free", series[1].Name)
+	assert.Equal(t, float64(1024), series[1].Points[0].Value)
+	assert.True(t, series[1].Points[0].Timestamp.IsZero())
+}
@@ -0,0 +1,456 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlertService is an autogenerated mock type for the AlertService type
+type AlertService struct {
+	mock.Mock
+}
+
+// AlertTarget provides a mock function with given fields: id, opts
+func (_m *AlertService) AlertTarget(id int64, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertTargetByID provides a mock function with given fields: id, opts
+func (_m *AlertService) AlertTargetByID(id string, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTargetByID")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertTargets provides a mock function with given fields: opts
+func (_m *AlertService) AlertTargets(opts ...client.RequestOption) ([]client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTargets")
+	}
+
+	var r0 []client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.AlertTarget, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.AlertTarget); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Alerts provides a mock function with given fields: clusterName, opts
+func (_m *AlertService) Alerts(clusterName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerts")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInCluster provides a mock function with given fields: clusterName, opts
+func (_m *AlertService) AlertsInCluster(clusterName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInCluster")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInHost provides a mock function with given fields: clusterName, hostname, opts
+func (_m *AlertService) AlertsInHost(clusterName string, hostname string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInHost")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInService provides a mock function with given fields: clusterName, serviceName, opts
+func (_m *AlertService) AlertsInService(clusterName string, serviceName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInService")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyAlertTarget provides a mock function with given fields: alertTarget
+func (_m *AlertService) ApplyAlertTarget(alertTarget *client.AlertTarget) (*client.ChangeReport, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyAlertTarget")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.ChangeReport, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.ChangeReport); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAlertTarget provides a mock function with given fields: alertTarget
+func (_m *AlertService) CreateAlertTarget(alertTarget *client.AlertTarget) (*client.AlertTarget, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.AlertTarget, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.AlertTarget); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAlertTarget provides a mock function with given fields: id
+func (_m *AlertService) DeleteAlertTarget(id int64) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchAlertTarget provides a mock function with given fields: name, opts
+func (_m *AlertService) SearchAlertTarget(name string, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAlertHistory provides a mock function with given fields: clusterName, callback, opts
+func (_m *AlertService) StreamAlertHistory(clusterName string, callback func(*client.AlertHistory) error, opts ...client.RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, callback)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAlertHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(*client.AlertHistory) error, ...client.RequestOption) error); ok {
+		r0 = rf(clusterName, callback, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAlertTarget provides a mock function with given fields: alertTarget
+func (_m *AlertService) UpdateAlertTarget(alertTarget *client.AlertTarget) (*client.AlertTarget, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.AlertTarget, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.AlertTarget); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAlertService creates a new instance of AlertService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlertService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlertService {
+	mock := &AlertService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,6196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// API is an autogenerated mock type for the API type
+type API struct {
+	mock.Mock
+}
+
+// AbortRequest provides a mock function with given fields: clusterName, Id
+func (_m *API) AbortRequest(clusterName string, Id int) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, Id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortRequest")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.RequestTask, error)); ok {
+		return rf(clusterName, Id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.RequestTask); ok {
+		r0 = rf(clusterName, Id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, Id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivateUser provides a mock function with given fields: userName, active
+func (_m *API) ActivateUser(userName string, active bool) error {
+	ret := _m.Called(userName, active)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActivateUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(userName, active)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddGroupMember provides a mock function with given fields: groupName, userName
+func (_m *API) AddGroupMember(groupName string, userName string) error {
+	ret := _m.Called(groupName, userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGroupMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(groupName, userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlertTarget provides a mock function with given fields: id, opts
+func (_m *API) AlertTarget(id int64, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(int64, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertTargetByID provides a mock function with given fields: id, opts
+func (_m *API) AlertTargetByID(id string, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTargetByID")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertTargets provides a mock function with given fields: opts
+func (_m *API) AlertTargets(opts ...client.RequestOption) ([]client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertTargets")
+	}
+
+	var r0 []client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.AlertTarget, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.AlertTarget); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Alerts provides a mock function with given fields: clusterName, opts
+func (_m *API) Alerts(clusterName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerts")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInCluster provides a mock function with given fields: clusterName, opts
+func (_m *API) AlertsInCluster(clusterName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInCluster")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInHost provides a mock function with given fields: clusterName, hostname, opts
+func (_m *API) AlertsInHost(clusterName string, hostname string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInHost")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertsInService provides a mock function with given fields: clusterName, serviceName, opts
+func (_m *API) AlertsInService(clusterName string, serviceName string, opts ...client.RequestOption) ([]client.Alert, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AlertsInService")
+	}
+
+	var r0 []client.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.Alert, error)); ok {
+		return rf(clusterName, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.Alert); ok {
+		r0 = rf(clusterName, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmbariPrivilegeByID provides a mock function with given fields: id, opts
+func (_m *API) AmbariPrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmbariPrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmbariPrivileges provides a mock function with given fields: opts
+func (_m *API) AmbariPrivileges(opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmbariPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyAlertTarget provides a mock function with given fields: alertTarget
+func (_m *API) ApplyAlertTarget(alertTarget *client.AlertTarget) (*client.ChangeReport, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyAlertTarget")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.ChangeReport, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.ChangeReport); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyConfigGroup provides a mock function with given fields: configGroup
+func (_m *API) ApplyConfigGroup(configGroup *client.ConfigGroup) (*client.ChangeReport, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyConfigGroup")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ChangeReport, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ChangeReport); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *API) ApplyPrivilege(clusterName string, privilege *client.Privilege) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPrivilege")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.ChangeReport); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPrivileges provides a mock function with given fields: clusterName, privileges
+func (_m *API) ApplyPrivileges(clusterName string, privileges []client.Privilege) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, privileges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPrivileges")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []client.Privilege) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, privileges)
+	}
+	if rf, ok := ret.Get(0).(func(string, []client.Privilege) *client.ChangeReport); ok {
+		r0 = rf(clusterName, privileges)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []client.Privilege) error); ok {
+		r1 = rf(clusterName, privileges)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplySetting provides a mock function with given fields: setting
+func (_m *API) ApplySetting(setting *client.Setting) (*client.ChangeReport, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplySetting")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.ChangeReport, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.ChangeReport); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyUser provides a mock function with given fields: user
+func (_m *API) ApplyUser(user *client.User) (*client.ChangeReport, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyUser")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.User) (*client.ChangeReport, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(*client.User) *client.ChangeReport); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.User) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Blueprint provides a mock function with given fields: name, opts
+func (_m *API) Blueprint(name string, opts ...client.RequestOption) (*client.Blueprint, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Blueprint")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Blueprint, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Blueprint); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlueprintByID provides a mock function with given fields: id, opts
+func (_m *API) BlueprintByID(id string, opts ...client.RequestOption) (*client.Blueprint, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlueprintByID")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Blueprint, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Blueprint); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cluster provides a mock function with given fields: clusterName, opts
+func (_m *API) Cluster(clusterName string, opts ...client.RequestOption) (*client.Cluster, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Cluster, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Cluster); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterByID provides a mock function with given fields: id, opts
+func (_m *API) ClusterByID(id string, opts ...client.RequestOption) (*client.Cluster, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClusterByID")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Cluster, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Cluster); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterRecoveryEnabled provides a mock function with given fields: clusterName
+func (_m *API) ClusterRecoveryEnabled(clusterName string) (bool, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClusterRecoveryEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(clusterName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Component provides a mock function with given fields: clusterName, serviceName, componentName, opts
+func (_m *API) Component(clusterName string, serviceName string, componentName string, opts ...client.RequestOption) (*client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName, componentName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Component")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.Component, error)); ok {
+		return rf(clusterName, serviceName, componentName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.Component); ok {
+		r0 = rf(clusterName, serviceName, componentName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentByID provides a mock function with given fields: id, opts
+func (_m *API) ComponentByID(id string, opts ...client.RequestOption) (*client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentByID")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Component, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Component); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentMetrics provides a mock function with given fields: clusterName, serviceName, componentName, query
+func (_m *API) ComponentMetrics(clusterName string, serviceName string, componentName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, serviceName, componentName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, serviceName, componentName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentsRecovery provides a mock function with given fields: clusterName, opts
+func (_m *API) ComponentsRecovery(clusterName string, opts ...client.RequestOption) ([]client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentsRecovery")
+	}
+
+	var r0 []client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Component, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Component); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroup provides a mock function with given fields: clusterName, id, opts
+func (_m *API) ConfigGroup(clusterName string, id int64, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroupByID provides a mock function with given fields: id, opts
+func (_m *API) ConfigGroupByID(id string, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroupByID")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroups provides a mock function with given fields: clusterName, opts
+func (_m *API) ConfigGroups(clusterName string, opts ...client.RequestOption) ([]client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroups")
+	}
+
+	var r0 []client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.ConfigGroup, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.ConfigGroup); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigurationByID provides a mock function with given fields: id, opts
+func (_m *API) ConfigurationByID(id string, opts ...client.RequestOption) (*client.Configuration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigurationByID")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Configuration, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Configuration); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigurationOnCluster provides a mock function with given fields: clusterName, configurationType, tag, opts
+func (_m *API) ConfigurationOnCluster(clusterName string, configurationType string, tag string, opts ...client.RequestOption) (*client.Configuration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, configurationType, tag)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigurationOnCluster")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, tag, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, tag, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, configurationType, tag, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAlertTarget provides a mock function with given fields: alertTarget
+func (_m *API) CreateAlertTarget(alertTarget *client.AlertTarget) (*client.AlertTarget, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.AlertTarget, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.AlertTarget); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAmbariPrivilege provides a mock function with given fields: privilege
+func (_m *API) CreateAmbariPrivilege(privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAmbariPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Privilege) (*client.Privilege, error)); ok {
+		return rf(privilege)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Privilege) *client.Privilege); ok {
+		r0 = rf(privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Privilege) error); ok {
+		r1 = rf(privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBlueprint provides a mock function with given fields: name, jsonBlueprint
+func (_m *API) CreateBlueprint(name string, jsonBlueprint string) (*client.Blueprint, error) {
+	ret := _m.Called(name, jsonBlueprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlueprint")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Blueprint, error)); ok {
+		return rf(name, jsonBlueprint)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Blueprint); ok {
+		r0 = rf(name, jsonBlueprint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonBlueprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCluster provides a mock function with given fields: cluster
+func (_m *API) CreateCluster(cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster) (*client.Cluster, error)); ok {
+		return rf(cluster)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Cluster) *client.Cluster); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Cluster) error); ok {
+		r1 = rf(cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterFromTemplate provides a mock function with given fields: name, jsonClusterTemplate
+func (_m *API) CreateClusterFromTemplate(name string, jsonClusterTemplate string) (*client.Cluster, error) {
+	ret := _m.Called(name, jsonClusterTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClusterFromTemplate")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Cluster, error)); ok {
+		return rf(name, jsonClusterTemplate)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Cluster); ok {
+		r0 = rf(name, jsonClusterTemplate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonClusterTemplate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateComponent provides a mock function with given fields: component
+func (_m *API) CreateComponent(component *client.Component) (*client.Component, error) {
+	ret := _m.Called(component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComponent")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Component) (*client.Component, error)); ok {
+		return rf(component)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Component) *client.Component); ok {
+		r0 = rf(component)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Component) error); ok {
+		r1 = rf(component)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateConfigGroup provides a mock function with given fields: configGroup
+func (_m *API) CreateConfigGroup(configGroup *client.ConfigGroup) (*client.ConfigGroup, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ConfigGroup, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ConfigGroup); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateConfigurationOnCluster provides a mock function with given fields: clusterName, configuration
+func (_m *API) CreateConfigurationOnCluster(clusterName string, configuration *client.Configuration) (*client.Cluster, error) {
+	ret := _m.Called(clusterName, configuration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConfigurationOnCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Configuration) (*client.Cluster, error)); ok {
+		return rf(clusterName, configuration)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Configuration) *client.Cluster); ok {
+		r0 = rf(clusterName, configuration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Configuration) error); ok {
+		r1 = rf(clusterName, configuration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCredential provides a mock function with given fields: credential
+func (_m *API) CreateCredential(credential *client.Credential) (*client.Credential, error) {
+	ret := _m.Called(credential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCredential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Credential) (*client.Credential, error)); ok {
+		return rf(credential)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Credential) *client.Credential); ok {
+		r0 = rf(credential)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Credential) error); ok {
+		r1 = rf(credential)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateGroup provides a mock function with given fields: groupName
+func (_m *API) CreateGroup(groupName string) (*client.Group, error) {
+	ret := _m.Called(groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateGroup")
+	}
+
+	var r0 *client.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.Group, error)); ok {
+		return rf(groupName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.Group); ok {
+		r0 = rf(groupName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(groupName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHost provides a mock function with given fields: host
+func (_m *API) CreateHost(host *client.Host) (*client.Host, error) {
+	ret := _m.Called(host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHost")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Host) (*client.Host, error)); ok {
+		return rf(host)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Host) *client.Host); ok {
+		r0 = rf(host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Host) error); ok {
+		r1 = rf(host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateHostComponent provides a mock function with given fields: hostComponent
+func (_m *API) CreateHostComponent(hostComponent *client.HostComponent) (*client.HostComponent, error) {
+	ret := _m.Called(hostComponent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) (*client.HostComponent, error)); ok {
+		return rf(hostComponent)
+	}
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) *client.HostComponent); ok {
+		r0 = rf(hostComponent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.HostComponent) error); ok {
+		r1 = rf(hostComponent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *API) CreatePrivilege(clusterName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRepository provides a mock function with given fields: repository
+func (_m *API) CreateRepository(repository *client.Repository) (*client.Repository, error) {
+	ret := _m.Called(repository)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Repository) (*client.Repository, error)); ok {
+		return rf(repository)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Repository) *client.Repository); ok {
+		r0 = rf(repository)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Repository) error); ok {
+		r1 = rf(repository)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRequestSchedule provides a mock function with given fields: requestSchedule
+func (_m *API) CreateRequestSchedule(requestSchedule *client.RequestSchedule) (*client.RequestSchedule, error) {
+	ret := _m.Called(requestSchedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequestSchedule")
+	}
+
+	var r0 *client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.RequestSchedule) (*client.RequestSchedule, error)); ok {
+		return rf(requestSchedule)
+	}
+	if rf, ok := ret.Get(0).(func(*client.RequestSchedule) *client.RequestSchedule); ok {
+		r0 = rf(requestSchedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.RequestSchedule) error); ok {
+		r1 = rf(requestSchedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateService provides a mock function with given fields: service
+func (_m *API) CreateService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSetting provides a mock function with given fields: setting
+func (_m *API) CreateSetting(setting *client.Setting) (*client.Setting, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSetting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.Setting, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.Setting); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: user
+func (_m *API) CreateUser(user *client.User) (*client.User, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUser")
+	}
+
+	var r0 *client.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.User) (*client.User, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(*client.User) *client.User); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.User) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateViewInstance provides a mock function with given fields: viewInstance
+func (_m *API) CreateViewInstance(viewInstance *client.ViewInstance) (*client.ViewInstance, error) {
+	ret := _m.Called(viewInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) (*client.ViewInstance, error)); ok {
+		return rf(viewInstance)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) *client.ViewInstance); ok {
+		r0 = rf(viewInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ViewInstance) error); ok {
+		r1 = rf(viewInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateViewPrivilege provides a mock function with given fields: viewName, version, instanceName, privilege
+func (_m *API) CreateViewPrivilege(viewName string, version string, instanceName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(viewName, version, instanceName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateViewPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(viewName, version, instanceName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(viewName, version, instanceName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.Privilege) error); ok {
+		r1 = rf(viewName, version, instanceName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWidget provides a mock function with given fields: widget
+func (_m *API) CreateWidget(widget *client.Widget) (*client.Widget, error) {
+	ret := _m.Called(widget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Widget) (*client.Widget, error)); ok {
+		return rf(widget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Widget) *client.Widget); ok {
+		r0 = rf(widget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Widget) error); ok {
+		r1 = rf(widget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWidgetLayout provides a mock function with given fields: widgetLayout
+func (_m *API) CreateWidgetLayout(widgetLayout *client.WidgetLayout) (*client.WidgetLayout, error) {
+	ret := _m.Called(widgetLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) (*client.WidgetLayout, error)); ok {
+		return rf(widgetLayout)
+	}
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) *client.WidgetLayout); ok {
+		r0 = rf(widgetLayout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.WidgetLayout) error); ok {
+		r1 = rf(widgetLayout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Credential provides a mock function with given fields: clusterName, alias, opts
+func (_m *API) Credential(clusterName string, alias string, opts ...client.RequestOption) (*client.Credential, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, alias)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Credential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Credential, error)); ok {
+		return rf(clusterName, alias, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Credential); ok {
+		r0 = rf(clusterName, alias, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, alias, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Credentials provides a mock function with given fields: clusterName, opts
+func (_m *API) Credentials(clusterName string, opts ...client.RequestOption) ([]client.Credential, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Credentials")
+	}
+
+	var r0 []client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Credential, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Credential); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAlertTarget provides a mock function with given fields: id
+func (_m *API) DeleteAlertTarget(id int64) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAlertTarget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAllComponentsInHost provides a mock function with given fields: clusterName, hostname, disableMaintenanceMode
+func (_m *API) DeleteAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error {
+	ret := _m.Called(clusterName, hostname, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(clusterName, hostname, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAmbariPrivilege provides a mock function with given fields: id
+func (_m *API) DeleteAmbariPrivilege(id int64) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAmbariPrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBlueprint provides a mock function with given fields: name
+func (_m *API) DeleteBlueprint(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlueprint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCluster provides a mock function with given fields: clusterName
+func (_m *API) DeleteCluster(clusterName string) error {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCluster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(clusterName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteComponent provides a mock function with given fields: clusterName, serviceName, componentName
+func (_m *API) DeleteComponent(clusterName string, serviceName string, componentName string) error {
+	ret := _m.Called(clusterName, serviceName, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComponent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(clusterName, serviceName, componentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteConfigGroup provides a mock function with given fields: clusterName, id
+func (_m *API) DeleteConfigGroup(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConfigGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCredential provides a mock function with given fields: clusterName, alias
+func (_m *API) DeleteCredential(clusterName string, alias string) error {
+	ret := _m.Called(clusterName, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCredential")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteGroup provides a mock function with given fields: groupName
+func (_m *API) DeleteGroup(groupName string) error {
+	ret := _m.Called(groupName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(groupName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHost provides a mock function with given fields: clusterName, hostname
+func (_m *API) DeleteHost(clusterName string, hostname string) error {
+	ret := _m.Called(clusterName, hostname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, hostname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *API) DeleteHostComponent(clusterName string, hostname string, componentName string) error {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHostComponent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePrivilege provides a mock function with given fields: clusterName, id
+func (_m *API) DeletePrivilege(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteQuickLinksProfile provides a mock function with no fields
+func (_m *API) DeleteQuickLinksProfile() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuickLinksProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRepository provides a mock function with given fields: stackName, stackVersion, repositoryId
+func (_m *API) DeleteRepository(stackName string, stackVersion string, repositoryId int) error {
+	ret := _m.Called(stackName, stackVersion, repositoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRepository")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, int) error); ok {
+		r0 = rf(stackName, stackVersion, repositoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRequestSchedule provides a mock function with given fields: clusterName, id
+func (_m *API) DeleteRequestSchedule(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRequestSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteService provides a mock function with given fields: clusterName, serviceName
+func (_m *API) DeleteService(clusterName string, serviceName string) error {
+	ret := _m.Called(clusterName, serviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, serviceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSetting provides a mock function with given fields: name
+func (_m *API) DeleteSetting(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: userName
+func (_m *API) DeleteUser(userName string) error {
+	ret := _m.Called(userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUser")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteViewInstance provides a mock function with given fields: viewName, version, instanceName
+func (_m *API) DeleteViewInstance(viewName string, version string, instanceName string) error {
+	ret := _m.Called(viewName, version, instanceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteViewInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(viewName, version, instanceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteViewPrivilege provides a mock function with given fields: viewName, version, instanceName, id
+func (_m *API) DeleteViewPrivilege(viewName string, version string, instanceName string, id int64) error {
+	ret := _m.Called(viewName, version, instanceName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteViewPrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int64) error); ok {
+		r0 = rf(viewName, version, instanceName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWidget provides a mock function with given fields: clusterName, id
+func (_m *API) DeleteWidget(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWidgetLayout provides a mock function with given fields: clusterName, id
+func (_m *API) DeleteWidgetLayout(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetLayout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DesiredConfigurationOnCluster provides a mock function with given fields: clusterName, configurationType
+func (_m *API) DesiredConfigurationOnCluster(clusterName string, configurationType string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DesiredConfigurationOnCluster")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(clusterName, configurationType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffBlueprintConfigs provides a mock function with given fields: blueprintName, clusterName
+func (_m *API) DiffBlueprintConfigs(blueprintName string, clusterName string) ([]client.ConfigDiff, error) {
+	ret := _m.Called(blueprintName, clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffBlueprintConfigs")
+	}
+
+	var r0 []client.ConfigDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.ConfigDiff, error)); ok {
+		return rf(blueprintName, clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.ConfigDiff); ok {
+		r0 = rf(blueprintName, clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(blueprintName, clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffClusterConfigs provides a mock function with given fields: fromClusterName, toClusterName
+func (_m *API) DiffClusterConfigs(fromClusterName string, toClusterName string) ([]client.ConfigDiff, error) {
+	ret := _m.Called(fromClusterName, toClusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffClusterConfigs")
+	}
+
+	var r0 []client.ConfigDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.ConfigDiff, error)); ok {
+		return rf(fromClusterName, toClusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.ConfigDiff); ok {
+		r0 = rf(fromClusterName, toClusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(fromClusterName, toClusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteCustomCommand provides a mock function with given fields: clusterName, serviceName, componentName, hosts, command, parameters
+func (_m *API) ExecuteCustomCommand(clusterName string, serviceName string, componentName string, hosts []string, command string, parameters map[string]string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, hosts, command, parameters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteCustomCommand")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, string, map[string]string) (*client.RequestTask, error)); ok {
+		return rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, string, map[string]string) *client.RequestTask); ok {
+		r0 = rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string, string, map[string]string) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportClusterConfigs provides a mock function with given fields: clusterName
+func (_m *API) ExportClusterConfigs(clusterName string) (*client.ClusterConfigs, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportClusterConfigs")
+	}
+
+	var r0 *client.ClusterConfigs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.ClusterConfigs, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.ClusterConfigs); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ClusterConfigs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportSecurityModel provides a mock function with given fields: clusterName
+func (_m *API) ExportSecurityModel(clusterName string) (*client.SecurityModel, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSecurityModel")
+	}
+
+	var r0 *client.SecurityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.SecurityModel, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.SecurityModel); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.SecurityModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinalizeUpgrade provides a mock function with given fields: clusterName, id
+func (_m *API) FinalizeUpgrade(clusterName string, id int) (*client.Upgrade, error) {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeUpgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.Upgrade); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetClusterHealthSummary provides a mock function with given fields: clusterName
+func (_m *API) GetClusterHealthSummary(clusterName string) (*client.ClusterHealthSummary, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterHealthSummary")
+	}
+
+	var r0 *client.ClusterHealthSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.ClusterHealthSummary, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.ClusterHealthSummary); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ClusterHealthSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Group provides a mock function with given fields: groupName, opts
+func (_m *API) Group(groupName string, opts ...client.RequestOption) (*client.Group, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, groupName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Group")
+	}
+
+	var r0 *client.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Group, error)); ok {
+		return rf(groupName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Group); ok {
+		r0 = rf(groupName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(groupName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupByID provides a mock function with given fields: id, opts
+func (_m *API) GroupByID(id string, opts ...client.RequestOption) (*client.Group, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupByID")
+	}
+
+	var r0 *client.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Group, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Group); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupPrivileges provides a mock function with given fields: groupName, opts
+func (_m *API) GroupPrivileges(groupName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, groupName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GroupPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(groupName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(groupName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(groupName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Groups provides a mock function with given fields: opts
+func (_m *API) Groups(opts ...client.RequestOption) ([]client.Group, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Groups")
+	}
+
+	var r0 []client.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Group, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Group); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Host provides a mock function with given fields: hostname, opts
+func (_m *API) Host(hostname string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Host")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostByID provides a mock function with given fields: id, opts
+func (_m *API) HostByID(id string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostByID")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostComponent provides a mock function with given fields: clusterName, hostname, componentName, opts
+func (_m *API) HostComponent(clusterName string, hostname string, componentName string, opts ...client.RequestOption) (*client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname, componentName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, componentName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostComponentByID provides a mock function with given fields: id, opts
+func (_m *API) HostComponentByID(id string, opts ...client.RequestOption) (*client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponentByID")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.HostComponent, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.HostComponent); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostComponentMetrics provides a mock function with given fields: clusterName, hostname, componentName, query
+func (_m *API) HostComponentMetrics(clusterName string, hostname string, componentName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, hostname, componentName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponentMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, hostname, componentName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, hostname, componentName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, hostname, componentName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostMetrics provides a mock function with given fields: clusterName, hostname, query
+func (_m *API) HostMetrics(clusterName string, hostname string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, hostname, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, hostname, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, hostname, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, hostname, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostOnCluster provides a mock function with given fields: clusterName, hostname, opts
+func (_m *API) HostOnCluster(clusterName string, hostname string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostOnCluster")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(clusterName, hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(clusterName, hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Hosts provides a mock function with given fields: opts
+func (_m *API) Hosts(opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hosts")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Host); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostsOnCluster provides a mock function with given fields: clusterName, opts
+func (_m *API) HostsOnCluster(clusterName string, opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostsOnCluster")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Host); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportClusterConfigs provides a mock function with given fields: clusterName, clusterConfigs, filter
+func (_m *API) ImportClusterConfigs(clusterName string, clusterConfigs *client.ClusterConfigs, filter *client.ConfigImportFilter) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, clusterConfigs, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportClusterConfigs")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, clusterConfigs, filter)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) *client.ChangeReport); ok {
+		r0 = rf(clusterName, clusterConfigs, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) error); ok {
+		r1 = rf(clusterName, clusterConfigs, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportSecurityModel provides a mock function with given fields: clusterName, securityModel, newUserPassword, deletePrivileges
+func (_m *API) ImportSecurityModel(clusterName string, securityModel *client.SecurityModel, newUserPassword string, deletePrivileges bool) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, securityModel, newUserPassword, deletePrivileges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSecurityModel")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.SecurityModel, string, bool) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.SecurityModel, string, bool) *client.ChangeReport); ok {
+		r0 = rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.SecurityModel, string, bool) error); ok {
+		r1 = rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallService provides a mock function with given fields: service
+func (_m *API) InstallService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListComponentsNeedingRestart provides a mock function with given fields: clusterName, opts
+func (_m *API) ListComponentsNeedingRestart(clusterName string, opts ...client.RequestOption) ([]client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComponentsNeedingRestart")
+	}
+
+	var r0 []client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.HostComponent, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.HostComponent); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHosts provides a mock function with given fields: clusterName, predicate, opts
+func (_m *API) ListHosts(clusterName string, predicate *client.Predicate, opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, predicate)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHosts")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Predicate, ...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(clusterName, predicate, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Predicate, ...client.RequestOption) []client.Host); ok {
+		r0 = rf(clusterName, predicate, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Predicate, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, predicate, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoggerLevel provides a mock function with given fields: clusterName, configurationType, logger
+func (_m *API) LoggerLevel(clusterName string, configurationType string, logger string) (*client.LoggerLevel, error) {
+	ret := _m.Called(clusterName, configurationType, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoggerLevel")
+	}
+
+	var r0 *client.LoggerLevel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.LoggerLevel, error)); ok {
+		return rf(clusterName, configurationType, logger)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.LoggerLevel); ok {
+		r0 = rf(clusterName, configurationType, logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.LoggerLevel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, configurationType, logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoggerLevels provides a mock function with given fields: clusterName, configurationType
+func (_m *API) LoggerLevels(clusterName string, configurationType string) ([]client.LoggerLevel, error) {
+	ret := _m.Called(clusterName, configurationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoggerLevels")
+	}
+
+	var r0 []client.LoggerLevel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.LoggerLevel, error)); ok {
+		return rf(clusterName, configurationType)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.LoggerLevel); ok {
+		r0 = rf(clusterName, configurationType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.LoggerLevel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(clusterName, configurationType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ManageKerberosOnCluster provides a mock function with given fields: cluster
+func (_m *API) ManageKerberosOnCluster(cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ManageKerberosOnCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster) (*client.Cluster, error)); ok {
+		return rf(cluster)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Cluster) *client.Cluster); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Cluster) error); ok {
+		r1 = rf(cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveMasterComponent provides a mock function with given fields: clusterName, componentName, sourceHostname, targetHostname, configUpdates
+func (_m *API) MoveMasterComponent(clusterName string, componentName string, sourceHostname string, targetHostname string, configUpdates map[string]map[string]string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMasterComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, map[string]map[string]string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, map[string]map[string]string) *client.HostComponent); ok {
+		r0 = rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, map[string]map[string]string) error); ok {
+		r1 = rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ping provides a mock function with given fields: timeout
+func (_m *API) Ping(timeout time.Duration) error {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Privilege provides a mock function with given fields: clusterName, id, opts
+func (_m *API) Privilege(clusterName string, id int64, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Privilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeByID provides a mock function with given fields: id, opts
+func (_m *API) PrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Privileges provides a mock function with given fields: clusterName, opts
+func (_m *API) Privileges(clusterName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Privileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProvisionClusterFromTemplate provides a mock function with given fields: name, jsonClusterTemplate
+func (_m *API) ProvisionClusterFromTemplate(name string, jsonClusterTemplate string) (*client.RequestTask, error) {
+	ret := _m.Called(name, jsonClusterTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionClusterFromTemplate")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.RequestTask, error)); ok {
+		return rf(name, jsonClusterTemplate)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.RequestTask); ok {
+		r0 = rf(name, jsonClusterTemplate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonClusterTemplate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QuickLinksProfile provides a mock function with no fields
+func (_m *API) QuickLinksProfile() (*client.QuickLinksProfile, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickLinksProfile")
+	}
+
+	var r0 *client.QuickLinksProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*client.QuickLinksProfile, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *client.QuickLinksProfile); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.QuickLinksProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshConfigs provides a mock function with given fields: clusterName, serviceName, componentName, hosts
+func (_m *API) RefreshConfigs(clusterName string, serviceName string, componentName string, hosts []string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, hosts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshConfigs")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) (*client.RequestTask, error)); ok {
+		return rf(clusterName, serviceName, componentName, hosts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) *client.RequestTask); ok {
+		r0 = rf(clusterName, serviceName, componentName, hosts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, hosts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshQueues provides a mock function with given fields: clusterName
+func (_m *API) RefreshQueues(clusterName string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshQueues")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.RequestTask, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.RequestTask); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegenerateKeytabs provides a mock function with given fields: clusterName, scope, onlyMissing
+func (_m *API) RegenerateKeytabs(clusterName string, scope *client.KeytabScope, onlyMissing bool) (int, error) {
+	ret := _m.Called(clusterName, scope, onlyMissing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateKeytabs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.KeytabScope, bool) (int, error)); ok {
+		return rf(clusterName, scope, onlyMissing)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.KeytabScope, bool) int); ok {
+		r0 = rf(clusterName, scope, onlyMissing)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.KeytabScope, bool) error); ok {
+		r1 = rf(clusterName, scope, onlyMissing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterHostOnCluster provides a mock function with given fields: clusterName, hostname, blueprintName, role
+func (_m *API) RegisterHostOnCluster(clusterName string, hostname string, blueprintName string, role string) (*client.Host, error) {
+	ret := _m.Called(clusterName, hostname, blueprintName, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterHostOnCluster")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*client.Host, error)); ok {
+		return rf(clusterName, hostname, blueprintName, role)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *client.Host); ok {
+		r0 = rf(clusterName, hostname, blueprintName, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, blueprintName, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveGroupMember provides a mock function with given fields: groupName, userName
+func (_m *API) RemoveGroupMember(groupName string, userName string) error {
+	ret := _m.Called(groupName, userName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveGroupMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(groupName, userName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenameCluster provides a mock function with given fields: oldClusterName, cluster
+func (_m *API) RenameCluster(oldClusterName string, cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(oldClusterName, cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Cluster) (*client.Cluster, error)); ok {
+		return rf(oldClusterName, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Cluster) *client.Cluster); ok {
+		r0 = rf(oldClusterName, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Cluster) error); ok {
+		r1 = rf(oldClusterName, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Repository provides a mock function with given fields: stackName, stackVersion, repositoryId, opts
+func (_m *API) Repository(stackName string, stackVersion string, repositoryId int, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, repositoryId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Repository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(stackName, stackVersion, repositoryId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(stackName, stackVersion, repositoryId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, repositoryId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryByID provides a mock function with given fields: id, opts
+func (_m *API) RepositoryByID(id string, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositoryByID")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request provides a mock function with given fields: clusterName, Id, opts
+func (_m *API) Request(clusterName string, Id int, opts ...client.RequestOption) (*client.RequestTask, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, Id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) (*client.RequestTask, error)); ok {
+		return rf(clusterName, Id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) *client.RequestTask); ok {
+		r0 = rf(clusterName, Id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, Id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestSchedule provides a mock function with given fields: clusterName, id, opts
+func (_m *API) RequestSchedule(clusterName string, id int64, opts ...client.RequestOption) (*client.RequestSchedule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestSchedule")
+	}
+
+	var r0 *client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.RequestSchedule, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.RequestSchedule); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestSchedules provides a mock function with given fields: clusterName, opts
+func (_m *API) RequestSchedules(clusterName string, opts ...client.RequestOption) ([]client.RequestSchedule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestSchedules")
+	}
+
+	var r0 []client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.RequestSchedule, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.RequestSchedule); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Requests provides a mock function with given fields: clusterName, opts
+func (_m *API) Requests(clusterName string, opts ...client.RequestOption) ([]client.RequestTask, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Requests")
+	}
+
+	var r0 []client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.RequestTask, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.RequestTask); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveQuickLinksProfile provides a mock function with given fields: quickLinksProfile
+func (_m *API) SaveQuickLinksProfile(quickLinksProfile *client.QuickLinksProfile) (*client.QuickLinksProfile, error) {
+	ret := _m.Called(quickLinksProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuickLinksProfile")
+	}
+
+	var r0 *client.QuickLinksProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.QuickLinksProfile) (*client.QuickLinksProfile, error)); ok {
+		return rf(quickLinksProfile)
+	}
+	if rf, ok := ret.Get(0).(func(*client.QuickLinksProfile) *client.QuickLinksProfile); ok {
+		r0 = rf(quickLinksProfile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.QuickLinksProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.QuickLinksProfile) error); ok {
+		r1 = rf(quickLinksProfile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchAlertTarget provides a mock function with given fields: name, opts
+func (_m *API) SearchAlertTarget(name string, opts ...client.RequestOption) (*client.AlertTarget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.AlertTarget, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.AlertTarget); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchConfigGroup provides a mock function with given fields: clusterName, groupName, opts
+func (_m *API) SearchConfigGroup(clusterName string, groupName string, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, groupName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(clusterName, groupName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(clusterName, groupName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, groupName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPrivilege provides a mock function with given fields: clusterName, permissionName, principalName, principalType, opts
+func (_m *API) SearchPrivilege(clusterName string, permissionName string, principalName string, principalType string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, permissionName, principalName, principalType)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(clusterName, permissionName, principalName, principalType, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(clusterName, permissionName, principalName, principalType, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, permissionName, principalName, principalType, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository provides a mock function with given fields: stackName, stackVersion, repositoryName, repositoryVersion, opts
+func (_m *API) SearchRepository(stackName string, stackVersion string, repositoryName string, repositoryVersion string, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, repositoryName, repositoryVersion)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchWidget provides a mock function with given fields: clusterName, widgetName, opts
+func (_m *API) SearchWidget(clusterName string, widgetName string, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, widgetName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(clusterName, widgetName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(clusterName, widgetName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, widgetName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchWidgetLayout provides a mock function with given fields: clusterName, layoutName, opts
+func (_m *API) SearchWidgetLayout(clusterName string, layoutName string, opts ...client.RequestOption) (*client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, layoutName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.WidgetLayout, error)); ok {
+		return rf(clusterName, layoutName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.WidgetLayout); ok {
+		r0 = rf(clusterName, layoutName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, layoutName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestCluster provides a mock function with given fields: request
+func (_m *API) SendRequestCluster(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestCluster")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestHostComponent provides a mock function with given fields: request
+func (_m *API) SendRequestHostComponent(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestHostComponent")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestService provides a mock function with given fields: request
+func (_m *API) SendRequestService(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestService")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerInfo provides a mock function with given fields: opts
+func (_m *API) ServerInfo(opts ...client.RequestOption) (*client.ServerInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerInfo")
+	}
+
+	var r0 *client.ServerInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) (*client.ServerInfo, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) *client.ServerInfo); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ServerInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerVersion provides a mock function with no fields
+func (_m *API) ServerVersion() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service provides a mock function with given fields: clusterName, serviceName, opts
+func (_m *API) Service(clusterName string, serviceName string, opts ...client.RequestOption) (*client.Service, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Service")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceByID provides a mock function with given fields: id, opts
+func (_m *API) ServiceByID(id string, opts ...client.RequestOption) (*client.Service, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceByID")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Service, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Service); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceMetrics provides a mock function with given fields: clusterName, serviceName, query
+func (_m *API) ServiceMetrics(clusterName string, serviceName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, serviceName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, serviceName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, serviceName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, serviceName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetClusterRecoveryEnabled provides a mock function with given fields: clusterName, enabled
+func (_m *API) SetClusterRecoveryEnabled(clusterName string, enabled bool) error {
+	ret := _m.Called(clusterName, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetClusterRecoveryEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(clusterName, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetComponentRecoveryEnabled provides a mock function with given fields: clusterName, serviceName, componentName, enabled
+func (_m *API) SetComponentRecoveryEnabled(clusterName string, serviceName string, componentName string, enabled bool) (*client.Component, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetComponentRecoveryEnabled")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) (*client.Component, error)); ok {
+		return rf(clusterName, serviceName, componentName, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) *client.Component); ok {
+		r0 = rf(clusterName, serviceName, componentName, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetComponentsRecoveryEnabled provides a mock function with given fields: clusterName, componentNames, enabled
+func (_m *API) SetComponentsRecoveryEnabled(clusterName string, componentNames []string, enabled bool) error {
+	ret := _m.Called(clusterName, componentNames, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetComponentsRecoveryEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, bool) error); ok {
+		r0 = rf(clusterName, componentNames, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetLoggerLevel provides a mock function with given fields: clusterName, configurationType, logger, level
+func (_m *API) SetLoggerLevel(clusterName string, configurationType string, logger string, level string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType, logger, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoggerLevel")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, logger, level)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, logger, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(clusterName, configurationType, logger, level)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Setting provides a mock function with given fields: name, opts
+func (_m *API) Setting(name string, opts ...client.RequestOption) (*client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Setting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Setting, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Setting); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SettingByID provides a mock function with given fields: id, opts
+func (_m *API) SettingByID(id string, opts ...client.RequestOption) (*client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettingByID")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Setting, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Setting); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Settings provides a mock function with given fields: opts
+func (_m *API) Settings(opts ...client.RequestOption) ([]client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Settings")
+	}
+
+	var r0 []client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Setting, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Setting); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SkipAndFinalizeUpgrade provides a mock function with given fields: clusterName, id
+func (_m *API) SkipAndFinalizeUpgrade(clusterName string, id int) (*client.Upgrade, error) {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipAndFinalizeUpgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.Upgrade); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackComponents provides a mock function with given fields: stackName, stackVersion, serviceName, opts
+func (_m *API) StackComponents(stackName string, stackVersion string, serviceName string, opts ...client.RequestOption) ([]client.StackComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackComponents")
+	}
+
+	var r0 []client.StackComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.StackComponent, error)); ok {
+		return rf(stackName, stackVersion, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.StackComponent); ok {
+		r0 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackConfigurations provides a mock function with given fields: stackName, stackVersion, serviceName, opts
+func (_m *API) StackConfigurations(stackName string, stackVersion string, serviceName string, opts ...client.RequestOption) ([]client.StackConfiguration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackConfigurations")
+	}
+
+	var r0 []client.StackConfiguration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.StackConfiguration, error)); ok {
+		return rf(stackName, stackVersion, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.StackConfiguration); ok {
+		r0 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackConfiguration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackDefaultConfigurations provides a mock function with given fields: stackName, stackVersion, serviceName
+func (_m *API) StackDefaultConfigurations(stackName string, stackVersion string, serviceName string) ([]client.Configuration, error) {
+	ret := _m.Called(stackName, stackVersion, serviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackDefaultConfigurations")
+	}
+
+	var r0 []client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]client.Configuration, error)); ok {
+		return rf(stackName, stackVersion, serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []client.Configuration); ok {
+		r0 = rf(stackName, stackVersion, serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackServices provides a mock function with given fields: stackName, stackVersion, opts
+func (_m *API) StackServices(stackName string, stackVersion string, opts ...client.RequestOption) ([]client.StackService, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackServices")
+	}
+
+	var r0 []client.StackService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.StackService, error)); ok {
+		return rf(stackName, stackVersion, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.StackService); ok {
+		r0 = rf(stackName, stackVersion, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackVersions provides a mock function with given fields: stackName, opts
+func (_m *API) StackVersions(stackName string, opts ...client.RequestOption) ([]client.StackVersion, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackVersions")
+	}
+
+	var r0 []client.StackVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.StackVersion, error)); ok {
+		return rf(stackName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.StackVersion); ok {
+		r0 = rf(stackName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stacks provides a mock function with given fields: opts
+func (_m *API) Stacks(opts ...client.RequestOption) ([]client.Stack, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stacks")
+	}
+
+	var r0 []client.Stack
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Stack, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Stack); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Stack)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartAllComponentsInHost provides a mock function with given fields: clusterName, hostname, disableMaintenanceMode
+func (_m *API) StartAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error {
+	ret := _m.Called(clusterName, hostname, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(clusterName, hostname, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartAllServices provides a mock function with given fields: cluster, disableMaintenanceMode
+func (_m *API) StartAllServices(cluster *client.Cluster, disableMaintenanceMode bool) error {
+	ret := _m.Called(cluster, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartAllServices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster, bool) error); ok {
+		r0 = rf(cluster, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *API) StartHostComponent(clusterName string, hostname string, componentName string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartService provides a mock function with given fields: clusterName, serviceName, disableMaintenanceMode
+func (_m *API) StartService(clusterName string, serviceName string, disableMaintenanceMode bool) (*client.Service, error) {
+	ret := _m.Called(clusterName, serviceName, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, disableMaintenanceMode)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, disableMaintenanceMode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(clusterName, serviceName, disableMaintenanceMode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopAllComponentsInHost provides a mock function with given fields: clusterName, hostname, enableMaintenanceMode, force
+func (_m *API) StopAllComponentsInHost(clusterName string, hostname string, enableMaintenanceMode bool, force bool) error {
+	ret := _m.Called(clusterName, hostname, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) error); ok {
+		r0 = rf(clusterName, hostname, enableMaintenanceMode, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StopAllServices provides a mock function with given fields: cluster, enableMaintenanceMode, force
+func (_m *API) StopAllServices(cluster *client.Cluster, enableMaintenanceMode bool, force bool) error {
+	ret := _m.Called(cluster, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopAllServices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster, bool, bool) error); ok {
+		r0 = rf(cluster, enableMaintenanceMode, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StopHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *API) StopHostComponent(clusterName string, hostname string, componentName string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopService provides a mock function with given fields: clusterName, serviceName, enableMaintenanceMode, force
+func (_m *API) StopService(clusterName string, serviceName string, enableMaintenanceMode bool, force bool) (*client.Service, error) {
+	ret := _m.Called(clusterName, serviceName, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, enableMaintenanceMode, force)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, enableMaintenanceMode, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool, bool) error); ok {
+		r1 = rf(clusterName, serviceName, enableMaintenanceMode, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAlertHistory provides a mock function with given fields: clusterName, callback, opts
+func (_m *API) StreamAlertHistory(clusterName string, callback func(*client.AlertHistory) error, opts ...client.RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, callback)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAlertHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(*client.AlertHistory) error, ...client.RequestOption) error); ok {
+		r0 = rf(clusterName, callback, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamHostsMetrics provides a mock function with given fields: clusterName, query, callback
+func (_m *API) StreamHostsMetrics(clusterName string, query *client.MetricQuery, callback func(string, []client.MetricSeries) error) error {
+	ret := _m.Called(clusterName, query, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamHostsMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *client.MetricQuery, func(string, []client.MetricSeries) error) error); ok {
+		r0 = rf(clusterName, query, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamTasks provides a mock function with given fields: clusterName, requestId, callback, opts
+func (_m *API) StreamTasks(clusterName string, requestId int, callback func(*client.Task) error, opts ...client.RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId, callback)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamTasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, func(*client.Task) error, ...client.RequestOption) error); ok {
+		r0 = rf(clusterName, requestId, callback, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: ctx, topics
+func (_m *API) Subscribe(ctx context.Context, topics ...string) (*client.EventSubscription, error) {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *client.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) (*client.EventSubscription, error)); ok {
+		return rf(ctx, topics...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) *client.EventSubscription); ok {
+		r0 = rf(ctx, topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, topics...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Supports provides a mock function with given fields: feature
+func (_m *API) Supports(feature client.Feature) bool {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Supports")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(client.Feature) bool); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Task provides a mock function with given fields: clusterName, requestId, taskId, opts
+func (_m *API) Task(clusterName string, requestId int, taskId int, opts ...client.RequestOption) (*client.Task, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId, taskId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Task")
+	}
+
+	var r0 *client.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int, ...client.RequestOption) (*client.Task, error)); ok {
+		return rf(clusterName, requestId, taskId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, ...client.RequestOption) *client.Task); ok {
+		r0 = rf(clusterName, requestId, taskId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, requestId, taskId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tasks provides a mock function with given fields: clusterName, requestId, opts
+func (_m *API) Tasks(clusterName string, requestId int, opts ...client.RequestOption) ([]client.Task, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tasks")
+	}
+
+	var r0 []client.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) ([]client.Task, error)); ok {
+		return rf(clusterName, requestId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) []client.Task); ok {
+		r0 = rf(clusterName, requestId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, requestId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAlertTarget provides a mock function with given fields: alertTarget
+func (_m *API) UpdateAlertTarget(alertTarget *client.AlertTarget) (*client.AlertTarget, error) {
+	ret := _m.Called(alertTarget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlertTarget")
+	}
+
+	var r0 *client.AlertTarget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) (*client.AlertTarget, error)); ok {
+		return rf(alertTarget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.AlertTarget) *client.AlertTarget); ok {
+		r0 = rf(alertTarget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.AlertTarget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.AlertTarget) error); ok {
+		r1 = rf(alertTarget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateConfigGroup provides a mock function with given fields: configGroup
+func (_m *API) UpdateConfigGroup(configGroup *client.ConfigGroup) (*client.ConfigGroup, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ConfigGroup, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ConfigGroup); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateConfigurationProperties provides a mock function with given fields: clusterName, configurationType, properties, removedProperties, note
+func (_m *API) UpdateConfigurationProperties(clusterName string, configurationType string, properties map[string]string, removedProperties []string, note string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType, properties, removedProperties, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigurationProperties")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, []string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, properties, removedProperties, note)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, []string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, properties, removedProperties, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string, []string, string) error); ok {
+		r1 = rf(clusterName, configurationType, properties, removedProperties, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCredential provides a mock function with given fields: credential
+func (_m *API) UpdateCredential(credential *client.Credential) (*client.Credential, error) {
+	ret := _m.Called(credential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCredential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Credential) (*client.Credential, error)); ok {
+		return rf(credential)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Credential) *client.Credential); ok {
+		r0 = rf(credential)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Credential) error); ok {
+		r1 = rf(credential)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHost provides a mock function with given fields: host
+func (_m *API) UpdateHost(host *client.Host) (*client.Host, error) {
+	ret := _m.Called(host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHost")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Host) (*client.Host, error)); ok {
+		return rf(host)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Host) *client.Host); ok {
+		r0 = rf(host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Host) error); ok {
+		r1 = rf(host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHostComponent provides a mock function with given fields: hostComponent
+func (_m *API) UpdateHostComponent(hostComponent *client.HostComponent) (*client.HostComponent, error) {
+	ret := _m.Called(hostComponent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) (*client.HostComponent, error)); ok {
+		return rf(hostComponent)
+	}
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) *client.HostComponent); ok {
+		r0 = rf(hostComponent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.HostComponent) error); ok {
+		r1 = rf(hostComponent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *API) UpdatePrivilege(clusterName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRepository provides a mock function with given fields: repository
+func (_m *API) UpdateRepository(repository *client.Repository) (*client.Repository, error) {
+	ret := _m.Called(repository)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Repository) (*client.Repository, error)); ok {
+		return rf(repository)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Repository) *client.Repository); ok {
+		r0 = rf(repository)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Repository) error); ok {
+		r1 = rf(repository)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateService provides a mock function with given fields: service
+func (_m *API) UpdateService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSetting provides a mock function with given fields: setting
+func (_m *API) UpdateSetting(setting *client.Setting) (*client.Setting, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSetting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.Setting, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.Setting); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateViewInstance provides a mock function with given fields: viewInstance
+func (_m *API) UpdateViewInstance(viewInstance *client.ViewInstance) (*client.ViewInstance, error) {
+	ret := _m.Called(viewInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) (*client.ViewInstance, error)); ok {
+		return rf(viewInstance)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) *client.ViewInstance); ok {
+		r0 = rf(viewInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ViewInstance) error); ok {
+		r1 = rf(viewInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWidget provides a mock function with given fields: widget
+func (_m *API) UpdateWidget(widget *client.Widget) (*client.Widget, error) {
+	ret := _m.Called(widget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Widget) (*client.Widget, error)); ok {
+		return rf(widget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Widget) *client.Widget); ok {
+		r0 = rf(widget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Widget) error); ok {
+		r1 = rf(widget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWidgetLayout provides a mock function with given fields: widgetLayout
+func (_m *API) UpdateWidgetLayout(widgetLayout *client.WidgetLayout) (*client.WidgetLayout, error) {
+	ret := _m.Called(widgetLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) (*client.WidgetLayout, error)); ok {
+		return rf(widgetLayout)
+	}
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) *client.WidgetLayout); ok {
+		r0 = rf(widgetLayout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.WidgetLayout) error); ok {
+		r1 = rf(widgetLayout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upgrade provides a mock function with given fields: clusterName, id, opts
+func (_m *API) Upgrade(clusterName string, id int, opts ...client.RequestOption) (*client.Upgrade, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) *client.Upgrade); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upgrades provides a mock function with given fields: clusterName, opts
+func (_m *API) Upgrades(clusterName string, opts ...client.RequestOption) ([]client.Upgrade, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrades")
+	}
+
+	var r0 []client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Upgrade, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Upgrade); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// User provides a mock function with given fields: userName, opts
+func (_m *API) User(userName string, opts ...client.RequestOption) (*client.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, userName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for User")
+	}
+
+	var r0 *client.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.User, error)); ok {
+		return rf(userName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.User); ok {
+		r0 = rf(userName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(userName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserByID provides a mock function with given fields: id, opts
+func (_m *API) UserByID(id string, opts ...client.RequestOption) (*client.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserByID")
+	}
+
+	var r0 *client.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.User, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.User); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserPrivileges provides a mock function with given fields: userName, opts
+func (_m *API) UserPrivileges(userName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, userName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(userName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(userName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(userName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Users provides a mock function with given fields: opts
+func (_m *API) Users(opts ...client.RequestOption) ([]client.User, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Users")
+	}
+
+	var r0 []client.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.User, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.User); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstance provides a mock function with given fields: viewName, version, instanceName, opts
+func (_m *API) ViewInstance(viewName string, version string, instanceName string, opts ...client.RequestOption) (*client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version, instanceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.ViewInstance, error)); ok {
+		return rf(viewName, version, instanceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.ViewInstance); ok {
+		r0 = rf(viewName, version, instanceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, instanceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstanceByID provides a mock function with given fields: id, opts
+func (_m *API) ViewInstanceByID(id string, opts ...client.RequestOption) (*client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstanceByID")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.ViewInstance, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.ViewInstance); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstances provides a mock function with given fields: viewName, version, opts
+func (_m *API) ViewInstances(viewName string, version string, opts ...client.RequestOption) ([]client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstances")
+	}
+
+	var r0 []client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.ViewInstance, error)); ok {
+		return rf(viewName, version, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.ViewInstance); ok {
+		r0 = rf(viewName, version, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewPrivilegeByID provides a mock function with given fields: id, opts
+func (_m *API) ViewPrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewPrivileges provides a mock function with given fields: viewName, version, instanceName, opts
+func (_m *API) ViewPrivileges(viewName string, version string, instanceName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version, instanceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(viewName, version, instanceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(viewName, version, instanceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, instanceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForHostComponentState provides a mock function with given fields: ctx, clusterName, hostname, componentName, state
+func (_m *API) WaitForHostComponentState(ctx context.Context, clusterName string, hostname string, componentName string, state string) (*client.HostComponent, error) {
+	ret := _m.Called(ctx, clusterName, hostname, componentName, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForHostComponentState")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*client.HostComponent, error)); ok {
+		return rf(ctx, clusterName, hostname, componentName, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *client.HostComponent); ok {
+		r0 = rf(ctx, clusterName, hostname, componentName, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, clusterName, hostname, componentName, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForServiceState provides a mock function with given fields: ctx, clusterName, serviceName, state
+func (_m *API) WaitForServiceState(ctx context.Context, clusterName string, serviceName string, state string) (*client.Service, error) {
+	ret := _m.Called(ctx, clusterName, serviceName, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForServiceState")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*client.Service, error)); ok {
+		return rf(ctx, clusterName, serviceName, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *client.Service); ok {
+		r0 = rf(ctx, clusterName, serviceName, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, clusterName, serviceName, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Widget provides a mock function with given fields: clusterName, id, opts
+func (_m *API) Widget(clusterName string, id int64, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Widget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetByID provides a mock function with given fields: id, opts
+func (_m *API) WidgetByID(id string, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetByID")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetLayout provides a mock function with given fields: clusterName, id, opts
+func (_m *API) WidgetLayout(clusterName string, id int64, opts ...client.RequestOption) (*client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.WidgetLayout, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.WidgetLayout); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetLayouts provides a mock function with given fields: clusterName, opts
+func (_m *API) WidgetLayouts(clusterName string, opts ...client.RequestOption) ([]client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetLayouts")
+	}
+
+	var r0 []client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.WidgetLayout, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.WidgetLayout); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Widgets provides a mock function with given fields: clusterName, opts
+func (_m *API) Widgets(clusterName string, opts ...client.RequestOption) ([]client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Widgets")
+	}
+
+	var r0 []client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Widget, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Widget); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAPI creates a new instance of API. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *API {
+	mock := &API{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
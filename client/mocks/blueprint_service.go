@@ -0,0 +1,150 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BlueprintService is an autogenerated mock type for the BlueprintService type
+type BlueprintService struct {
+	mock.Mock
+}
+
+// Blueprint provides a mock function with given fields: name, opts
+func (_m *BlueprintService) Blueprint(name string, opts ...client.RequestOption) (*client.Blueprint, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Blueprint")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Blueprint, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Blueprint); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlueprintByID provides a mock function with given fields: id, opts
+func (_m *BlueprintService) BlueprintByID(id string, opts ...client.RequestOption) (*client.Blueprint, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BlueprintByID")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Blueprint, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Blueprint); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBlueprint provides a mock function with given fields: name, jsonBlueprint
+func (_m *BlueprintService) CreateBlueprint(name string, jsonBlueprint string) (*client.Blueprint, error) {
+	ret := _m.Called(name, jsonBlueprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBlueprint")
+	}
+
+	var r0 *client.Blueprint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Blueprint, error)); ok {
+		return rf(name, jsonBlueprint)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Blueprint); ok {
+		r0 = rf(name, jsonBlueprint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Blueprint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonBlueprint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBlueprint provides a mock function with given fields: name
+func (_m *BlueprintService) DeleteBlueprint(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlueprint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBlueprintService creates a new instance of BlueprintService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBlueprintService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BlueprintService {
+	mock := &BlueprintService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
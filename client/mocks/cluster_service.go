@@ -0,0 +1,328 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ClusterService is an autogenerated mock type for the ClusterService type
+type ClusterService struct {
+	mock.Mock
+}
+
+// Cluster provides a mock function with given fields: clusterName, opts
+func (_m *ClusterService) Cluster(clusterName string, opts ...client.RequestOption) (*client.Cluster, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Cluster, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Cluster); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClusterByID provides a mock function with given fields: id, opts
+func (_m *ClusterService) ClusterByID(id string, opts ...client.RequestOption) (*client.Cluster, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClusterByID")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Cluster, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Cluster); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCluster provides a mock function with given fields: cluster
+func (_m *ClusterService) CreateCluster(cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster) (*client.Cluster, error)); ok {
+		return rf(cluster)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Cluster) *client.Cluster); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Cluster) error); ok {
+		r1 = rf(cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateClusterFromTemplate provides a mock function with given fields: name, jsonClusterTemplate
+func (_m *ClusterService) CreateClusterFromTemplate(name string, jsonClusterTemplate string) (*client.Cluster, error) {
+	ret := _m.Called(name, jsonClusterTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClusterFromTemplate")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Cluster, error)); ok {
+		return rf(name, jsonClusterTemplate)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Cluster); ok {
+		r0 = rf(name, jsonClusterTemplate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonClusterTemplate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCluster provides a mock function with given fields: clusterName
+func (_m *ClusterService) DeleteCluster(clusterName string) error {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCluster")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(clusterName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ManageKerberosOnCluster provides a mock function with given fields: cluster
+func (_m *ClusterService) ManageKerberosOnCluster(cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ManageKerberosOnCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster) (*client.Cluster, error)); ok {
+		return rf(cluster)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Cluster) *client.Cluster); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Cluster) error); ok {
+		r1 = rf(cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ProvisionClusterFromTemplate provides a mock function with given fields: name, jsonClusterTemplate
+func (_m *ClusterService) ProvisionClusterFromTemplate(name string, jsonClusterTemplate string) (*client.RequestTask, error) {
+	ret := _m.Called(name, jsonClusterTemplate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionClusterFromTemplate")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.RequestTask, error)); ok {
+		return rf(name, jsonClusterTemplate)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.RequestTask); ok {
+		r0 = rf(name, jsonClusterTemplate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(name, jsonClusterTemplate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegenerateKeytabs provides a mock function with given fields: clusterName, scope, onlyMissing
+func (_m *ClusterService) RegenerateKeytabs(clusterName string, scope *client.KeytabScope, onlyMissing bool) (int, error) {
+	ret := _m.Called(clusterName, scope, onlyMissing)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateKeytabs")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.KeytabScope, bool) (int, error)); ok {
+		return rf(clusterName, scope, onlyMissing)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.KeytabScope, bool) int); ok {
+		r0 = rf(clusterName, scope, onlyMissing)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.KeytabScope, bool) error); ok {
+		r1 = rf(clusterName, scope, onlyMissing)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RenameCluster provides a mock function with given fields: oldClusterName, cluster
+func (_m *ClusterService) RenameCluster(oldClusterName string, cluster *client.Cluster) (*client.Cluster, error) {
+	ret := _m.Called(oldClusterName, cluster)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Cluster) (*client.Cluster, error)); ok {
+		return rf(oldClusterName, cluster)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Cluster) *client.Cluster); ok {
+		r0 = rf(oldClusterName, cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Cluster) error); ok {
+		r1 = rf(oldClusterName, cluster)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestCluster provides a mock function with given fields: request
+func (_m *ClusterService) SendRequestCluster(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestCluster")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewClusterService creates a new instance of ClusterService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClusterService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClusterService {
+	mock := &ClusterService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,371 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ComponentService is an autogenerated mock type for the ComponentService type
+type ComponentService struct {
+	mock.Mock
+}
+
+// ClusterRecoveryEnabled provides a mock function with given fields: clusterName
+func (_m *ComponentService) ClusterRecoveryEnabled(clusterName string) (bool, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClusterRecoveryEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(clusterName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Component provides a mock function with given fields: clusterName, serviceName, componentName, opts
+func (_m *ComponentService) Component(clusterName string, serviceName string, componentName string, opts ...client.RequestOption) (*client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName, componentName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Component")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.Component, error)); ok {
+		return rf(clusterName, serviceName, componentName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.Component); ok {
+		r0 = rf(clusterName, serviceName, componentName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentByID provides a mock function with given fields: id, opts
+func (_m *ComponentService) ComponentByID(id string, opts ...client.RequestOption) (*client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentByID")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Component, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Component); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ComponentsRecovery provides a mock function with given fields: clusterName, opts
+func (_m *ComponentService) ComponentsRecovery(clusterName string, opts ...client.RequestOption) ([]client.Component, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentsRecovery")
+	}
+
+	var r0 []client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Component, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Component); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateComponent provides a mock function with given fields: component
+func (_m *ComponentService) CreateComponent(component *client.Component) (*client.Component, error) {
+	ret := _m.Called(component)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateComponent")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Component) (*client.Component, error)); ok {
+		return rf(component)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Component) *client.Component); ok {
+		r0 = rf(component)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Component) error); ok {
+		r1 = rf(component)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteComponent provides a mock function with given fields: clusterName, serviceName, componentName
+func (_m *ComponentService) DeleteComponent(clusterName string, serviceName string, componentName string) error {
+	ret := _m.Called(clusterName, serviceName, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteComponent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(clusterName, serviceName, componentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExecuteCustomCommand provides a mock function with given fields: clusterName, serviceName, componentName, hosts, command, parameters
+func (_m *ComponentService) ExecuteCustomCommand(clusterName string, serviceName string, componentName string, hosts []string, command string, parameters map[string]string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, hosts, command, parameters)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteCustomCommand")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, string, map[string]string) (*client.RequestTask, error)); ok {
+		return rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string, string, map[string]string) *client.RequestTask); ok {
+		r0 = rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string, string, map[string]string) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, hosts, command, parameters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshConfigs provides a mock function with given fields: clusterName, serviceName, componentName, hosts
+func (_m *ComponentService) RefreshConfigs(clusterName string, serviceName string, componentName string, hosts []string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, hosts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshConfigs")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) (*client.RequestTask, error)); ok {
+		return rf(clusterName, serviceName, componentName, hosts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, []string) *client.RequestTask); ok {
+		r0 = rf(clusterName, serviceName, componentName, hosts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, []string) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, hosts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshQueues provides a mock function with given fields: clusterName
+func (_m *ComponentService) RefreshQueues(clusterName string) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshQueues")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.RequestTask, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.RequestTask); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetClusterRecoveryEnabled provides a mock function with given fields: clusterName, enabled
+func (_m *ComponentService) SetClusterRecoveryEnabled(clusterName string, enabled bool) error {
+	ret := _m.Called(clusterName, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetClusterRecoveryEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, bool) error); ok {
+		r0 = rf(clusterName, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetComponentRecoveryEnabled provides a mock function with given fields: clusterName, serviceName, componentName, enabled
+func (_m *ComponentService) SetComponentRecoveryEnabled(clusterName string, serviceName string, componentName string, enabled bool) (*client.Component, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetComponentRecoveryEnabled")
+	}
+
+	var r0 *client.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) (*client.Component, error)); ok {
+		return rf(clusterName, serviceName, componentName, enabled)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, bool) *client.Component); ok {
+		r0 = rf(clusterName, serviceName, componentName, enabled)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, bool) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetComponentsRecoveryEnabled provides a mock function with given fields: clusterName, componentNames, enabled
+func (_m *ComponentService) SetComponentsRecoveryEnabled(clusterName string, componentNames []string, enabled bool) error {
+	ret := _m.Called(clusterName, componentNames, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetComponentsRecoveryEnabled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, bool) error); ok {
+		r0 = rf(clusterName, componentNames, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewComponentService creates a new instance of ComponentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewComponentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ComponentService {
+	mock := &ComponentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
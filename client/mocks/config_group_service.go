@@ -0,0 +1,284 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfigGroupService is an autogenerated mock type for the ConfigGroupService type
+type ConfigGroupService struct {
+	mock.Mock
+}
+
+// ApplyConfigGroup provides a mock function with given fields: configGroup
+func (_m *ConfigGroupService) ApplyConfigGroup(configGroup *client.ConfigGroup) (*client.ChangeReport, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyConfigGroup")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ChangeReport, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ChangeReport); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroup provides a mock function with given fields: clusterName, id, opts
+func (_m *ConfigGroupService) ConfigGroup(clusterName string, id int64, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroupByID provides a mock function with given fields: id, opts
+func (_m *ConfigGroupService) ConfigGroupByID(id string, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroupByID")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigGroups provides a mock function with given fields: clusterName, opts
+func (_m *ConfigGroupService) ConfigGroups(clusterName string, opts ...client.RequestOption) ([]client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigGroups")
+	}
+
+	var r0 []client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.ConfigGroup, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.ConfigGroup); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateConfigGroup provides a mock function with given fields: configGroup
+func (_m *ConfigGroupService) CreateConfigGroup(configGroup *client.ConfigGroup) (*client.ConfigGroup, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ConfigGroup, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ConfigGroup); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteConfigGroup provides a mock function with given fields: clusterName, id
+func (_m *ConfigGroupService) DeleteConfigGroup(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConfigGroup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchConfigGroup provides a mock function with given fields: clusterName, groupName, opts
+func (_m *ConfigGroupService) SearchConfigGroup(clusterName string, groupName string, opts ...client.RequestOption) (*client.ConfigGroup, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, groupName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.ConfigGroup, error)); ok {
+		return rf(clusterName, groupName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.ConfigGroup); ok {
+		r0 = rf(clusterName, groupName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, groupName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateConfigGroup provides a mock function with given fields: configGroup
+func (_m *ConfigGroupService) UpdateConfigGroup(configGroup *client.ConfigGroup) (*client.ConfigGroup, error) {
+	ret := _m.Called(configGroup)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigGroup")
+	}
+
+	var r0 *client.ConfigGroup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) (*client.ConfigGroup, error)); ok {
+		return rf(configGroup)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ConfigGroup) *client.ConfigGroup); ok {
+		r0 = rf(configGroup)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ConfigGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ConfigGroup) error); ok {
+		r1 = rf(configGroup)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewConfigGroupService creates a new instance of ConfigGroupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigGroupService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfigGroupService {
+	mock := &ConfigGroupService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,402 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ConfigurationService is an autogenerated mock type for the ConfigurationService type
+type ConfigurationService struct {
+	mock.Mock
+}
+
+// ConfigurationByID provides a mock function with given fields: id, opts
+func (_m *ConfigurationService) ConfigurationByID(id string, opts ...client.RequestOption) (*client.Configuration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigurationByID")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Configuration, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Configuration); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfigurationOnCluster provides a mock function with given fields: clusterName, configurationType, tag, opts
+func (_m *ConfigurationService) ConfigurationOnCluster(clusterName string, configurationType string, tag string, opts ...client.RequestOption) (*client.Configuration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, configurationType, tag)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigurationOnCluster")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, tag, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, tag, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, configurationType, tag, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateConfigurationOnCluster provides a mock function with given fields: clusterName, configuration
+func (_m *ConfigurationService) CreateConfigurationOnCluster(clusterName string, configuration *client.Configuration) (*client.Cluster, error) {
+	ret := _m.Called(clusterName, configuration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConfigurationOnCluster")
+	}
+
+	var r0 *client.Cluster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Configuration) (*client.Cluster, error)); ok {
+		return rf(clusterName, configuration)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Configuration) *client.Cluster); ok {
+		r0 = rf(clusterName, configuration)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Cluster)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Configuration) error); ok {
+		r1 = rf(clusterName, configuration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DesiredConfigurationOnCluster provides a mock function with given fields: clusterName, configurationType
+func (_m *ConfigurationService) DesiredConfigurationOnCluster(clusterName string, configurationType string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DesiredConfigurationOnCluster")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(clusterName, configurationType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffBlueprintConfigs provides a mock function with given fields: blueprintName, clusterName
+func (_m *ConfigurationService) DiffBlueprintConfigs(blueprintName string, clusterName string) ([]client.ConfigDiff, error) {
+	ret := _m.Called(blueprintName, clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffBlueprintConfigs")
+	}
+
+	var r0 []client.ConfigDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.ConfigDiff, error)); ok {
+		return rf(blueprintName, clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.ConfigDiff); ok {
+		r0 = rf(blueprintName, clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(blueprintName, clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiffClusterConfigs provides a mock function with given fields: fromClusterName, toClusterName
+func (_m *ConfigurationService) DiffClusterConfigs(fromClusterName string, toClusterName string) ([]client.ConfigDiff, error) {
+	ret := _m.Called(fromClusterName, toClusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffClusterConfigs")
+	}
+
+	var r0 []client.ConfigDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.ConfigDiff, error)); ok {
+		return rf(fromClusterName, toClusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.ConfigDiff); ok {
+		r0 = rf(fromClusterName, toClusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ConfigDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(fromClusterName, toClusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportClusterConfigs provides a mock function with given fields: clusterName
+func (_m *ConfigurationService) ExportClusterConfigs(clusterName string) (*client.ClusterConfigs, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportClusterConfigs")
+	}
+
+	var r0 *client.ClusterConfigs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.ClusterConfigs, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.ClusterConfigs); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ClusterConfigs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportClusterConfigs provides a mock function with given fields: clusterName, clusterConfigs, filter
+func (_m *ConfigurationService) ImportClusterConfigs(clusterName string, clusterConfigs *client.ClusterConfigs, filter *client.ConfigImportFilter) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, clusterConfigs, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportClusterConfigs")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, clusterConfigs, filter)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) *client.ChangeReport); ok {
+		r0 = rf(clusterName, clusterConfigs, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.ClusterConfigs, *client.ConfigImportFilter) error); ok {
+		r1 = rf(clusterName, clusterConfigs, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoggerLevel provides a mock function with given fields: clusterName, configurationType, logger
+func (_m *ConfigurationService) LoggerLevel(clusterName string, configurationType string, logger string) (*client.LoggerLevel, error) {
+	ret := _m.Called(clusterName, configurationType, logger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoggerLevel")
+	}
+
+	var r0 *client.LoggerLevel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.LoggerLevel, error)); ok {
+		return rf(clusterName, configurationType, logger)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.LoggerLevel); ok {
+		r0 = rf(clusterName, configurationType, logger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.LoggerLevel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, configurationType, logger)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoggerLevels provides a mock function with given fields: clusterName, configurationType
+func (_m *ConfigurationService) LoggerLevels(clusterName string, configurationType string) ([]client.LoggerLevel, error) {
+	ret := _m.Called(clusterName, configurationType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoggerLevels")
+	}
+
+	var r0 []client.LoggerLevel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]client.LoggerLevel, error)); ok {
+		return rf(clusterName, configurationType)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []client.LoggerLevel); ok {
+		r0 = rf(clusterName, configurationType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.LoggerLevel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(clusterName, configurationType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLoggerLevel provides a mock function with given fields: clusterName, configurationType, logger, level
+func (_m *ConfigurationService) SetLoggerLevel(clusterName string, configurationType string, logger string, level string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType, logger, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLoggerLevel")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, logger, level)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, logger, level)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(clusterName, configurationType, logger, level)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateConfigurationProperties provides a mock function with given fields: clusterName, configurationType, properties, removedProperties, note
+func (_m *ConfigurationService) UpdateConfigurationProperties(clusterName string, configurationType string, properties map[string]string, removedProperties []string, note string) (*client.Configuration, error) {
+	ret := _m.Called(clusterName, configurationType, properties, removedProperties, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfigurationProperties")
+	}
+
+	var r0 *client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, []string, string) (*client.Configuration, error)); ok {
+		return rf(clusterName, configurationType, properties, removedProperties, note)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, []string, string) *client.Configuration); ok {
+		r0 = rf(clusterName, configurationType, properties, removedProperties, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, map[string]string, []string, string) error); ok {
+		r1 = rf(clusterName, configurationType, properties, removedProperties, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewConfigurationService creates a new instance of ConfigurationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigurationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConfigurationService {
+	mock := &ConfigurationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
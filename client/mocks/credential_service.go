@@ -0,0 +1,180 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CredentialService is an autogenerated mock type for the CredentialService type
+type CredentialService struct {
+	mock.Mock
+}
+
+// CreateCredential provides a mock function with given fields: credential
+func (_m *CredentialService) CreateCredential(credential *client.Credential) (*client.Credential, error) {
+	ret := _m.Called(credential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCredential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Credential) (*client.Credential, error)); ok {
+		return rf(credential)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Credential) *client.Credential); ok {
+		r0 = rf(credential)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Credential) error); ok {
+		r1 = rf(credential)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Credential provides a mock function with given fields: clusterName, alias, opts
+func (_m *CredentialService) Credential(clusterName string, alias string, opts ...client.RequestOption) (*client.Credential, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, alias)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Credential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Credential, error)); ok {
+		return rf(clusterName, alias, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Credential); ok {
+		r0 = rf(clusterName, alias, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, alias, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Credentials provides a mock function with given fields: clusterName, opts
+func (_m *CredentialService) Credentials(clusterName string, opts ...client.RequestOption) ([]client.Credential, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Credentials")
+	}
+
+	var r0 []client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Credential, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Credential); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCredential provides a mock function with given fields: clusterName, alias
+func (_m *CredentialService) DeleteCredential(clusterName string, alias string) error {
+	ret := _m.Called(clusterName, alias)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCredential")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, alias)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCredential provides a mock function with given fields: credential
+func (_m *CredentialService) UpdateCredential(credential *client.Credential) (*client.Credential, error) {
+	ret := _m.Called(credential)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCredential")
+	}
+
+	var r0 *client.Credential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Credential) (*client.Credential, error)); ok {
+		return rf(credential)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Credential) *client.Credential); ok {
+		r0 = rf(credential)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Credential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Credential) error); ok {
+		r1 = rf(credential)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCredentialService creates a new instance of CredentialService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCredentialService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CredentialService {
+	mock := &CredentialService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
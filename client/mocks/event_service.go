@@ -0,0 +1,66 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventService is an autogenerated mock type for the EventService type
+type EventService struct {
+	mock.Mock
+}
+
+// Subscribe provides a mock function with given fields: ctx, topics
+func (_m *EventService) Subscribe(ctx context.Context, topics ...string) (*client.EventSubscription, error) {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *client.EventSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) (*client.EventSubscription, error)); ok {
+		return rf(ctx, topics...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) *client.EventSubscription); ok {
+		r0 = rf(ctx, topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.EventSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ...string) error); ok {
+		r1 = rf(ctx, topics...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEventService creates a new instance of EventService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventService {
+	mock := &EventService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
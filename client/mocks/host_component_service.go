@@ -0,0 +1,368 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HostComponentService is an autogenerated mock type for the HostComponentService type
+type HostComponentService struct {
+	mock.Mock
+}
+
+// CreateHostComponent provides a mock function with given fields: hostComponent
+func (_m *HostComponentService) CreateHostComponent(hostComponent *client.HostComponent) (*client.HostComponent, error) {
+	ret := _m.Called(hostComponent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) (*client.HostComponent, error)); ok {
+		return rf(hostComponent)
+	}
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) *client.HostComponent); ok {
+		r0 = rf(hostComponent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.HostComponent) error); ok {
+		r1 = rf(hostComponent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *HostComponentService) DeleteHostComponent(clusterName string, hostname string, componentName string) error {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHostComponent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HostComponent provides a mock function with given fields: clusterName, hostname, componentName, opts
+func (_m *HostComponentService) HostComponent(clusterName string, hostname string, componentName string, opts ...client.RequestOption) (*client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname, componentName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, componentName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostComponentByID provides a mock function with given fields: id, opts
+func (_m *HostComponentService) HostComponentByID(id string, opts ...client.RequestOption) (*client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponentByID")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.HostComponent, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.HostComponent); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListComponentsNeedingRestart provides a mock function with given fields: clusterName, opts
+func (_m *HostComponentService) ListComponentsNeedingRestart(clusterName string, opts ...client.RequestOption) ([]client.HostComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListComponentsNeedingRestart")
+	}
+
+	var r0 []client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.HostComponent, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.HostComponent); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MoveMasterComponent provides a mock function with given fields: clusterName, componentName, sourceHostname, targetHostname, configUpdates
+func (_m *HostComponentService) MoveMasterComponent(clusterName string, componentName string, sourceHostname string, targetHostname string, configUpdates map[string]map[string]string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveMasterComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, map[string]map[string]string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, map[string]map[string]string) *client.HostComponent); ok {
+		r0 = rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, map[string]map[string]string) error); ok {
+		r1 = rf(clusterName, componentName, sourceHostname, targetHostname, configUpdates)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestHostComponent provides a mock function with given fields: request
+func (_m *HostComponentService) SendRequestHostComponent(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestHostComponent")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *HostComponentService) StartHostComponent(clusterName string, hostname string, componentName string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopHostComponent provides a mock function with given fields: clusterName, hostname, componentName
+func (_m *HostComponentService) StopHostComponent(clusterName string, hostname string, componentName string) (*client.HostComponent, error) {
+	ret := _m.Called(clusterName, hostname, componentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*client.HostComponent, error)); ok {
+		return rf(clusterName, hostname, componentName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *client.HostComponent); ok {
+		r0 = rf(clusterName, hostname, componentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, componentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHostComponent provides a mock function with given fields: hostComponent
+func (_m *HostComponentService) UpdateHostComponent(hostComponent *client.HostComponent) (*client.HostComponent, error) {
+	ret := _m.Called(hostComponent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHostComponent")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) (*client.HostComponent, error)); ok {
+		return rf(hostComponent)
+	}
+	if rf, ok := ret.Get(0).(func(*client.HostComponent) *client.HostComponent); ok {
+		r0 = rf(hostComponent)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.HostComponent) error); ok {
+		r1 = rf(hostComponent)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForHostComponentState provides a mock function with given fields: ctx, clusterName, hostname, componentName, state
+func (_m *HostComponentService) WaitForHostComponentState(ctx context.Context, clusterName string, hostname string, componentName string, state string) (*client.HostComponent, error) {
+	ret := _m.Called(ctx, clusterName, hostname, componentName, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForHostComponentState")
+	}
+
+	var r0 *client.HostComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*client.HostComponent, error)); ok {
+		return rf(ctx, clusterName, hostname, componentName, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) *client.HostComponent); ok {
+		r0 = rf(ctx, clusterName, hostname, componentName, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.HostComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, clusterName, hostname, componentName, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewHostComponentService creates a new instance of HostComponentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHostComponentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HostComponentService {
+	mock := &HostComponentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,411 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HostService is an autogenerated mock type for the HostService type
+type HostService struct {
+	mock.Mock
+}
+
+// CreateHost provides a mock function with given fields: host
+func (_m *HostService) CreateHost(host *client.Host) (*client.Host, error) {
+	ret := _m.Called(host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateHost")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Host) (*client.Host, error)); ok {
+		return rf(host)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Host) *client.Host); ok {
+		r0 = rf(host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Host) error); ok {
+		r1 = rf(host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAllComponentsInHost provides a mock function with given fields: clusterName, hostname, disableMaintenanceMode
+func (_m *HostService) DeleteAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error {
+	ret := _m.Called(clusterName, hostname, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(clusterName, hostname, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteHost provides a mock function with given fields: clusterName, hostname
+func (_m *HostService) DeleteHost(clusterName string, hostname string) error {
+	ret := _m.Called(clusterName, hostname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, hostname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Host provides a mock function with given fields: hostname, opts
+func (_m *HostService) Host(hostname string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Host")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostByID provides a mock function with given fields: id, opts
+func (_m *HostService) HostByID(id string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostByID")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostOnCluster provides a mock function with given fields: clusterName, hostname, opts
+func (_m *HostService) HostOnCluster(clusterName string, hostname string, opts ...client.RequestOption) (*client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, hostname)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostOnCluster")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Host, error)); ok {
+		return rf(clusterName, hostname, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Host); ok {
+		r0 = rf(clusterName, hostname, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, hostname, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Hosts provides a mock function with given fields: opts
+func (_m *HostService) Hosts(opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hosts")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Host); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostsOnCluster provides a mock function with given fields: clusterName, opts
+func (_m *HostService) HostsOnCluster(clusterName string, opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostsOnCluster")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Host); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListHosts provides a mock function with given fields: clusterName, predicate, opts
+func (_m *HostService) ListHosts(clusterName string, predicate *client.Predicate, opts ...client.RequestOption) ([]client.Host, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, predicate)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHosts")
+	}
+
+	var r0 []client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Predicate, ...client.RequestOption) ([]client.Host, error)); ok {
+		return rf(clusterName, predicate, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Predicate, ...client.RequestOption) []client.Host); ok {
+		r0 = rf(clusterName, predicate, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Predicate, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, predicate, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterHostOnCluster provides a mock function with given fields: clusterName, hostname, blueprintName, role
+func (_m *HostService) RegisterHostOnCluster(clusterName string, hostname string, blueprintName string, role string) (*client.Host, error) {
+	ret := _m.Called(clusterName, hostname, blueprintName, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterHostOnCluster")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string) (*client.Host, error)); ok {
+		return rf(clusterName, hostname, blueprintName, role)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *client.Host); ok {
+		r0 = rf(clusterName, hostname, blueprintName, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(clusterName, hostname, blueprintName, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartAllComponentsInHost provides a mock function with given fields: clusterName, hostname, disableMaintenanceMode
+func (_m *HostService) StartAllComponentsInHost(clusterName string, hostname string, disableMaintenanceMode bool) error {
+	ret := _m.Called(clusterName, hostname, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(clusterName, hostname, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StopAllComponentsInHost provides a mock function with given fields: clusterName, hostname, enableMaintenanceMode, force
+func (_m *HostService) StopAllComponentsInHost(clusterName string, hostname string, enableMaintenanceMode bool, force bool) error {
+	ret := _m.Called(clusterName, hostname, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopAllComponentsInHost")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) error); ok {
+		r0 = rf(clusterName, hostname, enableMaintenanceMode, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateHost provides a mock function with given fields: host
+func (_m *HostService) UpdateHost(host *client.Host) (*client.Host, error) {
+	ret := _m.Called(host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateHost")
+	}
+
+	var r0 *client.Host
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Host) (*client.Host, error)); ok {
+		return rf(host)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Host) *client.Host); ok {
+		r0 = rf(host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Host)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Host) error); ok {
+		r1 = rf(host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewHostService creates a new instance of HostService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHostService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HostService {
+	mock := &HostService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
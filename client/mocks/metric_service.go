@@ -0,0 +1,166 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetricService is an autogenerated mock type for the MetricService type
+type MetricService struct {
+	mock.Mock
+}
+
+// ComponentMetrics provides a mock function with given fields: clusterName, serviceName, componentName, query
+func (_m *MetricService) ComponentMetrics(clusterName string, serviceName string, componentName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, serviceName, componentName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ComponentMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, serviceName, componentName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, serviceName, componentName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, serviceName, componentName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostComponentMetrics provides a mock function with given fields: clusterName, hostname, componentName, query
+func (_m *MetricService) HostComponentMetrics(clusterName string, hostname string, componentName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, hostname, componentName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostComponentMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, hostname, componentName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, hostname, componentName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, hostname, componentName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HostMetrics provides a mock function with given fields: clusterName, hostname, query
+func (_m *MetricService) HostMetrics(clusterName string, hostname string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, hostname, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HostMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, hostname, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, hostname, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, hostname, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceMetrics provides a mock function with given fields: clusterName, serviceName, query
+func (_m *MetricService) ServiceMetrics(clusterName string, serviceName string, query *client.MetricQuery) ([]client.MetricSeries, error) {
+	ret := _m.Called(clusterName, serviceName, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceMetrics")
+	}
+
+	var r0 []client.MetricSeries
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) ([]client.MetricSeries, error)); ok {
+		return rf(clusterName, serviceName, query)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *client.MetricQuery) []client.MetricSeries); ok {
+		r0 = rf(clusterName, serviceName, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.MetricSeries)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *client.MetricQuery) error); ok {
+		r1 = rf(clusterName, serviceName, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamHostsMetrics provides a mock function with given fields: clusterName, query, callback
+func (_m *MetricService) StreamHostsMetrics(clusterName string, query *client.MetricQuery, callback func(string, []client.MetricSeries) error) error {
+	ret := _m.Called(clusterName, query, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamHostsMetrics")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *client.MetricQuery, func(string, []client.MetricSeries) error) error); ok {
+		r0 = rf(clusterName, query, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMetricService creates a new instance of MetricService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetricService {
+	mock := &MetricService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,31 @@
+package mocks
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go-ambari-rest/client"
+	"testing"
+)
+
+func TestMockUsedAsService(t *testing.T) {
+
+	privilegeService := NewPrivilegeService(t)
+	privilegeService.On("Privileges", "test").Return([]client.Privilege{
+		{
+			PrivilegeInfo: &client.PrivilegeInfo{
+				PermissionName: "CLUSTER.USER",
+				PrincipalName:  "admin",
+				PrincipalType:  "USER",
+			},
+		},
+	}, nil)
+	privilegeService.On("DeletePrivilege", "test", mock.Anything).Return(nil)
+
+	var service client.PrivilegeService = privilegeService
+	privileges, err := service.Privileges("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(privileges))
+	assert.NoError(t, service.DeletePrivilege("test", 1))
+
+	var _ client.API = NewAPI(t)
+}
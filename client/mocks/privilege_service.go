@@ -0,0 +1,617 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PrivilegeService is an autogenerated mock type for the PrivilegeService type
+type PrivilegeService struct {
+	mock.Mock
+}
+
+// AmbariPrivilegeByID provides a mock function with given fields: id, opts
+func (_m *PrivilegeService) AmbariPrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmbariPrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AmbariPrivileges provides a mock function with given fields: opts
+func (_m *PrivilegeService) AmbariPrivileges(opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AmbariPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *PrivilegeService) ApplyPrivilege(clusterName string, privilege *client.Privilege) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPrivilege")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.ChangeReport); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ApplyPrivileges provides a mock function with given fields: clusterName, privileges
+func (_m *PrivilegeService) ApplyPrivileges(clusterName string, privileges []client.Privilege) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, privileges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPrivileges")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []client.Privilege) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, privileges)
+	}
+	if rf, ok := ret.Get(0).(func(string, []client.Privilege) *client.ChangeReport); ok {
+		r0 = rf(clusterName, privileges)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []client.Privilege) error); ok {
+		r1 = rf(clusterName, privileges)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateAmbariPrivilege provides a mock function with given fields: privilege
+func (_m *PrivilegeService) CreateAmbariPrivilege(privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAmbariPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Privilege) (*client.Privilege, error)); ok {
+		return rf(privilege)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Privilege) *client.Privilege); ok {
+		r0 = rf(privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Privilege) error); ok {
+		r1 = rf(privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *PrivilegeService) CreatePrivilege(clusterName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateViewPrivilege provides a mock function with given fields: viewName, version, instanceName, privilege
+func (_m *PrivilegeService) CreateViewPrivilege(viewName string, version string, instanceName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(viewName, version, instanceName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateViewPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(viewName, version, instanceName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(viewName, version, instanceName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, *client.Privilege) error); ok {
+		r1 = rf(viewName, version, instanceName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAmbariPrivilege provides a mock function with given fields: id
+func (_m *PrivilegeService) DeleteAmbariPrivilege(id int64) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAmbariPrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePrivilege provides a mock function with given fields: clusterName, id
+func (_m *PrivilegeService) DeletePrivilege(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteViewPrivilege provides a mock function with given fields: viewName, version, instanceName, id
+func (_m *PrivilegeService) DeleteViewPrivilege(viewName string, version string, instanceName string, id int64) error {
+	ret := _m.Called(viewName, version, instanceName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteViewPrivilege")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int64) error); ok {
+		r0 = rf(viewName, version, instanceName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportSecurityModel provides a mock function with given fields: clusterName
+func (_m *PrivilegeService) ExportSecurityModel(clusterName string) (*client.SecurityModel, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportSecurityModel")
+	}
+
+	var r0 *client.SecurityModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.SecurityModel, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.SecurityModel); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.SecurityModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportSecurityModel provides a mock function with given fields: clusterName, securityModel, newUserPassword, deletePrivileges
+func (_m *PrivilegeService) ImportSecurityModel(clusterName string, securityModel *client.SecurityModel, newUserPassword string, deletePrivileges bool) (*client.ChangeReport, error) {
+	ret := _m.Called(clusterName, securityModel, newUserPassword, deletePrivileges)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSecurityModel")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.SecurityModel, string, bool) (*client.ChangeReport, error)); ok {
+		return rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.SecurityModel, string, bool) *client.ChangeReport); ok {
+		r0 = rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.SecurityModel, string, bool) error); ok {
+		r1 = rf(clusterName, securityModel, newUserPassword, deletePrivileges)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Privilege provides a mock function with given fields: clusterName, id, opts
+func (_m *PrivilegeService) Privilege(clusterName string, id int64, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Privilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeByID provides a mock function with given fields: id, opts
+func (_m *PrivilegeService) PrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Privileges provides a mock function with given fields: clusterName, opts
+func (_m *PrivilegeService) Privileges(clusterName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Privileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPrivilege provides a mock function with given fields: clusterName, permissionName, principalName, principalType, opts
+func (_m *PrivilegeService) SearchPrivilege(clusterName string, permissionName string, principalName string, principalType string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, permissionName, principalName, principalType)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(clusterName, permissionName, principalName, principalType, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(clusterName, permissionName, principalName, principalType, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, permissionName, principalName, principalType, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePrivilege provides a mock function with given fields: clusterName, privilege
+func (_m *PrivilegeService) UpdatePrivilege(clusterName string, privilege *client.Privilege) (*client.Privilege, error) {
+	ret := _m.Called(clusterName, privilege)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrivilege")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) (*client.Privilege, error)); ok {
+		return rf(clusterName, privilege)
+	}
+	if rf, ok := ret.Get(0).(func(string, *client.Privilege) *client.Privilege); ok {
+		r0 = rf(clusterName, privilege)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *client.Privilege) error); ok {
+		r1 = rf(clusterName, privilege)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewPrivilegeByID provides a mock function with given fields: id, opts
+func (_m *PrivilegeService) ViewPrivilegeByID(id string, opts ...client.RequestOption) (*client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPrivilegeByID")
+	}
+
+	var r0 *client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Privilege, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Privilege); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewPrivileges provides a mock function with given fields: viewName, version, instanceName, opts
+func (_m *PrivilegeService) ViewPrivileges(viewName string, version string, instanceName string, opts ...client.RequestOption) ([]client.Privilege, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version, instanceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewPrivileges")
+	}
+
+	var r0 []client.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.Privilege, error)); ok {
+		return rf(viewName, version, instanceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.Privilege); ok {
+		r0 = rf(viewName, version, instanceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, instanceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPrivilegeService creates a new instance of PrivilegeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivilegeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivilegeService {
+	mock := &PrivilegeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
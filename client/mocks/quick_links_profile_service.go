@@ -0,0 +1,106 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuickLinksProfileService is an autogenerated mock type for the QuickLinksProfileService type
+type QuickLinksProfileService struct {
+	mock.Mock
+}
+
+// DeleteQuickLinksProfile provides a mock function with no fields
+func (_m *QuickLinksProfileService) DeleteQuickLinksProfile() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuickLinksProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuickLinksProfile provides a mock function with no fields
+func (_m *QuickLinksProfileService) QuickLinksProfile() (*client.QuickLinksProfile, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickLinksProfile")
+	}
+
+	var r0 *client.QuickLinksProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*client.QuickLinksProfile, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *client.QuickLinksProfile); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.QuickLinksProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveQuickLinksProfile provides a mock function with given fields: quickLinksProfile
+func (_m *QuickLinksProfileService) SaveQuickLinksProfile(quickLinksProfile *client.QuickLinksProfile) (*client.QuickLinksProfile, error) {
+	ret := _m.Called(quickLinksProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuickLinksProfile")
+	}
+
+	var r0 *client.QuickLinksProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.QuickLinksProfile) (*client.QuickLinksProfile, error)); ok {
+		return rf(quickLinksProfile)
+	}
+	if rf, ok := ret.Get(0).(func(*client.QuickLinksProfile) *client.QuickLinksProfile); ok {
+		r0 = rf(quickLinksProfile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.QuickLinksProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.QuickLinksProfile) error); ok {
+		r1 = rf(quickLinksProfile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewQuickLinksProfileService creates a new instance of QuickLinksProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuickLinksProfileService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuickLinksProfileService {
+	mock := &QuickLinksProfileService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,217 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RepositoryService is an autogenerated mock type for the RepositoryService type
+type RepositoryService struct {
+	mock.Mock
+}
+
+// CreateRepository provides a mock function with given fields: repository
+func (_m *RepositoryService) CreateRepository(repository *client.Repository) (*client.Repository, error) {
+	ret := _m.Called(repository)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Repository) (*client.Repository, error)); ok {
+		return rf(repository)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Repository) *client.Repository); ok {
+		r0 = rf(repository)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Repository) error); ok {
+		r1 = rf(repository)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRepository provides a mock function with given fields: stackName, stackVersion, repositoryId
+func (_m *RepositoryService) DeleteRepository(stackName string, stackVersion string, repositoryId int) error {
+	ret := _m.Called(stackName, stackVersion, repositoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRepository")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, int) error); ok {
+		r0 = rf(stackName, stackVersion, repositoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Repository provides a mock function with given fields: stackName, stackVersion, repositoryId, opts
+func (_m *RepositoryService) Repository(stackName string, stackVersion string, repositoryId int, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, repositoryId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Repository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(stackName, stackVersion, repositoryId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(stackName, stackVersion, repositoryId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, repositoryId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RepositoryByID provides a mock function with given fields: id, opts
+func (_m *RepositoryService) RepositoryByID(id string, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RepositoryByID")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRepository provides a mock function with given fields: stackName, stackVersion, repositoryName, repositoryVersion, opts
+func (_m *RepositoryService) SearchRepository(stackName string, stackVersion string, repositoryName string, repositoryVersion string, opts ...client.RequestOption) (*client.Repository, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, repositoryName, repositoryVersion)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) (*client.Repository, error)); ok {
+		return rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, string, ...client.RequestOption) *client.Repository); ok {
+		r0 = rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRepository provides a mock function with given fields: repository
+func (_m *RepositoryService) UpdateRepository(repository *client.Repository) (*client.Repository, error) {
+	ret := _m.Called(repository)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRepository")
+	}
+
+	var r0 *client.Repository
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Repository) (*client.Repository, error)); ok {
+		return rf(repository)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Repository) *client.Repository); ok {
+		r0 = rf(repository)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Repository)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Repository) error); ok {
+		r1 = rf(repository)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRepositoryService creates a new instance of RepositoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepositoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepositoryService {
+	mock := &RepositoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
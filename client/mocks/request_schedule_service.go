@@ -0,0 +1,150 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestScheduleService is an autogenerated mock type for the RequestScheduleService type
+type RequestScheduleService struct {
+	mock.Mock
+}
+
+// CreateRequestSchedule provides a mock function with given fields: requestSchedule
+func (_m *RequestScheduleService) CreateRequestSchedule(requestSchedule *client.RequestSchedule) (*client.RequestSchedule, error) {
+	ret := _m.Called(requestSchedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRequestSchedule")
+	}
+
+	var r0 *client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.RequestSchedule) (*client.RequestSchedule, error)); ok {
+		return rf(requestSchedule)
+	}
+	if rf, ok := ret.Get(0).(func(*client.RequestSchedule) *client.RequestSchedule); ok {
+		r0 = rf(requestSchedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.RequestSchedule) error); ok {
+		r1 = rf(requestSchedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRequestSchedule provides a mock function with given fields: clusterName, id
+func (_m *RequestScheduleService) DeleteRequestSchedule(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRequestSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestSchedule provides a mock function with given fields: clusterName, id, opts
+func (_m *RequestScheduleService) RequestSchedule(clusterName string, id int64, opts ...client.RequestOption) (*client.RequestSchedule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestSchedule")
+	}
+
+	var r0 *client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.RequestSchedule, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.RequestSchedule); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestSchedules provides a mock function with given fields: clusterName, opts
+func (_m *RequestScheduleService) RequestSchedules(clusterName string, opts ...client.RequestOption) ([]client.RequestSchedule, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestSchedules")
+	}
+
+	var r0 []client.RequestSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.RequestSchedule, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.RequestSchedule); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.RequestSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRequestScheduleService creates a new instance of RequestScheduleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestScheduleService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestScheduleService {
+	mock := &RequestScheduleService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,231 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestService is an autogenerated mock type for the RequestService type
+type RequestService struct {
+	mock.Mock
+}
+
+// AbortRequest provides a mock function with given fields: clusterName, Id
+func (_m *RequestService) AbortRequest(clusterName string, Id int) (*client.RequestTask, error) {
+	ret := _m.Called(clusterName, Id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AbortRequest")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.RequestTask, error)); ok {
+		return rf(clusterName, Id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.RequestTask); ok {
+		r0 = rf(clusterName, Id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, Id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Request provides a mock function with given fields: clusterName, Id, opts
+func (_m *RequestService) Request(clusterName string, Id int, opts ...client.RequestOption) (*client.RequestTask, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, Id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Request")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) (*client.RequestTask, error)); ok {
+		return rf(clusterName, Id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) *client.RequestTask); ok {
+		r0 = rf(clusterName, Id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, Id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Requests provides a mock function with given fields: clusterName, opts
+func (_m *RequestService) Requests(clusterName string, opts ...client.RequestOption) ([]client.RequestTask, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Requests")
+	}
+
+	var r0 []client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.RequestTask, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.RequestTask); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamTasks provides a mock function with given fields: clusterName, requestId, callback, opts
+func (_m *RequestService) StreamTasks(clusterName string, requestId int, callback func(*client.Task) error, opts ...client.RequestOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId, callback)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamTasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, func(*client.Task) error, ...client.RequestOption) error); ok {
+		r0 = rf(clusterName, requestId, callback, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Task provides a mock function with given fields: clusterName, requestId, taskId, opts
+func (_m *RequestService) Task(clusterName string, requestId int, taskId int, opts ...client.RequestOption) (*client.Task, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId, taskId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Task")
+	}
+
+	var r0 *client.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int, ...client.RequestOption) (*client.Task, error)); ok {
+		return rf(clusterName, requestId, taskId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, ...client.RequestOption) *client.Task); ok {
+		r0 = rf(clusterName, requestId, taskId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, requestId, taskId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Tasks provides a mock function with given fields: clusterName, requestId, opts
+func (_m *RequestService) Tasks(clusterName string, requestId int, opts ...client.RequestOption) ([]client.Task, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, requestId)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tasks")
+	}
+
+	var r0 []client.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) ([]client.Task, error)); ok {
+		return rf(clusterName, requestId, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) []client.Task); ok {
+		r0 = rf(clusterName, requestId, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, requestId, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRequestService creates a new instance of RequestService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRequestService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RequestService {
+	mock := &RequestService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
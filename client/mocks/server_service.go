@@ -0,0 +1,130 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ServerService is an autogenerated mock type for the ServerService type
+type ServerService struct {
+	mock.Mock
+}
+
+// Ping provides a mock function with given fields: timeout
+func (_m *ServerService) Ping(timeout time.Duration) error {
+	ret := _m.Called(timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(timeout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServerInfo provides a mock function with given fields: opts
+func (_m *ServerService) ServerInfo(opts ...client.RequestOption) (*client.ServerInfo, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerInfo")
+	}
+
+	var r0 *client.ServerInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) (*client.ServerInfo, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) *client.ServerInfo); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ServerInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerVersion provides a mock function with no fields
+func (_m *ServerService) ServerVersion() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServerVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Supports provides a mock function with given fields: feature
+func (_m *ServerService) Supports(feature client.Feature) bool {
+	ret := _m.Called(feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Supports")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(client.Feature) bool); ok {
+		r0 = rf(feature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NewServerService creates a new instance of ServerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerService {
+	mock := &ServerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
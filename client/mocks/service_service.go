@@ -0,0 +1,397 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServiceService is an autogenerated mock type for the ServiceService type
+type ServiceService struct {
+	mock.Mock
+}
+
+// CreateService provides a mock function with given fields: service
+func (_m *ServiceService) CreateService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteService provides a mock function with given fields: clusterName, serviceName
+func (_m *ServiceService) DeleteService(clusterName string, serviceName string) error {
+	ret := _m.Called(clusterName, serviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(clusterName, serviceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetClusterHealthSummary provides a mock function with given fields: clusterName
+func (_m *ServiceService) GetClusterHealthSummary(clusterName string) (*client.ClusterHealthSummary, error) {
+	ret := _m.Called(clusterName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClusterHealthSummary")
+	}
+
+	var r0 *client.ClusterHealthSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*client.ClusterHealthSummary, error)); ok {
+		return rf(clusterName)
+	}
+	if rf, ok := ret.Get(0).(func(string) *client.ClusterHealthSummary); ok {
+		r0 = rf(clusterName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ClusterHealthSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(clusterName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallService provides a mock function with given fields: service
+func (_m *ServiceService) InstallService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendRequestService provides a mock function with given fields: request
+func (_m *ServiceService) SendRequestService(request *client.Request) (*client.RequestTask, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendRequestService")
+	}
+
+	var r0 *client.RequestTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Request) (*client.RequestTask, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Request) *client.RequestTask); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.RequestTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service provides a mock function with given fields: clusterName, serviceName, opts
+func (_m *ServiceService) Service(clusterName string, serviceName string, opts ...client.RequestOption) (*client.Service, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Service")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceByID provides a mock function with given fields: id, opts
+func (_m *ServiceService) ServiceByID(id string, opts ...client.RequestOption) (*client.Service, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceByID")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Service, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Service); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartAllServices provides a mock function with given fields: cluster, disableMaintenanceMode
+func (_m *ServiceService) StartAllServices(cluster *client.Cluster, disableMaintenanceMode bool) error {
+	ret := _m.Called(cluster, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartAllServices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster, bool) error); ok {
+		r0 = rf(cluster, disableMaintenanceMode)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartService provides a mock function with given fields: clusterName, serviceName, disableMaintenanceMode
+func (_m *ServiceService) StartService(clusterName string, serviceName string, disableMaintenanceMode bool) (*client.Service, error) {
+	ret := _m.Called(clusterName, serviceName, disableMaintenanceMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, disableMaintenanceMode)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, disableMaintenanceMode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool) error); ok {
+		r1 = rf(clusterName, serviceName, disableMaintenanceMode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopAllServices provides a mock function with given fields: cluster, enableMaintenanceMode, force
+func (_m *ServiceService) StopAllServices(cluster *client.Cluster, enableMaintenanceMode bool, force bool) error {
+	ret := _m.Called(cluster, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopAllServices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*client.Cluster, bool, bool) error); ok {
+		r0 = rf(cluster, enableMaintenanceMode, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StopService provides a mock function with given fields: clusterName, serviceName, enableMaintenanceMode, force
+func (_m *ServiceService) StopService(clusterName string, serviceName string, enableMaintenanceMode bool, force bool) (*client.Service, error) {
+	ret := _m.Called(clusterName, serviceName, enableMaintenanceMode, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) (*client.Service, error)); ok {
+		return rf(clusterName, serviceName, enableMaintenanceMode, force)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, bool, bool) *client.Service); ok {
+		r0 = rf(clusterName, serviceName, enableMaintenanceMode, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, bool, bool) error); ok {
+		r1 = rf(clusterName, serviceName, enableMaintenanceMode, force)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateService provides a mock function with given fields: service
+func (_m *ServiceService) UpdateService(service *client.Service) (*client.Service, error) {
+	ret := _m.Called(service)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateService")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Service) (*client.Service, error)); ok {
+		return rf(service)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Service) *client.Service); ok {
+		r0 = rf(service)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Service) error); ok {
+		r1 = rf(service)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForServiceState provides a mock function with given fields: ctx, clusterName, serviceName, state
+func (_m *ServiceService) WaitForServiceState(ctx context.Context, clusterName string, serviceName string, state string) (*client.Service, error) {
+	ret := _m.Called(ctx, clusterName, serviceName, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForServiceState")
+	}
+
+	var r0 *client.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*client.Service, error)); ok {
+		return rf(ctx, clusterName, serviceName, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *client.Service); ok {
+		r0 = rf(ctx, clusterName, serviceName, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, clusterName, serviceName, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewServiceService creates a new instance of ServiceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceService {
+	mock := &ServiceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,246 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SettingService is an autogenerated mock type for the SettingService type
+type SettingService struct {
+	mock.Mock
+}
+
+// ApplySetting provides a mock function with given fields: setting
+func (_m *SettingService) ApplySetting(setting *client.Setting) (*client.ChangeReport, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplySetting")
+	}
+
+	var r0 *client.ChangeReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.ChangeReport, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.ChangeReport); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ChangeReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateSetting provides a mock function with given fields: setting
+func (_m *SettingService) CreateSetting(setting *client.Setting) (*client.Setting, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSetting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.Setting, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.Setting); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSetting provides a mock function with given fields: name
+func (_m *SettingService) DeleteSetting(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSetting")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Setting provides a mock function with given fields: name, opts
+func (_m *SettingService) Setting(name string, opts ...client.RequestOption) (*client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Setting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Setting, error)); ok {
+		return rf(name, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Setting); ok {
+		r0 = rf(name, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(name, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SettingByID provides a mock function with given fields: id, opts
+func (_m *SettingService) SettingByID(id string, opts ...client.RequestOption) (*client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettingByID")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Setting, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Setting); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Settings provides a mock function with given fields: opts
+func (_m *SettingService) Settings(opts ...client.RequestOption) ([]client.Setting, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Settings")
+	}
+
+	var r0 []client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Setting, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Setting); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSetting provides a mock function with given fields: setting
+func (_m *SettingService) UpdateSetting(setting *client.Setting) (*client.Setting, error) {
+	ret := _m.Called(setting)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSetting")
+	}
+
+	var r0 *client.Setting
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Setting) (*client.Setting, error)); ok {
+		return rf(setting)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Setting) *client.Setting); ok {
+		r0 = rf(setting)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Setting)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Setting) error); ok {
+		r1 = rf(setting)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSettingService creates a new instance of SettingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSettingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SettingService {
+	mock := &SettingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,242 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StackDefinitionService is an autogenerated mock type for the StackDefinitionService type
+type StackDefinitionService struct {
+	mock.Mock
+}
+
+// StackComponents provides a mock function with given fields: stackName, stackVersion, serviceName, opts
+func (_m *StackDefinitionService) StackComponents(stackName string, stackVersion string, serviceName string, opts ...client.RequestOption) ([]client.StackComponent, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackComponents")
+	}
+
+	var r0 []client.StackComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.StackComponent, error)); ok {
+		return rf(stackName, stackVersion, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.StackComponent); ok {
+		r0 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackConfigurations provides a mock function with given fields: stackName, stackVersion, serviceName, opts
+func (_m *StackDefinitionService) StackConfigurations(stackName string, stackVersion string, serviceName string, opts ...client.RequestOption) ([]client.StackConfiguration, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackConfigurations")
+	}
+
+	var r0 []client.StackConfiguration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) ([]client.StackConfiguration, error)); ok {
+		return rf(stackName, stackVersion, serviceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) []client.StackConfiguration); ok {
+		r0 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackConfiguration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackDefaultConfigurations provides a mock function with given fields: stackName, stackVersion, serviceName
+func (_m *StackDefinitionService) StackDefaultConfigurations(stackName string, stackVersion string, serviceName string) ([]client.Configuration, error) {
+	ret := _m.Called(stackName, stackVersion, serviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackDefaultConfigurations")
+	}
+
+	var r0 []client.Configuration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) ([]client.Configuration, error)); ok {
+		return rf(stackName, stackVersion, serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) []client.Configuration); ok {
+		r0 = rf(stackName, stackVersion, serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Configuration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(stackName, stackVersion, serviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackServices provides a mock function with given fields: stackName, stackVersion, opts
+func (_m *StackDefinitionService) StackServices(stackName string, stackVersion string, opts ...client.RequestOption) ([]client.StackService, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName, stackVersion)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackServices")
+	}
+
+	var r0 []client.StackService
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.StackService, error)); ok {
+		return rf(stackName, stackVersion, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.StackService); ok {
+		r0 = rf(stackName, stackVersion, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackService)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, stackVersion, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StackVersions provides a mock function with given fields: stackName, opts
+func (_m *StackDefinitionService) StackVersions(stackName string, opts ...client.RequestOption) ([]client.StackVersion, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stackName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StackVersions")
+	}
+
+	var r0 []client.StackVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.StackVersion, error)); ok {
+		return rf(stackName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.StackVersion); ok {
+		r0 = rf(stackName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.StackVersion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(stackName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Stacks provides a mock function with given fields: opts
+func (_m *StackDefinitionService) Stacks(opts ...client.RequestOption) ([]client.Stack, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stacks")
+	}
+
+	var r0 []client.Stack
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) ([]client.Stack, error)); ok {
+		return rf(opts...)
+	}
+	if rf, ok := ret.Get(0).(func(...client.RequestOption) []client.Stack); ok {
+		r0 = rf(opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Stack)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...client.RequestOption) error); ok {
+		r1 = rf(opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewStackDefinitionService creates a new instance of StackDefinitionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStackDefinitionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StackDefinitionService {
+	mock := &StackDefinitionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,162 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UpgradeService is an autogenerated mock type for the UpgradeService type
+type UpgradeService struct {
+	mock.Mock
+}
+
+// FinalizeUpgrade provides a mock function with given fields: clusterName, id
+func (_m *UpgradeService) FinalizeUpgrade(clusterName string, id int) (*client.Upgrade, error) {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinalizeUpgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.Upgrade); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SkipAndFinalizeUpgrade provides a mock function with given fields: clusterName, id
+func (_m *UpgradeService) SkipAndFinalizeUpgrade(clusterName string, id int) (*client.Upgrade, error) {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SkipAndFinalizeUpgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *client.Upgrade); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(clusterName, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upgrade provides a mock function with given fields: clusterName, id, opts
+func (_m *UpgradeService) Upgrade(clusterName string, id int, opts ...client.RequestOption) (*client.Upgrade, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 *client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) (*client.Upgrade, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, ...client.RequestOption) *client.Upgrade); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upgrades provides a mock function with given fields: clusterName, opts
+func (_m *UpgradeService) Upgrades(clusterName string, opts ...client.RequestOption) ([]client.Upgrade, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrades")
+	}
+
+	var r0 []client.Upgrade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Upgrade, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Upgrade); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Upgrade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUpgradeService creates a new instance of UpgradeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpgradeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpgradeService {
+	mock := &UpgradeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
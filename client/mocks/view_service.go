@@ -0,0 +1,217 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ViewService is an autogenerated mock type for the ViewService type
+type ViewService struct {
+	mock.Mock
+}
+
+// CreateViewInstance provides a mock function with given fields: viewInstance
+func (_m *ViewService) CreateViewInstance(viewInstance *client.ViewInstance) (*client.ViewInstance, error) {
+	ret := _m.Called(viewInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) (*client.ViewInstance, error)); ok {
+		return rf(viewInstance)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) *client.ViewInstance); ok {
+		r0 = rf(viewInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ViewInstance) error); ok {
+		r1 = rf(viewInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteViewInstance provides a mock function with given fields: viewName, version, instanceName
+func (_m *ViewService) DeleteViewInstance(viewName string, version string, instanceName string) error {
+	ret := _m.Called(viewName, version, instanceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteViewInstance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(viewName, version, instanceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateViewInstance provides a mock function with given fields: viewInstance
+func (_m *ViewService) UpdateViewInstance(viewInstance *client.ViewInstance) (*client.ViewInstance, error) {
+	ret := _m.Called(viewInstance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) (*client.ViewInstance, error)); ok {
+		return rf(viewInstance)
+	}
+	if rf, ok := ret.Get(0).(func(*client.ViewInstance) *client.ViewInstance); ok {
+		r0 = rf(viewInstance)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.ViewInstance) error); ok {
+		r1 = rf(viewInstance)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstance provides a mock function with given fields: viewName, version, instanceName, opts
+func (_m *ViewService) ViewInstance(viewName string, version string, instanceName string, opts ...client.RequestOption) (*client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version, instanceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstance")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) (*client.ViewInstance, error)); ok {
+		return rf(viewName, version, instanceName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string, ...client.RequestOption) *client.ViewInstance); ok {
+		r0 = rf(viewName, version, instanceName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, instanceName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstanceByID provides a mock function with given fields: id, opts
+func (_m *ViewService) ViewInstanceByID(id string, opts ...client.RequestOption) (*client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstanceByID")
+	}
+
+	var r0 *client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.ViewInstance, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.ViewInstance); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ViewInstances provides a mock function with given fields: viewName, version, opts
+func (_m *ViewService) ViewInstances(viewName string, version string, opts ...client.RequestOption) ([]client.ViewInstance, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, viewName, version)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ViewInstances")
+	}
+
+	var r0 []client.ViewInstance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) ([]client.ViewInstance, error)); ok {
+		return rf(viewName, version, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) []client.ViewInstance); ok {
+		r0 = rf(viewName, version, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.ViewInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(viewName, version, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewViewService creates a new instance of ViewService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewViewService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ViewService {
+	mock := &ViewService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,443 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	client "go-ambari-rest/client"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WidgetService is an autogenerated mock type for the WidgetService type
+type WidgetService struct {
+	mock.Mock
+}
+
+// CreateWidget provides a mock function with given fields: widget
+func (_m *WidgetService) CreateWidget(widget *client.Widget) (*client.Widget, error) {
+	ret := _m.Called(widget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Widget) (*client.Widget, error)); ok {
+		return rf(widget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Widget) *client.Widget); ok {
+		r0 = rf(widget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Widget) error); ok {
+		r1 = rf(widget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWidgetLayout provides a mock function with given fields: widgetLayout
+func (_m *WidgetService) CreateWidgetLayout(widgetLayout *client.WidgetLayout) (*client.WidgetLayout, error) {
+	ret := _m.Called(widgetLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) (*client.WidgetLayout, error)); ok {
+		return rf(widgetLayout)
+	}
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) *client.WidgetLayout); ok {
+		r0 = rf(widgetLayout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.WidgetLayout) error); ok {
+		r1 = rf(widgetLayout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWidget provides a mock function with given fields: clusterName, id
+func (_m *WidgetService) DeleteWidget(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidget")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWidgetLayout provides a mock function with given fields: clusterName, id
+func (_m *WidgetService) DeleteWidgetLayout(clusterName string, id int64) error {
+	ret := _m.Called(clusterName, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWidgetLayout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(clusterName, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchWidget provides a mock function with given fields: clusterName, widgetName, opts
+func (_m *WidgetService) SearchWidget(clusterName string, widgetName string, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, widgetName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(clusterName, widgetName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(clusterName, widgetName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, widgetName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchWidgetLayout provides a mock function with given fields: clusterName, layoutName, opts
+func (_m *WidgetService) SearchWidgetLayout(clusterName string, layoutName string, opts ...client.RequestOption) (*client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, layoutName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) (*client.WidgetLayout, error)); ok {
+		return rf(clusterName, layoutName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...client.RequestOption) *client.WidgetLayout); ok {
+		r0 = rf(clusterName, layoutName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, layoutName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWidget provides a mock function with given fields: widget
+func (_m *WidgetService) UpdateWidget(widget *client.Widget) (*client.Widget, error) {
+	ret := _m.Called(widget)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.Widget) (*client.Widget, error)); ok {
+		return rf(widget)
+	}
+	if rf, ok := ret.Get(0).(func(*client.Widget) *client.Widget); ok {
+		r0 = rf(widget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.Widget) error); ok {
+		r1 = rf(widget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWidgetLayout provides a mock function with given fields: widgetLayout
+func (_m *WidgetService) UpdateWidgetLayout(widgetLayout *client.WidgetLayout) (*client.WidgetLayout, error) {
+	ret := _m.Called(widgetLayout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) (*client.WidgetLayout, error)); ok {
+		return rf(widgetLayout)
+	}
+	if rf, ok := ret.Get(0).(func(*client.WidgetLayout) *client.WidgetLayout); ok {
+		r0 = rf(widgetLayout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*client.WidgetLayout) error); ok {
+		r1 = rf(widgetLayout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Widget provides a mock function with given fields: clusterName, id, opts
+func (_m *WidgetService) Widget(clusterName string, id int64, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Widget")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetByID provides a mock function with given fields: id, opts
+func (_m *WidgetService) WidgetByID(id string, opts ...client.RequestOption) (*client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetByID")
+	}
+
+	var r0 *client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) (*client.Widget, error)); ok {
+		return rf(id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) *client.Widget); ok {
+		r0 = rf(id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetLayout provides a mock function with given fields: clusterName, id, opts
+func (_m *WidgetService) WidgetLayout(clusterName string, id int64, opts ...client.RequestOption) (*client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetLayout")
+	}
+
+	var r0 *client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) (*client.WidgetLayout, error)); ok {
+		return rf(clusterName, id, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, ...client.RequestOption) *client.WidgetLayout); ok {
+		r0 = rf(clusterName, id, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, id, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WidgetLayouts provides a mock function with given fields: clusterName, opts
+func (_m *WidgetService) WidgetLayouts(clusterName string, opts ...client.RequestOption) ([]client.WidgetLayout, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WidgetLayouts")
+	}
+
+	var r0 []client.WidgetLayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.WidgetLayout, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.WidgetLayout); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.WidgetLayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Widgets provides a mock function with given fields: clusterName, opts
+func (_m *WidgetService) Widgets(clusterName string, opts ...client.RequestOption) ([]client.Widget, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, clusterName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Widgets")
+	}
+
+	var r0 []client.Widget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) ([]client.Widget, error)); ok {
+		return rf(clusterName, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...client.RequestOption) []client.Widget); ok {
+		r0 = rf(clusterName, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]client.Widget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...client.RequestOption) error); ok {
+		r1 = rf(clusterName, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWidgetService creates a new instance of WidgetService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWidgetService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WidgetService {
+	mock := &WidgetService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,211 @@
+// This file permit to customize the call on Ambari API, like the partial response
+
+package client
+
+import (
+	"gopkg.in/resty.v1"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RequestOption permit to customize the call on Ambari API.
+// All get methods accept them as last parameters. The options Header and QueryParam can be set on all calls with WithOptions.
+type RequestOption func(options *requestOptions)
+
+type requestOptions struct {
+	fields      []string
+	predicates  []*Predicate
+	pageSize    int
+	from        string
+	to          string
+	sortBy      []string
+	headers     map[string]string
+	queryParams map[string]string
+}
+
+// Fields permit to ask Ambari to return only the given fields (partial response), like Hosts/host_name or Hosts/*
+// When call many times, the fields are added
+func Fields(fields ...string) RequestOption {
+	return func(options *requestOptions) {
+		options.fields = append(options.fields, fields...)
+	}
+}
+
+// PageSize permit to limit the number of resources returned by Ambari
+// Use it with PageFrom or PageTo to choose the page, else Ambari return the first page
+func PageSize(size int) RequestOption {
+	return func(options *requestOptions) {
+		options.pageSize = size
+	}
+}
+
+// PageFrom permit to get the page that start at the offset (start from 0)
+func PageFrom(offset int) RequestOption {
+	return func(options *requestOptions) {
+		options.from = strconv.Itoa(offset)
+		options.to = ""
+	}
+}
+
+// PageTo permit to get the page that end at the offset
+func PageTo(offset int) RequestOption {
+	return func(options *requestOptions) {
+		options.to = strconv.Itoa(offset)
+		options.from = ""
+	}
+}
+
+// LastPage permit to get the last page
+func LastPage() RequestOption {
+	return func(options *requestOptions) {
+		options.to = "end"
+		options.from = ""
+	}
+}
+
+// SortBy permit to sort the resources in ascending order on field, like Tasks/id
+// When call many times, the resources are sorted by the first field, then by the next fields
+func SortBy(field string) RequestOption {
+	return func(options *requestOptions) {
+		options.sortBy = append(options.sortBy, field+".asc")
+	}
+}
+
+// SortByDesc permit to sort the resources in descending order on field
+func SortByDesc(field string) RequestOption {
+	return func(options *requestOptions) {
+		options.sortBy = append(options.sortBy, field+".desc")
+	}
+}
+
+// Header permit to add header on the call, like correlation ID for tracing
+// When call many times with the same key, the last value is used
+func Header(key string, value string) RequestOption {
+	return func(options *requestOptions) {
+		if options.headers == nil {
+			options.headers = map[string]string{}
+		}
+		options.headers[key] = value
+	}
+}
+
+// QueryParam permit to add query parameter on the call, like the parameters that the client not manage
+// It override the query parameters set by the other options
+func QueryParam(key string, value string) RequestOption {
+	return func(options *requestOptions) {
+		if options.queryParams == nil {
+			options.queryParams = map[string]string{}
+		}
+		options.queryParams[key] = value
+	}
+}
+
+// WithOptions return copy of client that add the headers and the query parameters of the options on all its calls, the get and the changes.
+// The other options are ignored. The copy share the connections and the logger of client, and the options already set on client are kept.
+// The copy share the transport of client too, like the retry, the cache, the dry run, the audit, the rate limit and the auth, so the client changes are used by the copy.
+// The methods that change the transport, like SetRetryPolicy, SetCache, SetDryRun, SetAudit or SetBearerTokenAuth, panic on the copy, call them on client.
+// It permit to call the methods that not accept options, like with X-Requested-By or correlation ID header, without change the client.
+func (c *AmbariClient) WithOptions(opts ...RequestOption) *AmbariClient {
+
+	options := newRequestOptions(opts, nil)
+	// Only the header names are logged, the values can be credentials
+	headerNames := make([]string, 0, len(options.headers))
+	for key := range options.headers {
+		headerNames = append(headerNames, key)
+	}
+	c.log.Debug("Headers: ", headerNames)
+	c.log.Debug("QueryParams: ", options.queryParams)
+
+	// The resty client keep its settings, only its headers and query parameters are changed
+	restyClient := *c.client
+	restyClient.Header = c.client.Header.Clone()
+	restyClient.QueryParam = url.Values{}
+	for key, values := range c.client.QueryParam {
+		restyClient.QueryParam[key] = append([]string(nil), values...)
+	}
+	for key, value := range options.headers {
+		restyClient.SetHeader(key, value)
+	}
+	for key, value := range options.queryParams {
+		restyClient.SetQueryParam(key, value)
+	}
+
+	client := *c
+	client.client = &restyClient
+	client.derived = true
+
+	return &client
+}
+
+// newRequestOptions permit to compute the options.
+// The default options are the options set by the method itself, like the search criteria. The default fields are used only when there are no option Fields
+func newRequestOptions(opts []RequestOption, defaultOpts []RequestOption) *requestOptions {
+
+	options := &requestOptions{}
+	for _, opt := range defaultOpts {
+		opt(options)
+	}
+	defaultFields := options.fields
+	options.fields = nil
+	for _, opt := range opts {
+		opt(options)
+	}
+	if len(options.fields) == 0 {
+		options.fields = defaultFields
+	}
+
+	return options
+}
+
+// request return new request with the options applied on it
+func (o *requestOptions) request(client *resty.Client) *resty.Request {
+
+	request := client.R()
+	if len(o.fields) > 0 {
+		request.SetQueryParam("fields", strings.Join(o.fields, ","))
+	}
+	if o.pageSize > 0 {
+		request.SetQueryParam("page_size", strconv.Itoa(o.pageSize))
+	}
+	if o.from != "" {
+		request.SetQueryParam("from", o.from)
+	}
+	if o.to != "" {
+		request.SetQueryParam("to", o.to)
+	}
+	if len(o.sortBy) > 0 {
+		request.SetQueryParam("sortBy", strings.Join(o.sortBy, ","))
+	}
+	for key, value := range o.queryParams {
+		request.SetQueryParam(key, value)
+	}
+	for key, value := range o.headers {
+		request.SetHeader(key, value)
+	}
+
+	return request
+}
+
+// url return the path with the predicates.
+// The predicates are not added as query parameter to keep them as is, because the order matter.
+func (o *requestOptions) url(path string) string {
+
+	if len(o.predicates) == 0 {
+		return path
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	return path + separator + escapePredicate(And(o.predicates...).String())
+}
+
+// get permit to call the API with GET method and the options
+func (c *AmbariClient) get(path string, opts []RequestOption, defaultOpts ...RequestOption) (*resty.Response, error) {
+
+	options := newRequestOptions(opts, defaultOpts)
+
+	return options.request(c.Client()).Get(options.url(path))
+}
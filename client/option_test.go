@@ -0,0 +1,128 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func (s *ClientTestSuite) TestFieldsOption() {
+
+	// Get hosts with only hostname
+	hosts, err := s.client.HostsOnCluster("test", Fields("Hosts/host_name"))
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), hosts)
+	for _, host := range hosts {
+		assert.NotEmpty(s.T(), host.HostInfo.Hostname)
+		assert.Empty(s.T(), host.HostInfo.Rack)
+	}
+
+	// Get requests sorted by id
+	requests, err := s.client.Requests("test", SortByDesc("Requests/id"))
+	assert.NoError(s.T(), err)
+	for i := 1; i < len(requests); i++ {
+		assert.True(s.T(), requests[i-1].RequestTaskInfo.Id > requests[i].RequestTaskInfo.Id)
+	}
+}
+
+func TestNewRequestOptions(t *testing.T) {
+
+	// Default fields
+	options := newRequestOptions(nil, []RequestOption{Fields("WidgetInfo/*")})
+	assert.Equal(t, []string{"WidgetInfo/*"}, options.fields)
+
+	// Fields given by caller replace the default fields
+	options = newRequestOptions([]RequestOption{Fields("WidgetInfo/id"), Fields("WidgetInfo/widget_name")}, []RequestOption{Fields("WidgetInfo/*")})
+	assert.Equal(t, []string{"WidgetInfo/id", "WidgetInfo/widget_name"}, options.fields)
+
+	// Predicates from the method are kept
+	options = newRequestOptions([]RequestOption{Fields("Hosts/host_name")}, []RequestOption{Where(Eq("Hosts/host_status", "HEALTHY"))})
+	assert.Equal(t, []string{"Hosts/host_name"}, options.fields)
+	assert.Equal(t, 1, len(options.predicates))
+}
+
+func TestRequestOptionsQueryParams(t *testing.T) {
+
+	options := newRequestOptions([]RequestOption{
+		Fields("Tasks/*"),
+		PageSize(10),
+		PageFrom(20),
+		SortByDesc("Tasks/id"),
+		SortBy("Tasks/start_time"),
+	}, nil)
+	request := options.request(New("http://ambari-server:8080/api/v1", "admin", "admin").Client())
+	assert.Equal(t, "Tasks/*", request.QueryParam.Get("fields"))
+	assert.Equal(t, "10", request.QueryParam.Get("page_size"))
+	assert.Equal(t, "20", request.QueryParam.Get("from"))
+	assert.Equal(t, "", request.QueryParam.Get("to"))
+	assert.Equal(t, "Tasks/id.desc,Tasks/start_time.asc", request.QueryParam.Get("sortBy"))
+
+	options = newRequestOptions([]RequestOption{PageSize(10), LastPage()}, nil)
+	request = options.request(New("http://ambari-server:8080/api/v1", "admin", "admin").Client())
+	assert.Equal(t, "end", request.QueryParam.Get("to"))
+	assert.Equal(t, "", request.QueryParam.Get("from"))
+}
+
+func TestHeaderAndQueryParamOptions(t *testing.T) {
+
+	options := newRequestOptions([]RequestOption{
+		Fields("Hosts/*"),
+		Header("X-Correlation-Id", "42"),
+		QueryParam("minimal_response", "true"),
+		QueryParam("fields", "Hosts/host_name"),
+	}, nil)
+	request := options.request(New("http://ambari-server:8080/api/v1", "admin", "admin").Client())
+	assert.Equal(t, "42", request.Header.Get("X-Correlation-Id"))
+	assert.Equal(t, "true", request.QueryParam.Get("minimal_response"))
+	assert.Equal(t, "Hosts/host_name", request.QueryParam.Get("fields"))
+}
+
+func TestWithOptions(t *testing.T) {
+
+	var lastRequest *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastRequest = r
+		if r.Method == "GET" {
+			w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+		}
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	// The options are sent on the changes
+	tracedClient := client.WithOptions(Header("X-Correlation-Id", "42"), QueryParam("trace", "true"), Fields("Clusters/*"))
+	err := tracedClient.DeleteCluster("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "DELETE", lastRequest.Method)
+	assert.Equal(t, "42", lastRequest.Header.Get("X-Correlation-Id"))
+	assert.Equal(t, "ambari", lastRequest.Header.Get("X-Requested-By"))
+	assert.Equal(t, "true", lastRequest.URL.Query().Get("trace"))
+	assert.Empty(t, lastRequest.URL.Query().Get("fields"))
+
+	// And on the get, with the options of the call
+	_, err = tracedClient.Cluster("test", Header("X-Correlation-Id", "43"))
+	assert.NoError(t, err)
+	assert.Equal(t, "43", lastRequest.Header.Get("X-Correlation-Id"))
+	assert.Equal(t, "true", lastRequest.URL.Query().Get("trace"))
+
+	// The client is not changed
+	_, err = client.Cluster("test")
+	assert.NoError(t, err)
+	assert.Empty(t, lastRequest.Header.Get("X-Correlation-Id"))
+	assert.Empty(t, lastRequest.URL.Query().Get("trace"))
+
+	// The transport of client is used by the copy, and it can't be changed on the copy
+	client.SetDryRun(NewDryRunRecorder())
+	assert.True(t, tracedClient.isDryRun())
+	client.SetDryRun(nil)
+	assert.False(t, tracedClient.isDryRun())
+	assert.Panics(t, func() { tracedClient.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2}) })
+	assert.Panics(t, func() { tracedClient.SetCache(time.Minute) })
+	assert.Panics(t, func() { tracedClient.SetDryRun(nil) })
+	assert.Panics(t, func() { tracedClient.SetAudit("admin", nil) })
+	assert.Panics(t, func() { tracedClient.SetBearerTokenAuth(StaticToken("token")) })
+	assert.Panics(t, func() { tracedClient.DisableVerifySSL() })
+	assert.NotPanics(t, func() { client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 2}) })
+}
@@ -0,0 +1,118 @@
+// This file permit to walk all pages of resources collection in Ambari API
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md#paging
+
+package client
+
+import (
+	"encoding/json"
+)
+
+// PageIterator permit to read a resources collection page by page
+//
+//	iterator := client.NewPageIterator("/clusters/test/requests", 100, Fields("Requests/*"))
+//	for iterator.Next() {
+//		requests := &RequestsTask{}
+//		if err := iterator.Decode(requests); err != nil {
+//			return err
+//		}
+//	}
+//	if iterator.Err() != nil {
+//		return iterator.Err()
+//	}
+type PageIterator struct {
+	client   *AmbariClient
+	path     string
+	pageSize int
+	opts     []RequestOption
+	from     int
+	page     []byte
+	done     bool
+	err      error
+}
+
+// NewPageIterator permit to create iterator on resources collection, like /clusters/test/requests
+// The options permit to set the fields or predicates. The page options are managed by the iterator.
+func (c *AmbariClient) NewPageIterator(path string, pageSize int, opts ...RequestOption) *PageIterator {
+
+	if path == "" {
+		panic("Path can't be empty")
+	}
+	if pageSize <= 0 {
+		panic("PageSize must be greater than 0")
+	}
+
+	return &PageIterator{
+		client:   c,
+		path:     path,
+		pageSize: pageSize,
+		opts:     opts,
+	}
+}
+
+// Next permit to read the next page
+// It return false when there are no more page or when error occurs. Use Err to know if there are error.
+func (p *PageIterator) Next() bool {
+
+	if p.done {
+		p.page = nil
+		return false
+	}
+	p.client.log.Debugf("Read page from %d on %s", p.from, p.path)
+
+	opts := make([]RequestOption, 0, len(p.opts)+2)
+	opts = append(opts, p.opts...)
+	opts = append(opts, PageSize(p.pageSize), PageFrom(p.from))
+	resp, err := p.client.get(p.path, opts)
+	if err != nil {
+		return p.stop(err)
+	}
+	p.client.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return p.stop(nil)
+		} else {
+			return p.stop(NewAmbariErrorFromResponse(resp))
+		}
+	}
+
+	page := &struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	err = json.Unmarshal(resp.Body(), page)
+	if err != nil {
+		return p.stop(err)
+	}
+	if len(page.Items) == 0 {
+		return p.stop(nil)
+	}
+
+	p.page = resp.Body()
+	p.from += len(page.Items)
+	if len(page.Items) < p.pageSize {
+		p.done = true
+	}
+
+	return true
+}
+
+// Decode permit to unmarshal the current page in object, like Hosts or RequestsTask
+func (p *PageIterator) Decode(object interface{}) error {
+
+	if p.page == nil {
+		return NewAmbariError(500, "There are no page to decode, you need to call Next before")
+	}
+
+	return json.Unmarshal(p.page, object)
+}
+
+// Err return the error that stop the iterator
+func (p *PageIterator) Err() error {
+	return p.err
+}
+
+func (p *PageIterator) stop(err error) bool {
+	p.done = true
+	p.page = nil
+	p.err = err
+	return false
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func (s *ClientTestSuite) TestPageIterator() {
+
+	requests, err := s.client.Requests("test")
+	assert.NoError(s.T(), err)
+
+	// Read all requests, 2 per page
+	nbRequests := 0
+	iterator := s.client.NewPageIterator("/clusters/test/requests", 2, Fields("Requests/*"))
+	for iterator.Next() {
+		requestsTask := &RequestsTask{}
+		err = iterator.Decode(requestsTask)
+		assert.NoError(s.T(), err)
+		assert.True(s.T(), len(requestsTask.Items) <= 2)
+		nbRequests += len(requestsTask.Items)
+	}
+	assert.NoError(s.T(), iterator.Err())
+	assert.Equal(s.T(), len(requests), nbRequests)
+
+	// Get only the last page
+	requests, err = s.client.Requests("test", PageSize(1), LastPage())
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, len(requests))
+}
+
+func TestPageIteratorWalkAllPages(t *testing.T) {
+
+	total := 5
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		pageSize, _ := strconv.Atoi(r.URL.Query().Get("page_size"))
+		body := `{"items":[`
+		for i := from; i < from+pageSize && i < total; i++ {
+			if i > from {
+				body += ","
+			}
+			body += fmt.Sprintf(`{"Requests":{"id":%d}}`, i)
+		}
+		body += "]}"
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	ids := make([]int, 0)
+	nbPages := 0
+	iterator := client.NewPageIterator("/clusters/test/requests", 2)
+	for iterator.Next() {
+		nbPages++
+		requestsTask := &RequestsTask{}
+		err := iterator.Decode(requestsTask)
+		assert.NoError(t, err)
+		for _, requestTask := range requestsTask.Items {
+			ids = append(ids, requestTask.RequestTaskInfo.Id)
+		}
+	}
+	assert.NoError(t, iterator.Err())
+	assert.Equal(t, 3, nbPages)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, ids)
+	assert.Error(t, iterator.Decode(&RequestsTask{}))
+}
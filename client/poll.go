@@ -0,0 +1,175 @@
+// This file permit to wait that Ambari reach the expected state, like when request is finished or when service is started
+
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PollPolicy permit to set how the client poll Ambari API when it wait a request or a state.
+// The first poll is done at once, then the wait time between two polls grow from MinInterval to MaxInterval, to not flood Ambari on long requests.
+type PollPolicy struct {
+	// MinInterval is the wait time after the first poll. It's doubled after each poll.
+	MinInterval time.Duration
+	// MaxInterval is the max wait time between two polls
+	MaxInterval time.Duration
+}
+
+// DefaultPollPolicy return the poll policy with the default values
+func DefaultPollPolicy() *PollPolicy {
+	return &PollPolicy{
+		MinInterval: 1 * time.Second,
+		MaxInterval: 10 * time.Second,
+	}
+}
+
+// SetPollPolicy permit to change how the client poll Ambari, for the requests and the states it wait
+// The nil policy restore the default policy
+func (c *AmbariClient) SetPollPolicy(policy *PollPolicy) {
+
+	if policy == nil {
+		policy = DefaultPollPolicy()
+	}
+	if policy.MinInterval <= 0 {
+		panic("MinInterval must be greater than 0")
+	}
+	if policy.MaxInterval < policy.MinInterval {
+		panic("MaxInterval can't be lower than MinInterval")
+	}
+	c.log.Debug("PollPolicy: ", *policy)
+
+	c.pollPolicy = policy
+}
+
+// interval return the wait time after the poll
+func (p *PollPolicy) interval(attempt int) time.Duration {
+
+	wait := p.MinInterval
+	for i := 1; i < attempt && wait < p.MaxInterval; i++ {
+		wait *= 2
+	}
+	if wait > p.MaxInterval {
+		wait = p.MaxInterval
+	}
+
+	return wait
+}
+
+// poll call the condition until it return true or error, with the poll policy of client between the calls
+// It return the error of context when it's done before
+func (c *AmbariClient) poll(ctx context.Context, condition func() (bool, error)) error {
+
+	policy := c.pollPolicy
+	if policy == nil {
+		policy = DefaultPollPolicy()
+	}
+
+	for attempt := 1; ; attempt++ {
+		isDone, err := condition()
+		if err != nil || isDone {
+			return err
+		}
+		if err = sleep(ctx, policy.interval(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// WaitForServiceState permit to wait that the service reach the state, like STARTED or INSTALLED
+// The context permit to cancel the wait or to set the timeout.
+// It return the service
+// It return error with code 408 if the context deadline is exceeded, the context error if it's canceled, or error if service not found or if something wrong when it call the API
+func (c *AmbariClient) WaitForServiceState(ctx context.Context, clusterName string, serviceName string, state string) (*Service, error) {
+
+	if ctx == nil {
+		panic("Ctx can't be nil")
+	}
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if state == "" {
+		panic("State can't be empty")
+	}
+	c.log.Debugw("WaitForServiceState", "cluster_name", clusterName, "service_name", serviceName, "state", state)
+
+	var service *Service
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		service, err = c.Service(clusterName, serviceName)
+		if err != nil {
+			return false, err
+		}
+		if service == nil {
+			return false, NewAmbariError(404, "Service %s not found in cluster %s", serviceName, clusterName)
+		}
+		c.log.Debugf("Service %s is %s, wait %s", serviceName, service.ServiceInfo.State, state)
+		return service.ServiceInfo.State == state, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) && service != nil {
+		return nil, NewAmbariError(408, "Service %s is %s, it not reach %s in time", serviceName, service.ServiceInfo.State, state)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+// WaitForHostComponentState permit to wait that the component on host reach the state, like STARTED or INSTALLED
+// The context permit to cancel the wait or to set the timeout.
+// It return the host component
+// It return error with code 408 if the context deadline is exceeded, the context error if it's canceled, or error if component not found on host or if something wrong when it call the API
+func (c *AmbariClient) WaitForHostComponentState(ctx context.Context, clusterName string, hostname string, componentName string, state string) (*HostComponent, error) {
+
+	if ctx == nil {
+		panic("Ctx can't be nil")
+	}
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if hostname == "" {
+		panic("Hostname can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	if state == "" {
+		panic("State can't be empty")
+	}
+	c.log.Debugw("WaitForHostComponentState", "cluster_name", clusterName, "hostname", hostname, "component_name", componentName, "state", state)
+
+	var hostComponent *HostComponent
+	err := c.poll(ctx, func() (bool, error) {
+		var err error
+		hostComponent, err = c.HostComponent(clusterName, hostname, componentName)
+		if err != nil {
+			return false, err
+		}
+		if hostComponent == nil {
+			return false, NewAmbariError(404, "Component %s not found on host %s in cluster %s", componentName, hostname, clusterName)
+		}
+		c.log.Debugf("Component %s on host %s is %s, wait %s", componentName, hostname, hostComponent.HostComponentInfo.State, state)
+		return hostComponent.HostComponentInfo.State == state, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) && hostComponent != nil {
+		return nil, NewAmbariError(408, "Component %s on host %s is %s, it not reach %s in time", componentName, hostname, hostComponent.HostComponentInfo.State, state)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return hostComponent, nil
+}
+
+// waitTimeout return the context that stop after the request timeout of client, if it's set
+func (c *AmbariClient) waitTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.requestTimeout > 0 {
+		return context.WithTimeout(ctx, c.requestTimeout)
+	}
+	return context.WithCancel(ctx)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPollPolicyInterval(t *testing.T) {
+
+	policy := &PollPolicy{MinInterval: time.Second, MaxInterval: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.interval(1))
+	assert.Equal(t, 2*time.Second, policy.interval(2))
+	assert.Equal(t, 4*time.Second, policy.interval(3))
+	assert.Equal(t, 5*time.Second, policy.interval(4))
+	assert.Equal(t, 5*time.Second, policy.interval(100))
+
+	client := New("http://localhost", "admin", "admin")
+	assert.Panics(t, func() { client.SetPollPolicy(&PollPolicy{}) })
+	assert.Panics(t, func() { client.SetPollPolicy(&PollPolicy{MinInterval: time.Second, MaxInterval: time.Millisecond}) })
+	client.SetPollPolicy(nil)
+	assert.Equal(t, DefaultPollPolicy(), client.pollPolicy)
+}
+
+func TestWaitForServiceState(t *testing.T) {
+
+	// The service is started on the third call
+	var nbCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := SERVICE_INSTALLED
+		if atomic.AddInt32(&nbCalls, 1) >= 3 {
+			state = SERVICE_STARTED
+		}
+		fmt.Fprintf(w, `{"ServiceInfo": {"cluster_name": "test", "service_name": "HDFS", "state": "%s"}}`, state)
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+	client.SetPollPolicy(&PollPolicy{MinInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond})
+
+	service, err := client.WaitForServiceState(context.Background(), "test", "HDFS", SERVICE_STARTED)
+	assert.NoError(t, err)
+	assert.NotNil(t, service)
+	assert.Equal(t, SERVICE_STARTED, service.ServiceInfo.State)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&nbCalls))
+
+	// Timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForServiceState(ctx, "test", "HDFS", SERVICE_INSTALLED)
+	assert.True(t, IsTimeout(err))
+
+	// Canceled
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = client.WaitForServiceState(ctx, "test", "HDFS", SERVICE_INSTALLED)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestWaitForHostComponentState(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/clusters/test/hosts/worker01/host_components/DATANODE" {
+			w.Write([]byte(`{"HostRoles": {"cluster_name": "test", "host_name": "worker01", "component_name": "DATANODE", "state": "STARTED"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+	client.SetPollPolicy(&PollPolicy{MinInterval: time.Millisecond, MaxInterval: time.Millisecond})
+
+	hostComponent, err := client.WaitForHostComponentState(context.Background(), "test", "worker01", "DATANODE", SERVICE_STARTED)
+	assert.NoError(t, err)
+	assert.NotNil(t, hostComponent)
+
+	// Not found
+	_, err = client.WaitForHostComponentState(context.Background(), "test", "worker02", "DATANODE", SERVICE_STARTED)
+	assert.True(t, IsNotFound(err))
+}
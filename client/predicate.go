@@ -0,0 +1,162 @@
+// This file permit to build the predicate used by Ambari API to filter the resources
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md#query-predicates
+
+package client
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Predicate is a filter expression with the Ambari query syntax, like HostRoles/state=STARTED&Hosts/rack_info!=default
+// Use the functions Eq, NotEq, Lt, Le, Gt, Ge, In, IsEmpty, And, Or and Not to build it.
+type Predicate struct {
+	expression string
+	operator   string
+}
+
+// String return the predicate with the Ambari query syntax
+func (p *Predicate) String() string {
+	return p.expression
+}
+
+// Eq permit to match resources where field is equal to value
+func Eq(field string, value string) *Predicate {
+	return relational(field, "=", value)
+}
+
+// NotEq permit to match resources where field is not equal to value
+func NotEq(field string, value string) *Predicate {
+	return relational(field, "!=", value)
+}
+
+// Lt permit to match resources where field is less than value
+func Lt(field string, value string) *Predicate {
+	return relational(field, "<", value)
+}
+
+// Le permit to match resources where field is less or equal than value
+func Le(field string, value string) *Predicate {
+	return relational(field, "<=", value)
+}
+
+// Gt permit to match resources where field is greater than value
+func Gt(field string, value string) *Predicate {
+	return relational(field, ">", value)
+}
+
+// Ge permit to match resources where field is greater or equal than value
+func Ge(field string, value string) *Predicate {
+	return relational(field, ">=", value)
+}
+
+// In permit to match resources where field is one of values
+func In(field string, values ...string) *Predicate {
+	if field == "" {
+		panic("Field can't be empty")
+	}
+	if len(values) == 0 {
+		panic("Values can't be empty")
+	}
+	return &Predicate{
+		expression: fmt.Sprintf("%s.in(%s)", field, strings.Join(values, ",")),
+	}
+}
+
+// IsEmpty permit to match resources where the category field is empty
+func IsEmpty(field string) *Predicate {
+	if field == "" {
+		panic("Field can't be empty")
+	}
+	return &Predicate{
+		expression: fmt.Sprintf("%s.isEmpty()", field),
+	}
+}
+
+// And permit to match resources that match all predicates
+func And(predicates ...*Predicate) *Predicate {
+	return logical("&", predicates)
+}
+
+// Or permit to match resources that match at least one predicate
+func Or(predicates ...*Predicate) *Predicate {
+	return logical("|", predicates)
+}
+
+// Not permit to match resources that not match the predicate
+func Not(predicate *Predicate) *Predicate {
+	if predicate == nil {
+		panic("Predicate can't be nil")
+	}
+	return &Predicate{
+		expression: fmt.Sprintf("!(%s)", predicate.expression),
+	}
+}
+
+// Where permit to filter the resources returned by Ambari with predicate
+// When call many times, the resources must match all predicates
+func Where(predicate *Predicate) RequestOption {
+	return func(options *requestOptions) {
+		if predicate != nil {
+			options.predicates = append(options.predicates, predicate)
+		}
+	}
+}
+
+func relational(field string, operator string, value string) *Predicate {
+	if field == "" {
+		panic("Field can't be empty")
+	}
+	return &Predicate{
+		expression: field + operator + value,
+	}
+}
+
+// logical join the predicates with operator.
+// The NOT operator has the highest precedence, followed by AND and then OR, so only OR need to be grouped inside AND.
+func logical(operator string, predicates []*Predicate) *Predicate {
+
+	expressions := make([]string, 0, len(predicates))
+	for _, predicate := range predicates {
+		if predicate == nil {
+			continue
+		}
+		if operator == "&" && predicate.operator == "|" {
+			expressions = append(expressions, fmt.Sprintf("(%s)", predicate.expression))
+		} else {
+			expressions = append(expressions, predicate.expression)
+		}
+	}
+	if len(expressions) == 0 {
+		panic("Predicates can't be empty")
+	}
+	if len(expressions) == 1 {
+		for _, predicate := range predicates {
+			if predicate != nil {
+				return predicate
+			}
+		}
+	}
+
+	return &Predicate{
+		expression: strings.Join(expressions, operator),
+		operator:   operator,
+	}
+}
+
+// escapePredicate permit to put the predicate in URL.
+// Ambari decode the query string before to parse it, so only the characters not allowed in URL are escaped.
+func escapePredicate(expression string) string {
+
+	var builder strings.Builder
+	for i := 0; i < len(expression); i++ {
+		char := expression[i]
+		if (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9') || strings.IndexByte("-_.~/=!&|(),*:@$'", char) >= 0 {
+			builder.WriteByte(char)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", char)
+		}
+	}
+
+	return builder.String()
+}
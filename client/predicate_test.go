@@ -0,0 +1,53 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func (s *ClientTestSuite) TestPredicate() {
+
+	// Get hosts with predicate
+	hosts, err := s.client.HostsOnCluster("test", Where(Or(Eq("Hosts/host_name", "ambari-agent"), Eq("Hosts/host_name", "ambari-agent2"))))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, len(hosts))
+
+	hosts, err = s.client.HostsOnCluster("test", Where(In("Hosts/host_name", "ambari-agent", "ambari-agent3")), Where(NotEq("Hosts/host_name", "ambari-agent")))
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, len(hosts))
+	if len(hosts) == 1 {
+		assert.Equal(s.T(), "ambari-agent3", hosts[0].HostInfo.Hostname)
+	}
+}
+
+func TestPredicateString(t *testing.T) {
+
+	assert.Equal(t, "HostRoles/state=STARTED", Eq("HostRoles/state", "STARTED").String())
+	assert.Equal(t, "Hosts/rack_info!=default", NotEq("Hosts/rack_info", "default").String())
+	assert.Equal(t, "Hosts/total_mem<1024", Lt("Hosts/total_mem", "1024").String())
+	assert.Equal(t, "Hosts/total_mem<=1024", Le("Hosts/total_mem", "1024").String())
+	assert.Equal(t, "Hosts/total_mem>1024", Gt("Hosts/total_mem", "1024").String())
+	assert.Equal(t, "Hosts/total_mem>=1024", Ge("Hosts/total_mem", "1024").String())
+	assert.Equal(t, "HostRoles/component_name.in(NAMENODE,DATANODE)", In("HostRoles/component_name", "NAMENODE", "DATANODE").String())
+	assert.Equal(t, "ServiceInfo/alerts.isEmpty()", IsEmpty("ServiceInfo/alerts").String())
+	assert.Equal(t, "!(Hosts/host_name=host1)", Not(Eq("Hosts/host_name", "host1")).String())
+
+	// Precedence
+	assert.Equal(t, "HostRoles/state=STARTED&Hosts/rack_info!=default", And(Eq("HostRoles/state", "STARTED"), NotEq("Hosts/rack_info", "default")).String())
+	assert.Equal(t, "a=1&(b=2|c=3)", And(Eq("a", "1"), Or(Eq("b", "2"), Eq("c", "3"))).String())
+	assert.Equal(t, "a=1&b=2|c=3", Or(And(Eq("a", "1"), Eq("b", "2")), Eq("c", "3")).String())
+	assert.Equal(t, "!(a=1|b=2)", Not(Or(Eq("a", "1"), Eq("b", "2"))).String())
+
+	// And with only one predicate
+	assert.Equal(t, "a=1|b=2", And(Or(Eq("a", "1"), Eq("b", "2"))).String())
+}
+
+func TestPredicateUrl(t *testing.T) {
+
+	options := newRequestOptions([]RequestOption{Where(Or(Eq("a", "1"), Lt("b", "2")))}, []RequestOption{Where(Eq("c", "value with space"))})
+	assert.Equal(t, "/clusters/test/hosts?c=value%20with%20space&(a=1|b%3C2)", options.url("/clusters/test/hosts"))
+	assert.Equal(t, "/clusters/test/hosts?fields=*&c=value%20with%20space&(a=1|b%3C2)", options.url("/clusters/test/hosts?fields=*"))
+
+	options = newRequestOptions(nil, nil)
+	assert.Equal(t, "/clusters/test/hosts", options.url("/clusters/test/hosts"))
+}
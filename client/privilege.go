@@ -6,7 +6,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 // Privilege object
@@ -23,6 +22,11 @@ type PrivilegeInfo struct {
 	PermissionName  string `json:"permission_name"`
 	PrincipalName   string `json:"principal_name"`
 	PrincipalType   string `json:"principal_type"`
+	Type            string `json:"type,omitempty"`
+	ClusterName     string `json:"cluster_name,omitempty"`
+	ViewName        string `json:"view_name,omitempty"`
+	Version         string `json:"version,omitempty"`
+	InstanceName    string `json:"instance_name,omitempty"`
 }
 
 // String return privilege object as Json string
@@ -35,25 +39,24 @@ func (p *Privilege) String() string {
 // It return the pivilege if is found
 // It return nil if not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) Privilege(clusterName string, id int64) (*Privilege, error) {
+func (c *AmbariClient) Privilege(clusterName string, id int64, opts ...RequestOption) (*Privilege, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Id: ", id)
+	c.log.Debugw("Privilege", "cluster_name", clusterName, "id", id)
 
 	path := fmt.Sprintf("/clusters/%s/privileges/%d", clusterName, id)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Result : ", resp)
+	c.log.Debug("Result : ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	privilege := &Privilege{}
@@ -62,7 +65,7 @@ func (c *AmbariClient) Privilege(clusterName string, id int64) (*Privilege, erro
 		return nil, err
 	}
 
-	log.Debug("Privilege: ", privilege)
+	c.log.Debug("Privilege: ", privilege)
 
 	return privilege, nil
 
@@ -79,8 +82,13 @@ func (c *AmbariClient) CreatePrivilege(clusterName string, privilege *Privilege)
 	if privilege == nil {
 		panic("Privilege can't be nil")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Privilege :", privilege)
+	c.log.Debugw("CreatePrivilege", "cluster_name", clusterName)
+	c.log.Debug("Privilege :", privilege)
+	if c.validation {
+		if err := ValidatePrivilege(privilege); err != nil {
+			return nil, err
+		}
+	}
 
 	// Create the privilege
 	path := fmt.Sprintf("/clusters/%s/privileges", clusterName)
@@ -92,9 +100,9 @@ func (c *AmbariClient) CreatePrivilege(clusterName string, privilege *Privilege)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the privilege
@@ -117,16 +125,16 @@ func (c *AmbariClient) DeletePrivilege(clusterName string, id int64) error {
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("DeletePrivilege", "cluster_name", clusterName)
 
 	path := fmt.Sprintf("/clusters/%s/privileges/%d", clusterName, id)
 	resp, err := c.Client().R().Delete(path)
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete privilege: ", resp)
+	c.log.Debug("Response to delete privilege: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -142,8 +150,12 @@ func (c *AmbariClient) UpdatePrivilege(clusterName string, privilege *Privilege)
 	if privilege == nil {
 		panic("Privilege can't be nil")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Privilege: ", privilege)
+	c.log.Debugw("UpdatePrivilege", "cluster_name", clusterName, "privilege", privilege)
+	if c.validation {
+		if err := ValidatePrivilege(privilege); err != nil {
+			return nil, err
+		}
+	}
 
 	// Update the privilege
 	path := fmt.Sprintf("/clusters/%s/privileges/%d", clusterName, privilege.PrivilegeInfo.PrivilegeId)
@@ -155,9 +167,9 @@ func (c *AmbariClient) UpdatePrivilege(clusterName string, privilege *Privilege)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the privilege because id and permission label change after update
@@ -177,7 +189,7 @@ func (c *AmbariClient) UpdatePrivilege(clusterName string, privilege *Privilege)
 // It return privielege if is found
 // It return nil if is not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) SearchPrivilege(clusterName string, permissionName string, principalName string, principalType string) (*Privilege, error) {
+func (c *AmbariClient) SearchPrivilege(clusterName string, permissionName string, principalName string, principalType string, opts ...RequestOption) (*Privilege, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -191,26 +203,23 @@ func (c *AmbariClient) SearchPrivilege(clusterName string, permissionName string
 	if principalType == "" {
 		panic("PrincipalType can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("PermissionName: ", permissionName)
-	log.Debug("PrincipalName: ", principalName)
-	log.Debug("PrincipalType: ", principalType)
+	c.log.Debugw("SearchPrivilege", "cluster_name", clusterName, "permission_name", permissionName, "principal_name", principalName, "principal_type", principalType)
 
 	path := fmt.Sprintf("/clusters/%s/privileges", clusterName)
-	resp, err := c.Client().R().SetQueryParams(map[string]string{
-		"PrivilegeInfo/permission_name": permissionName,
-		"PrivilegeInfo/principal_name":  principalName,
-		"PrivilegeInfo/principal_type":  principalType,
-	}).Get(path)
+	resp, err := c.get(path, opts, Where(And(
+		Eq("PrivilegeInfo/permission_name", permissionName),
+		Eq("PrivilegeInfo/principal_name", principalName),
+		Eq("PrivilegeInfo/principal_type", principalType),
+	)))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	privilegeResponses := &PrivilegesResponse{}
@@ -218,12 +227,185 @@ func (c *AmbariClient) SearchPrivilege(clusterName string, permissionName string
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("PrivilegesResponse: ", privilegeResponses)
+	c.log.Debug("PrivilegesResponse: ", privilegeResponses)
 
 	if len(privilegeResponses.Items) > 0 {
-		log.Debug("Privilege: ", privilegeResponses.Items[0])
+		c.log.Debug("Privilege: ", privilegeResponses.Items[0])
 		return &privilegeResponses.Items[0], nil
 	} else {
 		return nil, nil
 	}
 }
+
+// Privileges return all privileges on cluster
+// It return the list of privileges.
+// If not privilege, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Privileges(clusterName string, opts ...RequestOption) ([]Privilege, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Privileges", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/privileges", clusterName)
+	resp, err := c.get(path, opts, Fields("PrivilegeInfo/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	privilegesResponse := &PrivilegesResponse{}
+	err = json.Unmarshal(resp.Body(), privilegesResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Privileges: ", privilegesResponse.Items)
+
+	return privilegesResponse.Items, nil
+}
+
+// ApplyPrivilege permit to give the permission to the principal on cluster
+// It create the privilege if the principal has not privilege, or it update it if the principal has another permission
+// If the principal has many privileges on cluster, the other privileges are deleted, so the principal has only this permission
+// It return the change report, that is empty if the principal has already only this permission
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ApplyPrivilege(clusterName string, privilege *Privilege) (*ChangeReport, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if privilege == nil {
+		panic("Privilege can't be nil")
+	}
+	c.log.Debugw("ApplyPrivilege", "cluster_name", clusterName, "privilege", privilege)
+
+	privileges, err := c.Privileges(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	hasPermission := false
+	otherPrivileges := make([]Privilege, 0)
+	for _, p := range privileges {
+		if p.PrivilegeInfo.PrincipalName == privilege.PrivilegeInfo.PrincipalName && p.PrivilegeInfo.PrincipalType == privilege.PrivilegeInfo.PrincipalType {
+			if p.PrivilegeInfo.PermissionName == privilege.PrivilegeInfo.PermissionName && !hasPermission {
+				c.log.Debug("Privilege already exist")
+				hasPermission = true
+			} else {
+				otherPrivileges = append(otherPrivileges, p)
+			}
+		}
+	}
+
+	// The first other privilege is updated if the principal has not the permission, the next ones are deleted
+	report := newChangeReport()
+	var currentPrivilege *Privilege
+	if !hasPermission {
+		if len(otherPrivileges) == 0 {
+			report.add(CHANGE_CREATE, "privilege", privilege.key(), nil, privilege)
+		} else {
+			currentPrivilege = &otherPrivileges[0]
+			otherPrivileges = otherPrivileges[1:]
+			report.add(CHANGE_UPDATE, "privilege", privilege.key(), currentPrivilege, privilege)
+		}
+	}
+	deletedPrivileges := make(map[string]Privilege, len(otherPrivileges))
+	for _, p := range otherPrivileges {
+		deletedPrivileges[p.key()] = p
+		report.add(CHANGE_DELETE, "privilege", p.key(), p, nil)
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			_, err = c.CreatePrivilege(clusterName, privilege)
+		case CHANGE_UPDATE:
+			_, err = c.UpdatePrivilege(clusterName, &Privilege{
+				PrivilegeInfo: &PrivilegeInfo{
+					PrivilegeId:    currentPrivilege.PrivilegeInfo.PrivilegeId,
+					PermissionName: privilege.PrivilegeInfo.PermissionName,
+					PrincipalName:  privilege.PrivilegeInfo.PrincipalName,
+					PrincipalType:  privilege.PrivilegeInfo.PrincipalType,
+				},
+			})
+		case CHANGE_DELETE:
+			err = c.DeletePrivilege(clusterName, deletedPrivileges[change.Name].PrivilegeInfo.PrivilegeId)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// ApplyPrivileges permit to set all privileges on cluster
+// It create the privileges that not exist and delete the privileges that are not in the list
+// It return the change report, that is empty if the cluster has already this privileges
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ApplyPrivileges(clusterName string, privileges []Privilege) (*ChangeReport, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ApplyPrivileges", "cluster_name", clusterName, "privileges", privileges)
+
+	currentPrivileges, err := c.Privileges(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]Privilege, len(currentPrivileges))
+	for _, privilege := range currentPrivileges {
+		current[privilege.key()] = privilege
+	}
+	desired := make(map[string]Privilege, len(privileges))
+	for _, privilege := range privileges {
+		desired[privilege.key()] = privilege
+	}
+
+	report := newChangeReport()
+	for _, privilege := range privileges {
+		if _, ok := current[privilege.key()]; !ok {
+			report.add(CHANGE_CREATE, "privilege", privilege.key(), nil, privilege)
+		}
+	}
+	for _, privilege := range currentPrivileges {
+		if _, ok := desired[privilege.key()]; !ok {
+			report.add(CHANGE_DELETE, "privilege", privilege.key(), privilege, nil)
+		}
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			privilege := desired[change.Name]
+			_, err = c.CreatePrivilege(clusterName, &privilege)
+		case CHANGE_DELETE:
+			err = c.DeletePrivilege(clusterName, current[change.Name].PrivilegeInfo.PrivilegeId)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// key return the unique name of the privilege on cluster, like USER:admin:CLUSTER.ADMINISTRATOR
+func (p *Privilege) key() string {
+	return fmt.Sprintf("%s:%s:%s", p.PrivilegeInfo.PrincipalType, p.PrivilegeInfo.PrincipalName, p.PrivilegeInfo.PermissionName)
+}
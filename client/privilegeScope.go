@@ -0,0 +1,176 @@
+// This file permit to manage the privileges given on Ambari itself (like AMBARI.ADMINISTRATOR) and on view instances (like VIEW.USER)
+// The privileges on cluster are managed in privilege.go
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// The scope of privilege, given in PrivilegeInfo.Type when the privileges are read from user or group
+const (
+	PRIVILEGE_TYPE_AMBARI  = "AMBARI"
+	PRIVILEGE_TYPE_CLUSTER = "CLUSTER"
+	PRIVILEGE_TYPE_VIEW    = "VIEW"
+)
+
+// AmbariPrivileges return all privileges on Ambari
+// It return the list of privileges
+// It return error if something wrong when it call the API
+func (c *AmbariClient) AmbariPrivileges(opts ...RequestOption) ([]Privilege, error) {
+	return c.scopedPrivileges("/privileges", opts)
+}
+
+// CreateAmbariPrivilege permit to give permission on Ambari, like AMBARI.ADMINISTRATOR
+// It return the privilege if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateAmbariPrivilege(privilege *Privilege) (*Privilege, error) {
+
+	if privilege == nil {
+		panic("Privilege can't be nil")
+	}
+	c.log.Debugw("CreateAmbariPrivilege", "privilege", privilege)
+
+	return c.createScopedPrivilege("/privileges", privilege)
+}
+
+// DeleteAmbariPrivilege permit to remove permission on Ambari
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteAmbariPrivilege(id int64) error {
+	c.log.Debugw("DeleteAmbariPrivilege", "id", id)
+
+	return c.deleteScopedPrivilege(fmt.Sprintf("/privileges/%d", id))
+}
+
+// ViewPrivileges return all privileges on view instance
+// It return the list of privileges
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ViewPrivileges(viewName string, version string, instanceName string, opts ...RequestOption) ([]Privilege, error) {
+
+	c.log.Debugw("ViewPrivileges", "view_name", viewName, "version", version, "instance_name", instanceName)
+
+	return c.scopedPrivileges(viewPrivilegesPath(viewName, version, instanceName), opts)
+}
+
+// CreateViewPrivilege permit to give permission on view instance, like VIEW.USER
+// It return the privilege if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateViewPrivilege(viewName string, version string, instanceName string, privilege *Privilege) (*Privilege, error) {
+
+	if privilege == nil {
+		panic("Privilege can't be nil")
+	}
+	c.log.Debugw("CreateViewPrivilege", "view_name", viewName, "version", version, "instance_name", instanceName, "privilege", privilege)
+
+	return c.createScopedPrivilege(viewPrivilegesPath(viewName, version, instanceName), privilege)
+}
+
+// DeleteViewPrivilege permit to remove permission on view instance
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteViewPrivilege(viewName string, version string, instanceName string, id int64) error {
+
+	c.log.Debugw("DeleteViewPrivilege", "view_name", viewName, "version", version, "instance_name", instanceName, "id", id)
+
+	return c.deleteScopedPrivilege(fmt.Sprintf("%s/%d", viewPrivilegesPath(viewName, version, instanceName), id))
+}
+
+// FindPrivilege return the privilege that give the permission to the principal
+// It return nil if not found
+func FindPrivilege(privileges []Privilege, permissionName string, principalName string, principalType string) *Privilege {
+
+	for i, privilege := range privileges {
+		if privilege.PrivilegeInfo != nil && privilege.PrivilegeInfo.PermissionName == permissionName && privilege.PrivilegeInfo.PrincipalName == principalName && privilege.PrivilegeInfo.PrincipalType == principalType {
+			return &privileges[i]
+		}
+	}
+
+	return nil
+}
+
+func viewPrivilegesPath(viewName string, version string, instanceName string) string {
+
+	if viewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if version == "" {
+		panic("Version can't be empty")
+	}
+	if instanceName == "" {
+		panic("InstanceName can't be empty")
+	}
+
+	return fmt.Sprintf("/views/%s/versions/%s/instances/%s/privileges", viewName, version, instanceName)
+}
+
+func (c *AmbariClient) scopedPrivileges(path string, opts []RequestOption) ([]Privilege, error) {
+
+	resp, err := c.get(path, opts, Fields("PrivilegeInfo/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	privilegesResponse := &PrivilegesResponse{}
+	err = json.Unmarshal(resp.Body(), privilegesResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Privileges: ", privilegesResponse.Items)
+
+	return privilegesResponse.Items, nil
+}
+
+func (c *AmbariClient) createScopedPrivilege(path string, privilege *Privilege) (*Privilege, error) {
+
+	if c.validation {
+		if err := ValidatePrivilege(privilege); err != nil {
+			return nil, err
+		}
+	}
+
+	jsonData, err := json.Marshal(privilege)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the privilege
+	privileges, err := c.scopedPrivileges(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	createdPrivilege := FindPrivilege(privileges, privilege.PrivilegeInfo.PermissionName, privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PrincipalType)
+	if createdPrivilege == nil {
+		return nil, NewAmbariError(500, "Can't get privilege that just created")
+	}
+
+	return createdPrivilege, nil
+}
+
+func (c *AmbariClient) deleteScopedPrivilege(path string) error {
+
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete privilege: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestScopedPrivileges(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Ambari
+	privilege, err := client.CreateAmbariPrivilege(&Privilege{
+		PrivilegeInfo: &PrivilegeInfo{
+			PermissionName: "AMBARI.ADMINISTRATOR",
+			PrincipalName:  "admins",
+			PrincipalType:  "GROUP",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, privilege)
+	ambariPrivileges, err := client.AmbariPrivileges()
+	assert.NoError(t, err)
+	assert.NotNil(t, FindPrivilege(ambariPrivileges, "AMBARI.ADMINISTRATOR", "admins", "GROUP"))
+	assert.Nil(t, FindPrivilege(ambariPrivileges, "AMBARI.ADMINISTRATOR", "admins", "USER"))
+	if privilege != nil {
+		assert.NoError(t, client.DeleteAmbariPrivilege(privilege.PrivilegeInfo.PrivilegeId))
+		assert.True(t, IsNotFound(client.DeleteAmbariPrivilege(privilege.PrivilegeInfo.PrivilegeId)))
+	}
+
+	// View
+	privilege, err = client.CreateViewPrivilege("FILES", "1.0.0", "files", &Privilege{
+		PrivilegeInfo: &PrivilegeInfo{
+			PermissionName: "VIEW.USER",
+			PrincipalName:  "users",
+			PrincipalType:  "GROUP",
+		},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, privilege)
+	viewPrivileges, err := client.ViewPrivileges("FILES", "1.0.0", "files")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(viewPrivileges))
+	if privilege != nil {
+		assert.NoError(t, client.DeleteViewPrivilege("FILES", "1.0.0", "files", privilege.PrivilegeInfo.PrivilegeId))
+	}
+	viewPrivileges, err = client.ViewPrivileges("FILES", "1.0.0", "files")
+	assert.NoError(t, err)
+	assert.Empty(t, viewPrivileges)
+}
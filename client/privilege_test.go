@@ -45,3 +45,53 @@ func (s *ClientTestSuite) TestPrivilege() {
 	assert.NoError(s.T(), err)
 
 }
+
+func (s *ClientTestSuite) TestApplyPrivilege() {
+
+	privilege := &Privilege{
+		PrivilegeInfo: &PrivilegeInfo{
+			PermissionName: "CLUSTER.USER",
+			PrincipalName:  "admin",
+			PrincipalType:  "USER",
+		},
+	}
+
+	// Create privilege
+	report, err := s.client.ApplyPrivilege("test", privilege)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), report)
+	if report != nil {
+		assert.Equal(s.T(), 1, len(report.Changes))
+		assert.Equal(s.T(), CHANGE_CREATE, report.Changes[0].Action)
+	}
+
+	// Already exist
+	report, err = s.client.ApplyPrivilege("test", privilege)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), report)
+	if report != nil {
+		assert.False(s.T(), report.HasChanges())
+	}
+
+	// Update permission
+	privilege.PrivilegeInfo.PermissionName = "CLUSTER.OPERATOR"
+	report, err = s.client.ApplyPrivilege("test", privilege)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), report)
+	if report != nil {
+		assert.Equal(s.T(), 1, len(report.Changes))
+		assert.Equal(s.T(), CHANGE_UPDATE, report.Changes[0].Action)
+	}
+
+	// Remove all privileges
+	report, err = s.client.ApplyPrivileges("test", []Privilege{})
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), report)
+	if report != nil {
+		assert.Equal(s.T(), 1, len(report.Changes))
+		assert.Equal(s.T(), CHANGE_DELETE, report.Changes[0].Action)
+	}
+	privileges, err := s.client.Privileges("test")
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), privileges)
+}
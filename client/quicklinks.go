@@ -0,0 +1,122 @@
+// This file permit to manage the quick links profile in Ambari API
+// The profile is stored by Ambari as setting named quicklinks_profile and it apply on all clusters.
+// Ambari documentation: https://cwiki.apache.org/confluence/display/AMBARI/Quick+Links+Profiles
+
+package client
+
+import (
+	"encoding/json"
+)
+
+const (
+	SETTING_QUICKLINKS_PROFILE = "quicklinks_profile"
+)
+
+// QuickLinksProfile object
+type QuickLinksProfile struct {
+	Filters  []QuickLinksFilter  `json:"filters,omitempty"`
+	Services []QuickLinksService `json:"services,omitempty"`
+}
+type QuickLinksService struct {
+	Name       string                `json:"name"`
+	Filters    []QuickLinksFilter    `json:"filters,omitempty"`
+	Components []QuickLinksComponent `json:"components,omitempty"`
+}
+type QuickLinksComponent struct {
+	Name    string             `json:"name"`
+	Filters []QuickLinksFilter `json:"filters,omitempty"`
+}
+
+// QuickLinksFilter permit to show or hide quick links.
+// Without link name and link attribute, the filter match all links.
+// LinkUrl permit to override the url of the link matched by link name
+type QuickLinksFilter struct {
+	LinkName      string `json:"link_name,omitempty"`
+	LinkAttribute string `json:"link_attribute,omitempty"`
+	LinkUrl       string `json:"link_url,omitempty"`
+	Visible       bool   `json:"visible"`
+}
+
+// String return quick links profile object as Json string
+func (q *QuickLinksProfile) String() string {
+	json, _ := json.Marshal(q)
+	return string(json)
+}
+
+// QuickLinksProfile return the current quick links profile
+// It return the profile if is found
+// It return nil if there are no profile
+// It return error if something wrong when it call the API
+func (c *AmbariClient) QuickLinksProfile() (*QuickLinksProfile, error) {
+
+	setting, err := c.Setting(SETTING_QUICKLINKS_PROFILE)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, nil
+	}
+
+	quickLinksProfile := &QuickLinksProfile{}
+	err = json.Unmarshal([]byte(setting.SettingInfo.Content), quickLinksProfile)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return quick links profile: %s", quickLinksProfile)
+
+	return quickLinksProfile, nil
+}
+
+// SaveQuickLinksProfile permit to set the quick links profile
+// It create the profile if not exist or update it
+// It return the profile if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SaveQuickLinksProfile(quickLinksProfile *QuickLinksProfile) (*QuickLinksProfile, error) {
+
+	if quickLinksProfile == nil {
+		panic("QuickLinksProfile can't be nil")
+	}
+	c.log.Debugw("SaveQuickLinksProfile", "quick_links_profile", quickLinksProfile)
+
+	content, err := json.Marshal(quickLinksProfile)
+	if err != nil {
+		return nil, err
+	}
+	setting := &Setting{
+		SettingInfo: &SettingInfo{
+			Name:        SETTING_QUICKLINKS_PROFILE,
+			SettingType: SETTING_TYPE_AMBARI_SERVER,
+			Content:     string(content),
+		},
+	}
+
+	currentSetting, err := c.Setting(SETTING_QUICKLINKS_PROFILE)
+	if err != nil {
+		return nil, err
+	}
+	if currentSetting == nil {
+		_, err = c.CreateSetting(setting)
+	} else {
+		_, err = c.UpdateSetting(setting)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the profile
+	quickLinksProfile, err = c.QuickLinksProfile()
+	if err != nil {
+		return nil, err
+	}
+	if quickLinksProfile == nil {
+		return nil, NewAmbariError(500, "Can't get quick links profile that just saved")
+	}
+
+	return quickLinksProfile, nil
+}
+
+// DeleteQuickLinksProfile permit to remove the quick links profile, so Ambari display again all quick links
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteQuickLinksProfile() error {
+	return c.DeleteSetting(SETTING_QUICKLINKS_PROFILE)
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestQuickLinksProfile() {
+
+	// Create profile
+	quickLinksProfile := &QuickLinksProfile{
+		Filters: []QuickLinksFilter{
+			{
+				Visible: true,
+			},
+		},
+		Services: []QuickLinksService{
+			{
+				Name: "HDFS",
+				Components: []QuickLinksComponent{
+					{
+						Name: "NAMENODE",
+						Filters: []QuickLinksFilter{
+							{
+								LinkName: "namenode_ui",
+								LinkUrl:  "https://proxy.domain.com/namenode",
+								Visible:  true,
+							},
+							{
+								LinkName: "namenode_logs",
+								Visible:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	quickLinksProfile, err := s.client.SaveQuickLinksProfile(quickLinksProfile)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), quickLinksProfile)
+	if quickLinksProfile != nil {
+		assert.Equal(s.T(), 1, len(quickLinksProfile.Services))
+		assert.Equal(s.T(), "https://proxy.domain.com/namenode", quickLinksProfile.Services[0].Components[0].Filters[0].LinkUrl)
+	}
+
+	// Update profile
+	quickLinksProfile.Services[0].Components[0].Filters[1].Visible = true
+	quickLinksProfile, err = s.client.SaveQuickLinksProfile(quickLinksProfile)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), quickLinksProfile)
+	if quickLinksProfile != nil {
+		assert.True(s.T(), quickLinksProfile.Services[0].Components[0].Filters[1].Visible)
+	}
+
+	// Get profile
+	quickLinksProfile, err = s.client.QuickLinksProfile()
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), quickLinksProfile)
+
+	// Delete profile
+	err = s.client.DeleteQuickLinksProfile()
+	assert.NoError(s.T(), err)
+	quickLinksProfile, err = s.client.QuickLinksProfile()
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), quickLinksProfile)
+}
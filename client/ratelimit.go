@@ -0,0 +1,68 @@
+// This file permit to limit the number of calls on Ambari API, to not overload Ambari when there are many calls like in bulk operations
+
+package client
+
+import (
+	"golang.org/x/time/rate"
+	"net/http"
+)
+
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+}
+
+// SetRateLimit permit to limit the number of calls per second on Ambari API
+// The burst is the number of calls that can be done at once, before to be limited. The limit is shared by all goroutines that use the client.
+// Set 0 requestsPerSecond to disable the limit
+// The limit is added on the transport on the first call, that must be done before the client is used by many goroutines
+func (c *AmbariClient) SetRateLimit(requestsPerSecond float64, burst int) {
+
+	c.checkOwnTransport()
+	if requestsPerSecond < 0 {
+		panic("RequestsPerSecond can't be negative")
+	}
+	limit := rate.Limit(requestsPerSecond)
+	if requestsPerSecond == 0 {
+		limit = rate.Inf
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	c.log.Debugf("Rate limit: %f/s, burst %d", requestsPerSecond, burst)
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*rateLimitTransport)
+		return ok
+	})
+	if transport != nil {
+		// The limiter can be changed while calls are sent
+		transport.(*rateLimitTransport).limiter.SetLimit(limit)
+		transport.(*rateLimitTransport).limiter.SetBurst(burst)
+		return
+	}
+
+	if requestsPerSecond > 0 {
+		c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return &rateLimitTransport{
+				next:    next,
+				limiter: rate.NewLimiter(limit, burst),
+			}
+		})
+	}
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *rateLimitTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+// RoundTrip wait the limiter permit the call before send the request
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
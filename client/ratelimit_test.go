@@ -0,0 +1,44 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSetRateLimit(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRateLimit(20, 2)
+
+	// The limit is shared by the goroutines
+	start := time.Now()
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.Cluster("test")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.True(t, time.Since(start) >= 80*time.Millisecond)
+
+	// Disable the limit
+	client.SetRateLimit(0, 0)
+	transport := client.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*rateLimitTransport)
+		return ok
+	})
+	assert.Equal(t, rate.Inf, transport.(*rateLimitTransport).limiter.Limit())
+}
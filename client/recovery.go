@@ -0,0 +1,192 @@
+// This file permit to manage the auto start (recovery) settings in Ambari API
+// Ambari documentation: https://cwiki.apache.org/confluence/display/AMBARI/Recovery%3A+auto+start+components
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	CONFIG_CLUSTER_ENV        = "cluster-env"
+	PROPERTY_RECOVERY_ENABLED = "recovery_enabled"
+)
+
+// ClusterRecoveryEnabled permit to know if auto start is enabled on cluster
+// It read the property recovery_enabled from the cluster-env configuration
+// It return error if cluster not found or if something wrong when it call the API
+func (c *AmbariClient) ClusterRecoveryEnabled(clusterName string) (bool, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ClusterRecoveryEnabled", "cluster_name", clusterName)
+
+	configuration, err := c.DesiredConfigurationOnCluster(clusterName, CONFIG_CLUSTER_ENV)
+	if err != nil {
+		return false, err
+	}
+	if configuration == nil {
+		return false, NewAmbariError(404, "Configuration %s not found on cluster %s", CONFIG_CLUSTER_ENV, clusterName)
+	}
+	c.log.Debugf("Recovery enabled: %s", configuration.Properties[PROPERTY_RECOVERY_ENABLED])
+
+	return configuration.Properties[PROPERTY_RECOVERY_ENABLED] == "true", nil
+}
+
+// SetClusterRecoveryEnabled permit to enable or disable auto start on cluster
+// It create new version of cluster-env configuration with all current properties and the property recovery_enabled updated.
+// Nothing is done if the cluster already have the expected setting
+// It return error if cluster not found or if something wrong when it call the API
+func (c *AmbariClient) SetClusterRecoveryEnabled(clusterName string, enabled bool) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("SetClusterRecoveryEnabled", "cluster_name", clusterName, "enabled", enabled)
+
+	configuration, err := c.DesiredConfigurationOnCluster(clusterName, CONFIG_CLUSTER_ENV)
+	if err != nil {
+		return err
+	}
+	if configuration == nil {
+		return NewAmbariError(404, "Configuration %s not found on cluster %s", CONFIG_CLUSTER_ENV, clusterName)
+	}
+	if configuration.Properties[PROPERTY_RECOVERY_ENABLED] == strconv.FormatBool(enabled) {
+		c.log.Debug("Recovery setting is already up to date")
+		return nil
+	}
+
+	properties := make(map[string]string, len(configuration.Properties))
+	for key, value := range configuration.Properties {
+		properties[key] = value
+	}
+	properties[PROPERTY_RECOVERY_ENABLED] = strconv.FormatBool(enabled)
+	newConfiguration := &Configuration{
+		Type:                 CONFIG_CLUSTER_ENV,
+		Tag:                  newConfigurationTag(),
+		Properties:           properties,
+		PropertiesAttributes: configuration.PropertiesAttributes,
+	}
+	_, err = c.CreateConfigurationOnCluster(clusterName, newConfiguration)
+
+	return err
+}
+
+// ComponentsRecovery return all components of cluster with is auto start setting
+// It return the list of components
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ComponentsRecovery(clusterName string, opts ...RequestOption) ([]Component, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ComponentsRecovery", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/components", clusterName)
+	resp, err := c.get(path, opts, Fields("ServiceComponentInfo/service_name,ServiceComponentInfo/category,ServiceComponentInfo/recovery_enabled"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	componentsResponse := &ComponentsResponse{}
+	err = json.Unmarshal(resp.Body(), componentsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Components: ", componentsResponse.Items)
+
+	return componentsResponse.Items, nil
+}
+
+// SetComponentsRecoveryEnabled permit to enable or disable auto start on some components of the cluster
+// It update all components in one call.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SetComponentsRecoveryEnabled(clusterName string, componentNames []string, enabled bool) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if len(componentNames) == 0 {
+		panic("ComponentNames can't be empty")
+	}
+	c.log.Debugw("SetComponentsRecoveryEnabled", "cluster_name", clusterName, "component_names", componentNames, "enabled", enabled)
+
+	path := fmt.Sprintf("/clusters/%s/components?ServiceComponentInfo/component_name.in(%s)", clusterName, strings.Join(componentNames, ","))
+	component := &Component{
+		ComponentInfo: &ComponentInfo{
+			RecoveryEnabled: strconv.FormatBool(enabled),
+		},
+	}
+	jsonData, err := json.Marshal(component)
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// SetComponentRecoveryEnabled permit to enable or disable auto start on component
+// It return the component if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SetComponentRecoveryEnabled(clusterName string, serviceName string, componentName string, enabled bool) (*Component, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	c.log.Debugw("SetComponentRecoveryEnabled", "cluster_name", clusterName, "service_name", serviceName, "component_name", componentName, "enabled", enabled)
+
+	path := fmt.Sprintf("/clusters/%s/services/%s/components/%s", clusterName, serviceName, componentName)
+	component := &Component{
+		ComponentInfo: &ComponentInfo{
+			RecoveryEnabled: strconv.FormatBool(enabled),
+		},
+	}
+	jsonData, err := json.Marshal(component)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the component after update
+	component, err = c.Component(clusterName, serviceName, componentName)
+	if err != nil {
+		return nil, err
+	}
+	if component == nil {
+		return nil, NewAmbariError(500, "Can't get component that just updated")
+	}
+
+	return component, nil
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestRecovery() {
+
+	// Enable auto start on cluster
+	err := s.client.SetClusterRecoveryEnabled("test", true)
+	assert.NoError(s.T(), err)
+	enabled, err := s.client.ClusterRecoveryEnabled("test")
+	assert.NoError(s.T(), err)
+	assert.True(s.T(), enabled)
+
+	// Enable auto start on component
+	component, err := s.client.SetComponentRecoveryEnabled("test", "ZOOKEEPER", "ZOOKEEPER_SERVER", true)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), component)
+	if component != nil {
+		assert.Equal(s.T(), "true", component.ComponentInfo.RecoveryEnabled)
+	}
+
+	// Disable auto start on many components
+	err = s.client.SetComponentsRecoveryEnabled("test", []string{"ZOOKEEPER_SERVER"}, false)
+	assert.NoError(s.T(), err)
+	components, err := s.client.ComponentsRecovery("test")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), components)
+	for _, component := range components {
+		if component.ComponentInfo.ComponentName == "ZOOKEEPER_SERVER" {
+			assert.Equal(s.T(), "false", component.ComponentInfo.RecoveryEnabled)
+		}
+	}
+
+	// Disable auto start on cluster
+	err = s.client.SetClusterRecoveryEnabled("test", false)
+	assert.NoError(s.T(), err)
+	enabled, err = s.client.ClusterRecoveryEnabled("test")
+	assert.NoError(s.T(), err)
+	assert.False(s.T(), enabled)
+}
@@ -6,7 +6,6 @@ package client
 import (
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 )
 
 // Repository object
@@ -28,7 +27,7 @@ type OS struct {
 }
 type OSInfo struct {
 	Type              string `json:"os_type"`
-	ManagedRepository bool   `json:"ambari_managed_repositories, omitempty"`
+	ManagedRepository bool   `json:"ambari_managed_repositories"`
 }
 type RepositoryData struct {
 	Response
@@ -73,12 +72,12 @@ func (c *AmbariClient) CreateRepository(repository *Repository) (*Repository, er
 	if repository == nil {
 		panic("Repository can't be nil")
 	}
-	log.Debugf("Repository: %s", repository.String())
+	c.log.Debugf("Repository: %s", repository.String())
 
 	repository.CleanBeforeSave()
 
 	path := fmt.Sprintf("/stacks/%s/versions/%s/repository_versions", repository.RepositoryVersion.StackName, repository.RepositoryVersion.StackVersion)
-	jsonData, err := json.Marshal(repository)
+	jsonData, err := c.repositoryPayload(repository)
 	if err != nil {
 		return nil, err
 	}
@@ -86,9 +85,9 @@ func (c *AmbariClient) CreateRepository(repository *Repository) (*Repository, er
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	repository, err = c.SearchRepository(repository.RepositoryVersion.StackName, repository.RepositoryVersion.StackVersion, repository.RepositoryVersion.Name, repository.RepositoryVersion.Version)
@@ -99,7 +98,7 @@ func (c *AmbariClient) CreateRepository(repository *Repository) (*Repository, er
 		return nil, NewAmbariError(500, "Can't get repository that just created")
 	}
 
-	log.Debugf("Return repository: %s", repository)
+	c.log.Debugf("Return repository: %s", repository)
 
 	return repository, nil
 
@@ -108,7 +107,7 @@ func (c *AmbariClient) CreateRepository(repository *Repository) (*Repository, er
 // Repository permit to get existing repository
 // It return repository if is found
 // It return nil if is not found
-func (c *AmbariClient) Repository(stackName string, stackVersion string, repositoryId int) (*Repository, error) {
+func (c *AmbariClient) Repository(stackName string, stackVersion string, repositoryId int, opts ...RequestOption) (*Repository, error) {
 
 	if stackName == "" {
 		panic("StackName can't be empty")
@@ -116,20 +115,19 @@ func (c *AmbariClient) Repository(stackName string, stackVersion string, reposit
 	if stackVersion == "" {
 		panic("StackVersion can't be empty")
 	}
-	log.Debug("StackName: ", stackName)
-	log.Debug("StackVersion: ", stackVersion)
+	c.log.Debugw("Repository", "stack_name", stackName, "stack_version", stackVersion)
 
 	path := fmt.Sprintf("/stacks/%s/versions/%s/repository_versions/%d", stackName, stackVersion, repositoryId)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	repository := &Repository{}
@@ -138,44 +136,44 @@ func (c *AmbariClient) Repository(stackName string, stackVersion string, reposit
 		return nil, err
 	}
 
-	log.Debug("Repository : ", repository.String())
+	c.log.Debug("Repository : ", repository.String())
 
 	// Get Repositories for each OS
 	if repository != nil {
 		for index, os := range repository.OS {
-			log.Debug("Call ", os.Href)
+			c.log.Debug("Call ", os.Href)
 			resp, err = c.Client().R().Get(*os.Href)
 			if err != nil {
 				return nil, err
 			}
-			log.Debug("Response to get repository: ", resp)
+			c.log.Debug("Response to get repository: ", resp)
 			os = OS{}
 			err = json.Unmarshal(resp.Body(), &os)
 			if err != nil {
 				return nil, err
 			}
-			log.Debug("Return repository: ", os)
+			c.log.Debug("Return repository: ", os)
 
 			for index2, repositoryData := range os.RepositoriesData {
-				log.Debug("Call ", repositoryData.Href)
+				c.log.Debug("Call ", repositoryData.Href)
 				resp, err = c.Client().R().Get(*repositoryData.Href)
 				if err != nil {
 					return nil, err
 				}
-				log.Debug("Response to get repositoryData: ", resp)
+				c.log.Debug("Response to get repositoryData: ", resp)
 				repositoryData = RepositoryData{}
 				err = json.Unmarshal(resp.Body(), &repositoryData)
 				if err != nil {
 					return nil, err
 				}
-				log.Debug("Return repositoryData: ", repositoryData)
+				c.log.Debug("Return repositoryData: ", repositoryData)
 				os.RepositoriesData[index2] = repositoryData
 			}
 
 			repository.OS[index] = os
 		}
 	}
-	log.Debugf("Return repository: %s", repository)
+	c.log.Debugf("Return repository: %s", repository)
 	return repository, nil
 }
 
@@ -187,12 +185,12 @@ func (c *AmbariClient) UpdateRepository(repository *Repository) (*Repository, er
 	if repository == nil {
 		panic("Repository can't be nil")
 	}
-	log.Debug("Repository: ", repository)
+	c.log.Debugw("UpdateRepository", "repository", repository)
 
 	repository.CleanBeforeSave()
 
 	path := fmt.Sprintf("/stacks/%s/versions/%s/repository_versions/%d", repository.RepositoryVersion.StackName, repository.RepositoryVersion.StackVersion, repository.RepositoryVersion.Id)
-	jsonData, err := json.Marshal(repository)
+	jsonData, err := c.repositoryPayload(repository)
 	if err != nil {
 		return nil, err
 	}
@@ -200,9 +198,9 @@ func (c *AmbariClient) UpdateRepository(repository *Repository) (*Repository, er
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	repository, err = c.Repository(repository.RepositoryVersion.StackName, repository.RepositoryVersion.StackVersion, repository.RepositoryVersion.Id)
@@ -213,7 +211,7 @@ func (c *AmbariClient) UpdateRepository(repository *Repository) (*Repository, er
 		return nil, NewAmbariError(500, "Can't get repository that just updated")
 	}
 
-	log.Debugf("Return repository: %s", repository.String())
+	c.log.Debugf("Return repository: %s", repository.String())
 
 	return repository, nil
 
@@ -230,17 +228,16 @@ func (c *AmbariClient) DeleteRepository(stackName string, stackVersion string, r
 	if stackVersion == "" {
 		panic("StackVersion can't be empty")
 	}
-	log.Debug("StackName: ", stackName)
-	log.Debug("StackVersion: ", stackVersion)
+	c.log.Debugw("DeleteRepository", "stack_name", stackName, "stack_version", stackVersion)
 
 	path := fmt.Sprintf("/stacks/%s/versions/%s/repository_versions/%d", stackName, stackVersion, repositoryId)
 	resp, err := c.Client().R().Delete(path)
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete host: ", resp)
+	c.log.Debug("Response to delete host: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -251,7 +248,7 @@ func (c *AmbariClient) DeleteRepository(stackName string, stackVersion string, r
 // It return the repository if is found
 // It return nil if is not found
 // It return error if something wrong when it call the API
-func (c *AmbariClient) SearchRepository(stackName string, stackVersion string, repositoryName string, repositoryVersion string) (*Repository, error) {
+func (c *AmbariClient) SearchRepository(stackName string, stackVersion string, repositoryName string, repositoryVersion string, opts ...RequestOption) (*Repository, error) {
 
 	if stackName == "" {
 		panic("StackName can't be empty")
@@ -265,25 +262,24 @@ func (c *AmbariClient) SearchRepository(stackName string, stackVersion string, r
 	if repositoryVersion == "" {
 		panic("RepositoryVersion can't be empty")
 	}
-	log.Debug("StackName: ", stackName)
-	log.Debug("StackVersion: ", stackVersion)
-	log.Debug("RepositoryName ", repositoryName)
-	log.Debug("RepositoryVersion ", repositoryVersion)
+	c.log.Debugw("SearchRepository", "stack_name", stackName, "stack_version", stackVersion)
+	c.log.Debug("RepositoryName ", repositoryName)
+	c.log.Debug("RepositoryVersion ", repositoryVersion)
 
 	path := fmt.Sprintf("/stacks/%s/versions/%s/repository_versions", stackName, stackVersion)
-	resp, err := c.Client().R().SetQueryParams(map[string]string{
-		"RepositoryVersions/repository_version": repositoryVersion,
-		"RepositoryVersions/display_name":       repositoryName,
-	}).Get(path)
+	resp, err := c.get(path, opts, Where(And(
+		Eq("RepositoryVersions/repository_version", repositoryVersion),
+		Eq("RepositoryVersions/display_name", repositoryName),
+	)))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	repositoryResponse := &RepositoriesResponse{}
@@ -291,10 +287,10 @@ func (c *AmbariClient) SearchRepository(stackName string, stackVersion string, r
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("RepositoryResponse: ", repositoryResponse)
+	c.log.Debug("RepositoryResponse: ", repositoryResponse)
 
 	if len(repositoryResponse.Items) > 0 {
-		log.Debug("Repository: ", repositoryResponse.Items[0])
+		c.log.Debug("Repository: ", repositoryResponse.Items[0])
 
 		repository, err := c.Repository(stackName, stackVersion, repositoryResponse.Items[0].RepositoryVersion.Id)
 		if err != nil {
@@ -306,3 +302,29 @@ func (c *AmbariClient) SearchRepository(stackName string, stackVersion string, r
 		return nil, nil
 	}
 }
+
+// repositoryPayload return the repository as Json, without the attributes that Ambari server not support
+func (c *AmbariClient) repositoryPayload(repository *Repository) ([]byte, error) {
+
+	jsonData, err := json.Marshal(repository)
+	if err != nil || !c.notSupports(FEATURE_REPOSITORY_AMBARI_MANAGED) {
+		return jsonData, err
+	}
+
+	c.log.Debug("Remove ambari_managed_repositories not supported by Ambari")
+	payload := map[string]interface{}{}
+	err = json.Unmarshal(jsonData, &payload)
+	if err != nil {
+		return nil, err
+	}
+	operatingSystems, _ := payload["operating_systems"].([]interface{})
+	for _, os := range operatingSystems {
+		if os, ok := os.(map[string]interface{}); ok {
+			if osInfo, ok := os["OperatingSystems"].(map[string]interface{}); ok {
+				delete(osInfo, "ambari_managed_repositories")
+			}
+		}
+	}
+
+	return json.Marshal(payload)
+}
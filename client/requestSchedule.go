@@ -0,0 +1,366 @@
+// This file permit to manage request schedules in Ambari API
+// Request schedule permit to run requests by batch, like the rolling restart
+// Ambari documentation: https://cwiki.apache.org/confluence/display/AMBARI/Rolling+Restart+of+Components
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	REQUEST_SCHEDULE_SCHEDULED = "SCHEDULED"
+	REQUEST_SCHEDULE_COMPLETED = "COMPLETED"
+	REQUEST_SCHEDULE_DISABLED  = "DISABLED"
+)
+
+// RequestSchedule object
+type RequestSchedule struct {
+	RequestScheduleInfo *RequestScheduleInfo `json:"RequestSchedule"`
+}
+type RequestSchedulesResponse struct {
+	Response
+	Items []RequestSchedule `json:"items"`
+}
+type RequestScheduleInfo struct {
+	Id                  int64                    `json:"id,omitempty"`
+	ClusterName         string                   `json:"cluster_name,omitempty"`
+	Description         string                   `json:"description,omitempty"`
+	Status              string                   `json:"status,omitempty"`
+	LastExecutionStatus string                   `json:"last_execution_status,omitempty"`
+	CreateUser          string                   `json:"create_user,omitempty"`
+	CreateTime          string                   `json:"create_time,omitempty"`
+	UpdateUser          string                   `json:"update_user,omitempty"`
+	UpdateTime          string                   `json:"update_time,omitempty"`
+	Batch               *RequestScheduleBatch    `json:"batch,omitempty"`
+	Schedule            *RequestScheduleSchedule `json:"schedule,omitempty"`
+}
+
+// RequestScheduleBatch is the requests run one after the other, with the settings between them
+// Ambari expect it as list of requests and settings when create it, and return it as object
+type RequestScheduleBatch struct {
+	Requests []RequestScheduleRequest
+	Settings *RequestScheduleBatchSettings
+}
+type RequestScheduleRequest struct {
+	OrderId int `json:"order_id"`
+	// Type is the HTTP method, like POST
+	Type string `json:"type,omitempty"`
+	// URI is the Ambari API path, like /clusters/test/requests, Ambari add its own /api/v1 prefix
+	URI  string      `json:"uri,omitempty"`
+	Body interface{} `json:"RequestBodyInfo,omitempty"`
+	// Status and ReturnCode are set by Ambari when the request is run
+	Status     string `json:"-"`
+	ReturnCode int    `json:"-"`
+}
+type RequestScheduleBatchSettings struct {
+	BatchSeparationInSeconds int `json:"batch_separation_in_seconds"`
+	TaskFailureTolerance     int `json:"task_failure_tolerance"`
+}
+type RequestScheduleSchedule struct {
+	Minutes     string `json:"minutes,omitempty"`
+	Hours       string `json:"hours,omitempty"`
+	DaysOfMonth string `json:"days_of_month,omitempty"`
+	Month       string `json:"month,omitempty"`
+	DayOfWeek   string `json:"day_of_week,omitempty"`
+	Year        string `json:"year,omitempty"`
+	StartTime   string `json:"startTime,omitempty"`
+	EndTime     string `json:"endTime,omitempty"`
+}
+
+// Response return by Ambari when create request schedule
+type requestScheduleCreateResponse struct {
+	Resources []RequestSchedule `json:"resources"`
+}
+
+// String return request schedule object as Json string
+func (r *RequestSchedule) String() string {
+	json, _ := json.Marshal(r)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before create request schedule
+func (r *RequestSchedule) CleanBeforeSave() *RequestSchedule {
+
+	return &RequestSchedule{
+		RequestScheduleInfo: &RequestScheduleInfo{
+			Description: r.RequestScheduleInfo.Description,
+			Batch:       r.RequestScheduleInfo.Batch,
+			Schedule:    r.RequestScheduleInfo.Schedule,
+		},
+	}
+}
+
+// MarshalJSON write the batch as expected by Ambari when create request schedule
+func (b *RequestScheduleBatch) MarshalJSON() ([]byte, error) {
+
+	batch := []interface{}{
+		map[string]interface{}{
+			"requests": b.Requests,
+		},
+	}
+	if b.Settings != nil {
+		batch = append(batch, map[string]interface{}{
+			"batch_settings": b.Settings,
+		})
+	}
+
+	return json.Marshal(batch)
+}
+
+// UnmarshalJSON read the batch as return by Ambari
+func (b *RequestScheduleBatch) UnmarshalJSON(data []byte) error {
+
+	batch := &struct {
+		Requests []struct {
+			OrderId    int    `json:"order_id"`
+			Type       string `json:"request_type"`
+			URI        string `json:"request_uri"`
+			Body       string `json:"request_body"`
+			Status     string `json:"request_status"`
+			ReturnCode int    `json:"return_code"`
+		} `json:"batch_requests"`
+		Settings *struct {
+			BatchSeparationInSeconds  int `json:"batch_separation_in_seconds"`
+			TaskFailureTolerance      int `json:"task_failure_tolerance"`
+			TaskFailureToleranceLimit int `json:"task_failure_tolerance_limit"`
+		} `json:"batch_settings"`
+	}{}
+	if err := json.Unmarshal(data, batch); err != nil {
+		return err
+	}
+
+	b.Requests = make([]RequestScheduleRequest, 0, len(batch.Requests))
+	for _, request := range batch.Requests {
+		var body interface{}
+		if request.Body != "" {
+			if err := json.Unmarshal([]byte(request.Body), &body); err != nil {
+				body = request.Body
+			}
+		}
+		b.Requests = append(b.Requests, RequestScheduleRequest{
+			OrderId:    request.OrderId,
+			Type:       request.Type,
+			URI:        request.URI,
+			Body:       body,
+			Status:     request.Status,
+			ReturnCode: request.ReturnCode,
+		})
+	}
+	if batch.Settings != nil {
+		b.Settings = &RequestScheduleBatchSettings{
+			BatchSeparationInSeconds: batch.Settings.BatchSeparationInSeconds,
+			TaskFailureTolerance:     batch.Settings.TaskFailureTolerance,
+		}
+		if batch.Settings.TaskFailureToleranceLimit > 0 {
+			b.Settings.TaskFailureTolerance = batch.Settings.TaskFailureToleranceLimit
+		}
+	}
+
+	return nil
+}
+
+// NewRollingRestartRequestSchedule permit to create the request schedule that restart component on hosts by batch, like Ambari UI do
+// Each batch restart batchSize hosts, and wait batchSeparationInSeconds before the next batch.
+// The request schedule is aborted when more than taskFailureTolerance tasks failed.
+func NewRollingRestartRequestSchedule(clusterName string, serviceName string, componentName string, hostnames []string, batchSize int, batchSeparationInSeconds int, taskFailureTolerance int) *RequestSchedule {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	if componentName == "" {
+		panic("ComponentName can't be empty")
+	}
+	if batchSize <= 0 {
+		panic("BatchSize must be greater than 0")
+	}
+
+	nbBatch := (len(hostnames) + batchSize - 1) / batchSize
+	requests := make([]RequestScheduleRequest, 0, nbBatch)
+	for index := 0; index < nbBatch; index++ {
+		end := (index + 1) * batchSize
+		if end > len(hostnames) {
+			end = len(hostnames)
+		}
+		requests = append(requests, RequestScheduleRequest{
+			OrderId: index + 1,
+			Type:    "POST",
+			URI:     fmt.Sprintf("/clusters/%s/requests", clusterName),
+			Body: map[string]interface{}{
+				"RequestInfo": map[string]interface{}{
+					"context": fmt.Sprintf("_PARSE_.ROLLING-RESTART.%s.%d.%d", componentName, index+1, nbBatch),
+					"command": COMMAND_RESTART,
+				},
+				"Requests/resource_filters": []map[string]string{
+					{
+						"service_name":   serviceName,
+						"component_name": componentName,
+						"hosts":          strings.Join(hostnames[index*batchSize:end], ","),
+					},
+				},
+			},
+		})
+	}
+
+	return &RequestSchedule{
+		RequestScheduleInfo: &RequestScheduleInfo{
+			ClusterName: clusterName,
+			Description: fmt.Sprintf("Rolling Restart of %s", componentName),
+			Batch: &RequestScheduleBatch{
+				Requests: requests,
+				Settings: &RequestScheduleBatchSettings{
+					BatchSeparationInSeconds: batchSeparationInSeconds,
+					TaskFailureTolerance:     taskFailureTolerance,
+				},
+			},
+		},
+	}
+}
+
+// CreateRequestSchedule permit to create new request schedule on cluster
+// Ambari run the requests as soon as it's created if there are no schedule
+// It return the request schedule if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateRequestSchedule(requestSchedule *RequestSchedule) (*RequestSchedule, error) {
+
+	if requestSchedule == nil {
+		panic("RequestSchedule can't be nil")
+	}
+	if requestSchedule.RequestScheduleInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("CreateRequestSchedule", "request_schedule", requestSchedule)
+
+	// Create the request schedule
+	path := fmt.Sprintf("/clusters/%s/request_schedules", requestSchedule.RequestScheduleInfo.ClusterName)
+	requestSchedulePayload := []*RequestSchedule{requestSchedule.CleanBeforeSave()}
+	jsonData, err := json.Marshal(requestSchedulePayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Ambari return the id of the request schedule that just created
+	createResponse := &requestScheduleCreateResponse{}
+	err = json.Unmarshal(resp.Body(), createResponse)
+	if err != nil {
+		return nil, err
+	}
+	if len(createResponse.Resources) == 0 || createResponse.Resources[0].RequestScheduleInfo == nil {
+		return nil, NewAmbariError(500, "Can't get the id of request schedule that just created")
+	}
+
+	// Get the request schedule
+	requestSchedule, err = c.RequestSchedule(requestSchedule.RequestScheduleInfo.ClusterName, createResponse.Resources[0].RequestScheduleInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if requestSchedule == nil {
+		return nil, NewAmbariError(500, "Can't get request schedule that just created")
+	}
+
+	return requestSchedule, err
+}
+
+// RequestSchedule return existing request schedule on cluster
+// It return the request schedule if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) RequestSchedule(clusterName string, id int64, opts ...RequestOption) (*RequestSchedule, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("RequestSchedule", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/request_schedules/%d", clusterName, id)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	requestSchedule := &RequestSchedule{}
+	err = json.Unmarshal(resp.Body(), requestSchedule)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return request schedule: %s", requestSchedule)
+
+	return requestSchedule, nil
+}
+
+// RequestSchedules return all request schedules on cluster
+// It return the list of request schedules.
+// If not request schedule, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) RequestSchedules(clusterName string, opts ...RequestOption) ([]RequestSchedule, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("RequestSchedules", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/request_schedules", clusterName)
+	resp, err := c.get(path, opts, Fields("RequestSchedule/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	requestSchedulesResponse := &RequestSchedulesResponse{}
+	err = json.Unmarshal(resp.Body(), requestSchedulesResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("RequestSchedules: ", requestSchedulesResponse.Items)
+
+	return requestSchedulesResponse.Items, nil
+}
+
+// DeleteRequestSchedule permit to delete existing request schedule on cluster
+// Ambari disable the request schedule, so the batches not yet run are canceled
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteRequestSchedule(clusterName string, id int64) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("DeleteRequestSchedule", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/request_schedules/%d", clusterName, id)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete request schedule: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
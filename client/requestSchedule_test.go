@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestRequestSchedule(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Rolling restart
+	requestSchedule := NewRollingRestartRequestSchedule("test", "HDFS", "DATANODE", []string{"worker01", "worker02", "worker03"}, 2, 120, 1)
+	assert.Equal(t, 2, len(requestSchedule.RequestScheduleInfo.Batch.Requests))
+
+	requestSchedule, err := client.CreateRequestSchedule(requestSchedule)
+	assert.NoError(t, err)
+	assert.NotNil(t, requestSchedule)
+	if requestSchedule == nil {
+		return
+	}
+	assert.Equal(t, REQUEST_SCHEDULE_SCHEDULED, requestSchedule.RequestScheduleInfo.Status)
+	assert.Equal(t, "Rolling Restart of DATANODE", requestSchedule.RequestScheduleInfo.Description)
+	batch, err := json.Marshal(requestSchedule.RequestScheduleInfo.Batch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"requests": [
+			{"order_id": 1, "type": "POST", "uri": "/clusters/test/requests", "RequestBodyInfo": {
+				"RequestInfo": {"context": "_PARSE_.ROLLING-RESTART.DATANODE.1.2", "command": "RESTART"},
+				"Requests/resource_filters": [{"service_name": "HDFS", "component_name": "DATANODE", "hosts": "worker01,worker02"}]
+			}},
+			{"order_id": 2, "type": "POST", "uri": "/clusters/test/requests", "RequestBodyInfo": {
+				"RequestInfo": {"context": "_PARSE_.ROLLING-RESTART.DATANODE.2.2", "command": "RESTART"},
+				"Requests/resource_filters": [{"service_name": "HDFS", "component_name": "DATANODE", "hosts": "worker03"}]
+			}}
+		]},
+		{"batch_settings": {"batch_separation_in_seconds": 120, "task_failure_tolerance": 1}}
+	]`, string(batch))
+
+	// Status of the batches that are run
+	id := requestSchedule.RequestScheduleInfo.Id
+	server.SetRequestScheduleBatchStatus("test", id, 1, "COMPLETED", 202)
+	requestSchedule, err = client.RequestSchedule("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, requestSchedule)
+	if requestSchedule != nil {
+		assert.Equal(t, 2, len(requestSchedule.RequestScheduleInfo.Batch.Requests))
+		assert.Equal(t, "COMPLETED", requestSchedule.RequestScheduleInfo.Batch.Requests[0].Status)
+		assert.Equal(t, 202, requestSchedule.RequestScheduleInfo.Batch.Requests[0].ReturnCode)
+		assert.Equal(t, "", requestSchedule.RequestScheduleInfo.Batch.Requests[1].Status)
+		assert.Equal(t, 1, requestSchedule.RequestScheduleInfo.Batch.Settings.TaskFailureTolerance)
+	}
+
+	// Delete
+	err = client.DeleteRequestSchedule("test", id)
+	assert.NoError(t, err)
+	requestSchedule, err = client.RequestSchedule("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, requestSchedule)
+	if requestSchedule != nil {
+		assert.Equal(t, REQUEST_SCHEDULE_DISABLED, requestSchedule.RequestScheduleInfo.Status)
+	}
+	err = client.DeleteRequestSchedule("test", id+1)
+	assert.True(t, IsNotFound(err))
+}
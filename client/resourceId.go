@@ -0,0 +1,615 @@
+// This file permit to identify the resources with stable ID, like cluster/HDFS/NAMENODE for component
+// It permit to store the ID of resource, like in Terraform state, and to get the resource again from its ID.
+// Each part of ID is escaped like URL path segment, so the names can contain /.
+
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+const ID_SEPARATOR = "/"
+
+// newID return the ID with the escaped parts
+func newID(parts ...string) string {
+	escapedParts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		escapedParts = append(escapedParts, url.PathEscape(part))
+	}
+	return strings.Join(escapedParts, ID_SEPARATOR)
+}
+
+// parseID return the unescaped parts of ID
+// It return error with code 400 if the ID has not the format, like cluster/service
+func parseID(id string, format string) ([]string, error) {
+
+	nbParts := len(strings.Split(format, ID_SEPARATOR))
+	escapedParts := strings.Split(id, ID_SEPARATOR)
+	if len(escapedParts) != nbParts {
+		return nil, NewAmbariError(400, "ID '%s' is invalid, it must be %s", id, format)
+	}
+	parts := make([]string, 0, nbParts)
+	for _, escapedPart := range escapedParts {
+		part, err := url.PathUnescape(escapedPart)
+		if err != nil || part == "" {
+			return nil, NewAmbariError(400, "ID '%s' is invalid, it must be %s", id, format)
+		}
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// ClusterID return the ID of cluster, it's its name
+func ClusterID(clusterName string) string {
+	return newID(clusterName)
+}
+
+// ParseClusterID return the cluster name from the ID
+func ParseClusterID(id string) (clusterName string, err error) {
+	parts, err := parseID(id, "cluster")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// HostID return the ID of host on cluster, like cluster/hostname
+func HostID(clusterName string, hostname string) string {
+	return newID(clusterName, hostname)
+}
+
+// ParseHostID return the cluster name and the hostname from the ID
+func ParseHostID(id string) (clusterName string, hostname string, err error) {
+	parts, err := parseID(id, "cluster/hostname")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// ServiceID return the ID of service, like cluster/HDFS
+func ServiceID(clusterName string, serviceName string) string {
+	return newID(clusterName, serviceName)
+}
+
+// ParseServiceID return the cluster name and the service name from the ID
+func ParseServiceID(id string) (clusterName string, serviceName string, err error) {
+	parts, err := parseID(id, "cluster/service")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// ComponentID return the ID of component, like cluster/HDFS/NAMENODE
+func ComponentID(clusterName string, serviceName string, componentName string) string {
+	return newID(clusterName, serviceName, componentName)
+}
+
+// ParseComponentID return the cluster name, the service name and the component name from the ID
+func ParseComponentID(id string) (clusterName string, serviceName string, componentName string, err error) {
+	parts, err := parseID(id, "cluster/service/component")
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// HostComponentID return the ID of component on host, like cluster/worker01/DATANODE
+func HostComponentID(clusterName string, hostname string, componentName string) string {
+	return newID(clusterName, hostname, componentName)
+}
+
+// ParseHostComponentID return the cluster name, the hostname and the component name from the ID
+func ParseHostComponentID(id string) (clusterName string, hostname string, componentName string, err error) {
+	parts, err := parseID(id, "cluster/hostname/component")
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ConfigurationID return the ID of configuration version, like cluster/hdfs-site/version1
+func ConfigurationID(clusterName string, configurationType string, tag string) string {
+	return newID(clusterName, configurationType, tag)
+}
+
+// ParseConfigurationID return the cluster name, the configuration type and the tag from the ID
+func ParseConfigurationID(id string) (clusterName string, configurationType string, tag string, err error) {
+	parts, err := parseID(id, "cluster/type/tag")
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// PrivilegeID return the ID of privilege on cluster, like cluster/CLUSTER.OPERATOR/ops/GROUP
+// The privilege ID of Ambari is not used because it change when the privilege is updated
+func PrivilegeID(clusterName string, permissionName string, principalName string, principalType string) string {
+	return newID(clusterName, permissionName, principalName, principalType)
+}
+
+// ParsePrivilegeID return the cluster name, the permission name, the principal name and the principal type from the ID
+func ParsePrivilegeID(id string) (clusterName string, permissionName string, principalName string, principalType string, err error) {
+	parts, err := parseID(id, "cluster/permission/principal/type")
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+// UserID return the ID of user, it's its name
+func UserID(userName string) string {
+	return newID(userName)
+}
+
+// ParseUserID return the user name from the ID
+func ParseUserID(id string) (userName string, err error) {
+	parts, err := parseID(id, "user")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// GroupID return the ID of group, it's its name
+func GroupID(groupName string) string {
+	return newID(groupName)
+}
+
+// ParseGroupID return the group name from the ID
+func ParseGroupID(id string) (groupName string, err error) {
+	parts, err := parseID(id, "group")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// AmbariPrivilegeID return the ID of privilege on Ambari, like AMBARI.ADMINISTRATOR/admin/USER
+func AmbariPrivilegeID(permissionName string, principalName string, principalType string) string {
+	return newID(permissionName, principalName, principalType)
+}
+
+// ParseAmbariPrivilegeID return the permission name, the principal name and the principal type from the ID
+func ParseAmbariPrivilegeID(id string) (permissionName string, principalName string, principalType string, err error) {
+	parts, err := parseID(id, "permission/principal/type")
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ViewInstanceID return the ID of view instance, like FILES/1.0.0/files
+func ViewInstanceID(viewName string, version string, instanceName string) string {
+	return newID(viewName, version, instanceName)
+}
+
+// ParseViewInstanceID return the view name, the version and the instance name from the ID
+func ParseViewInstanceID(id string) (viewName string, version string, instanceName string, err error) {
+	parts, err := parseID(id, "view/version/instance")
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// ViewPrivilegeID return the ID of privilege on view instance, like FILES/1.0.0/files/VIEW.USER/ops/GROUP
+func ViewPrivilegeID(viewName string, version string, instanceName string, permissionName string, principalName string, principalType string) string {
+	return newID(viewName, version, instanceName, permissionName, principalName, principalType)
+}
+
+// ParseViewPrivilegeID return the view name, the version, the instance name, the permission name, the principal name and the principal type from the ID
+func ParseViewPrivilegeID(id string) (viewName string, version string, instanceName string, permissionName string, principalName string, principalType string, err error) {
+	parts, err := parseID(id, "view/version/instance/permission/principal/type")
+	if err != nil {
+		return "", "", "", "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], parts[3], parts[4], parts[5], nil
+}
+
+// AlertTargetID return the ID of alert target, it's its name
+// The id of Ambari is not used because it change when the alert target is created again
+func AlertTargetID(name string) string {
+	return newID(name)
+}
+
+// ParseAlertTargetID return the alert target name from the ID
+func ParseAlertTargetID(id string) (name string, err error) {
+	parts, err := parseID(id, "name")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// ConfigGroupID return the ID of config group, like cluster/datanodes-with-ssd
+func ConfigGroupID(clusterName string, groupName string) string {
+	return newID(clusterName, groupName)
+}
+
+// ParseConfigGroupID return the cluster name and the group name from the ID
+func ParseConfigGroupID(id string) (clusterName string, groupName string, err error) {
+	parts, err := parseID(id, "cluster/group")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// RepositoryID return the ID of repository version, like HDP/2.6/HDP-2.6.5.0/2.6.5.0-292
+// The id of Ambari is not used because it change when the repository version is registered again
+func RepositoryID(stackName string, stackVersion string, repositoryName string, repositoryVersion string) string {
+	return newID(stackName, stackVersion, repositoryName, repositoryVersion)
+}
+
+// ParseRepositoryID return the stack name, the stack version, the repository name and the repository version from the ID
+func ParseRepositoryID(id string) (stackName string, stackVersion string, repositoryName string, repositoryVersion string, err error) {
+	parts, err := parseID(id, "stack/version/name/repository_version")
+	if err != nil {
+		return "", "", "", "", err
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+// BlueprintID return the ID of blueprint, it's its name
+func BlueprintID(name string) string {
+	return newID(name)
+}
+
+// ParseBlueprintID return the blueprint name from the ID
+func ParseBlueprintID(id string) (name string, err error) {
+	parts, err := parseID(id, "name")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// SettingID return the ID of setting, it's its name
+func SettingID(name string) string {
+	return newID(name)
+}
+
+// ParseSettingID return the setting name from the ID
+func ParseSettingID(id string) (name string, err error) {
+	parts, err := parseID(id, "name")
+	if err != nil {
+		return "", err
+	}
+	return parts[0], nil
+}
+
+// WidgetID return the ID of widget on cluster, like cluster/NameNode%20RPC
+// The id of Ambari is not used because it change when the widget is created again
+func WidgetID(clusterName string, widgetName string) string {
+	return newID(clusterName, widgetName)
+}
+
+// ParseWidgetID return the cluster name and the widget name from the ID
+func ParseWidgetID(id string) (clusterName string, widgetName string, err error) {
+	parts, err := parseID(id, "cluster/widget")
+	if err != nil {
+		return "", "", err
+	}
+	return parts[0], parts[1], nil
+}
+
+// ID return the stable ID of cluster
+func (c *Cluster) ID() string {
+	return ClusterID(c.ClusterInfo.ClusterName)
+}
+
+// ID return the stable ID of host on cluster
+func (h *Host) ID() string {
+	return HostID(h.HostInfo.ClusterName, h.HostInfo.Hostname)
+}
+
+// ID return the stable ID of service
+func (s *Service) ID() string {
+	return ServiceID(s.ServiceInfo.ClusterName, s.ServiceInfo.ServiceName)
+}
+
+// ID return the stable ID of component
+func (c *Component) ID() string {
+	return ComponentID(c.ComponentInfo.ClusterName, c.ComponentInfo.ServiceName, c.ComponentInfo.ComponentName)
+}
+
+// ID return the stable ID of component on host
+func (h *HostComponent) ID() string {
+	return HostComponentID(h.HostComponentInfo.ClusterName, h.HostComponentInfo.Hostname, h.HostComponentInfo.ComponentName)
+}
+
+// ID return the stable ID of user
+func (u *User) ID() string {
+	return UserID(u.UserInfo.UserName)
+}
+
+// ID return the stable ID of group
+func (g *Group) ID() string {
+	return GroupID(g.GroupInfo.GroupName)
+}
+
+// ID return the stable ID of privilege, on cluster, on view instance or on Ambari
+func (p *Privilege) ID() string {
+	info := p.PrivilegeInfo
+	switch {
+	case info.ViewName != "":
+		return ViewPrivilegeID(info.ViewName, info.Version, info.InstanceName, info.PermissionName, info.PrincipalName, info.PrincipalType)
+	case info.ClusterName != "":
+		return PrivilegeID(info.ClusterName, info.PermissionName, info.PrincipalName, info.PrincipalType)
+	default:
+		return AmbariPrivilegeID(info.PermissionName, info.PrincipalName, info.PrincipalType)
+	}
+}
+
+// ID return the stable ID of view instance
+func (v *ViewInstance) ID() string {
+	return ViewInstanceID(v.ViewInstanceInfo.ViewName, v.ViewInstanceInfo.Version, v.ViewInstanceInfo.InstanceName)
+}
+
+// ID return the stable ID of alert target
+func (a *AlertTarget) ID() string {
+	return AlertTargetID(a.AlertTargetInfo.Name)
+}
+
+// ID return the stable ID of config group
+func (g *ConfigGroup) ID() string {
+	return ConfigGroupID(g.ConfigGroupInfo.ClusterName, g.ConfigGroupInfo.GroupName)
+}
+
+// ID return the stable ID of repository version
+func (r *Repository) ID() string {
+	return RepositoryID(r.RepositoryVersion.StackName, r.RepositoryVersion.StackVersion, r.RepositoryVersion.Name, r.RepositoryVersion.Version)
+}
+
+// ID return the stable ID of blueprint
+func (b *Blueprint) ID() string {
+	return BlueprintID(b.BlueprintInfo.Name)
+}
+
+// ID return the stable ID of setting
+func (s *Setting) ID() string {
+	return SettingID(s.SettingInfo.Name)
+}
+
+// ID return the stable ID of widget
+func (w *Widget) ID() string {
+	return WidgetID(w.WidgetInfo.ClusterName, w.WidgetInfo.WidgetName)
+}
+
+// ClusterByID permit to get cluster from its ID
+// It return nil if cluster not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ClusterByID(id string, opts ...RequestOption) (*Cluster, error) {
+	c.log.Debugw("ClusterByID", "id", id)
+	clusterName, err := ParseClusterID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Cluster(clusterName, opts...)
+}
+
+// HostByID permit to get host on cluster from its ID
+// It return nil if host not found on cluster
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) HostByID(id string, opts ...RequestOption) (*Host, error) {
+	c.log.Debugw("HostByID", "id", id)
+	clusterName, hostname, err := ParseHostID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.HostOnCluster(clusterName, hostname, opts...)
+}
+
+// ServiceByID permit to get service from its ID
+// It return nil if service not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ServiceByID(id string, opts ...RequestOption) (*Service, error) {
+	c.log.Debugw("ServiceByID", "id", id)
+	clusterName, serviceName, err := ParseServiceID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Service(clusterName, serviceName, opts...)
+}
+
+// ComponentByID permit to get component from its ID
+// It return nil if component not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ComponentByID(id string, opts ...RequestOption) (*Component, error) {
+	c.log.Debugw("ComponentByID", "id", id)
+	clusterName, serviceName, componentName, err := ParseComponentID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Component(clusterName, serviceName, componentName, opts...)
+}
+
+// HostComponentByID permit to get component on host from its ID
+// It return nil if component not found on host
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) HostComponentByID(id string, opts ...RequestOption) (*HostComponent, error) {
+	c.log.Debugw("HostComponentByID", "id", id)
+	clusterName, hostname, componentName, err := ParseHostComponentID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.HostComponent(clusterName, hostname, componentName, opts...)
+}
+
+// ConfigurationByID permit to get configuration version from its ID
+// It return nil if configuration not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ConfigurationByID(id string, opts ...RequestOption) (*Configuration, error) {
+	c.log.Debugw("ConfigurationByID", "id", id)
+	clusterName, configurationType, tag, err := ParseConfigurationID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.ConfigurationOnCluster(clusterName, configurationType, tag, opts...)
+}
+
+// PrivilegeByID permit to get privilege on cluster from its ID
+// It return nil if privilege not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) PrivilegeByID(id string, opts ...RequestOption) (*Privilege, error) {
+	c.log.Debugw("PrivilegeByID", "id", id)
+	clusterName, permissionName, principalName, principalType, err := ParsePrivilegeID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchPrivilege(clusterName, permissionName, principalName, principalType, opts...)
+}
+
+// UserByID permit to get user from its ID
+// It return nil if user not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) UserByID(id string, opts ...RequestOption) (*User, error) {
+	c.log.Debugw("UserByID", "id", id)
+	userName, err := ParseUserID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.User(userName, opts...)
+}
+
+// GroupByID permit to get group from its ID
+// It return nil if group not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) GroupByID(id string, opts ...RequestOption) (*Group, error) {
+	c.log.Debugw("GroupByID", "id", id)
+	groupName, err := ParseGroupID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Group(groupName, opts...)
+}
+
+// AmbariPrivilegeByID permit to get privilege on Ambari from its ID
+// It return nil if privilege not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) AmbariPrivilegeByID(id string, opts ...RequestOption) (*Privilege, error) {
+	c.log.Debugw("AmbariPrivilegeByID", "id", id)
+	permissionName, principalName, principalType, err := ParseAmbariPrivilegeID(id)
+	if err != nil {
+		return nil, err
+	}
+	privileges, err := c.AmbariPrivileges(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return findPrivilege(privileges, permissionName, principalName, principalType), nil
+}
+
+// ViewInstanceByID permit to get view instance from its ID
+// It return nil if view instance not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ViewInstanceByID(id string, opts ...RequestOption) (*ViewInstance, error) {
+	c.log.Debugw("ViewInstanceByID", "id", id)
+	viewName, version, instanceName, err := ParseViewInstanceID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.ViewInstance(viewName, version, instanceName, opts...)
+}
+
+// ViewPrivilegeByID permit to get privilege on view instance from its ID
+// It return nil if privilege not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ViewPrivilegeByID(id string, opts ...RequestOption) (*Privilege, error) {
+	c.log.Debugw("ViewPrivilegeByID", "id", id)
+	viewName, version, instanceName, permissionName, principalName, principalType, err := ParseViewPrivilegeID(id)
+	if err != nil {
+		return nil, err
+	}
+	privileges, err := c.ViewPrivileges(viewName, version, instanceName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return findPrivilege(privileges, permissionName, principalName, principalType), nil
+}
+
+// AlertTargetByID permit to get alert target from its ID
+// It return nil if alert target not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) AlertTargetByID(id string, opts ...RequestOption) (*AlertTarget, error) {
+	c.log.Debugw("AlertTargetByID", "id", id)
+	name, err := ParseAlertTargetID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchAlertTarget(name, opts...)
+}
+
+// ConfigGroupByID permit to get config group from its ID
+// It return nil if config group not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) ConfigGroupByID(id string, opts ...RequestOption) (*ConfigGroup, error) {
+	c.log.Debugw("ConfigGroupByID", "id", id)
+	clusterName, groupName, err := ParseConfigGroupID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchConfigGroup(clusterName, groupName, opts...)
+}
+
+// RepositoryByID permit to get repository version from its ID
+// It return nil if repository version not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) RepositoryByID(id string, opts ...RequestOption) (*Repository, error) {
+	c.log.Debugw("RepositoryByID", "id", id)
+	stackName, stackVersion, repositoryName, repositoryVersion, err := ParseRepositoryID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchRepository(stackName, stackVersion, repositoryName, repositoryVersion, opts...)
+}
+
+// BlueprintByID permit to get blueprint from its ID
+// It return nil if blueprint not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) BlueprintByID(id string, opts ...RequestOption) (*Blueprint, error) {
+	c.log.Debugw("BlueprintByID", "id", id)
+	name, err := ParseBlueprintID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Blueprint(name, opts...)
+}
+
+// SettingByID permit to get setting from its ID
+// It return nil if setting not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) SettingByID(id string, opts ...RequestOption) (*Setting, error) {
+	c.log.Debugw("SettingByID", "id", id)
+	name, err := ParseSettingID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.Setting(name, opts...)
+}
+
+// WidgetByID permit to get widget from its ID
+// It return nil if widget not found
+// It return error if ID is invalid or if something wrong when it call the API
+func (c *AmbariClient) WidgetByID(id string, opts ...RequestOption) (*Widget, error) {
+	c.log.Debugw("WidgetByID", "id", id)
+	clusterName, widgetName, err := ParseWidgetID(id)
+	if err != nil {
+		return nil, err
+	}
+	return c.SearchWidget(clusterName, widgetName, opts...)
+}
+
+// findPrivilege return the privilege given to the principal with the permission, or nil if there are not
+func findPrivilege(privileges []Privilege, permissionName string, principalName string, principalType string) *Privilege {
+	for i, privilege := range privileges {
+		if privilege.PrivilegeInfo.PermissionName == permissionName && privilege.PrivilegeInfo.PrincipalName == principalName && privilege.PrivilegeInfo.PrincipalType == principalType {
+			return &privileges[i]
+		}
+	}
+	return nil
+}
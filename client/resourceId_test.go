@@ -0,0 +1,110 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestResourceIDs(t *testing.T) {
+
+	assert.Equal(t, "test/HDFS/NAMENODE", ComponentID("test", "HDFS", "NAMENODE"))
+	clusterName, serviceName, componentName, err := ParseComponentID("test/HDFS/NAMENODE")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test", "HDFS", "NAMENODE"}, []string{clusterName, serviceName, componentName})
+
+	// The names with separator are escaped
+	id := PrivilegeID("test", PERMISSION_CLUSTER_OPERATOR, "cn=ops/team", PRINCIPAL_TYPE_GROUP)
+	assert.Equal(t, "test/CLUSTER.OPERATOR/cn=ops%2Fteam/GROUP", id)
+	clusterName, permissionName, principalName, principalType, err := ParsePrivilegeID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test", PERMISSION_CLUSTER_OPERATOR, "cn=ops/team", PRINCIPAL_TYPE_GROUP}, []string{clusterName, permissionName, principalName, principalType})
+
+	id = ViewPrivilegeID("FILES", "1.0.0", "files", "VIEW.USER", "ops", PRINCIPAL_TYPE_GROUP)
+	assert.Equal(t, "FILES/1.0.0/files/VIEW.USER/ops/GROUP", id)
+	viewName, version, instanceName, permissionName, principalName, principalType, err := ParseViewPrivilegeID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"FILES", "1.0.0", "files", "VIEW.USER", "ops", PRINCIPAL_TYPE_GROUP}, []string{viewName, version, instanceName, permissionName, principalName, principalType})
+
+	// Invalid ID
+	_, _, err = ParseServiceID("test")
+	assert.Error(t, err)
+	assert.Equal(t, 400, err.(AmbariError).Code)
+	_, _, err = ParseServiceID("test/HDFS/NAMENODE")
+	assert.Error(t, err)
+	_, _, err = ParseServiceID("test/")
+	assert.Error(t, err)
+	_, _, _, err = ParseConfigurationID("test/hdfs-site/%zz")
+	assert.Error(t, err)
+}
+
+func TestGetByID(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	server.AddHost("worker01", "test")
+	server.AddComponent("test", "HDFS", "DATANODE", COMPONENT_SLAVE)
+	server.AddHostComponent("test", "worker01", "DATANODE", SERVICE_STARTED)
+	client := New(server.BaseURL(), "admin", "admin")
+
+	cluster, err := client.ClusterByID("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "test", cluster.ID())
+
+	host, err := client.HostByID("test/worker01")
+	assert.NoError(t, err)
+	assert.Equal(t, "test/worker01", host.ID())
+
+	service, err := client.ServiceByID("test/HDFS")
+	assert.NoError(t, err)
+	assert.Equal(t, "test/HDFS", service.ID())
+
+	component, err := client.ComponentByID(ComponentID("test", "HDFS", "DATANODE"))
+	assert.NoError(t, err)
+	assert.Equal(t, "test/HDFS/DATANODE", component.ID())
+
+	hostComponent, err := client.HostComponentByID("test/worker01/DATANODE")
+	assert.NoError(t, err)
+	assert.Equal(t, "test/worker01/DATANODE", hostComponent.ID())
+
+	server.AddUser("admin", "LOCAL", true)
+	server.AddGroup("ops", "admin")
+	server.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER)
+	server.AddViewPrivilege("FILES", "1.0.0", "files", "VIEW.USER", "ops", PRINCIPAL_TYPE_GROUP)
+
+	user, err := client.UserByID("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", user.ID())
+
+	group, err := client.GroupByID("ops")
+	assert.NoError(t, err)
+	assert.Equal(t, "ops", group.ID())
+
+	privilege, err := client.AmbariPrivilegeByID(AmbariPrivilegeID(PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER))
+	assert.NoError(t, err)
+	assert.Equal(t, "AMBARI.ADMINISTRATOR/admin/USER", privilege.ID())
+
+	privilege, err = client.ViewPrivilegeByID("FILES/1.0.0/files/VIEW.USER/ops/GROUP")
+	assert.NoError(t, err)
+	assert.Equal(t, "FILES/1.0.0/files/VIEW.USER/ops/GROUP", privilege.ID())
+
+	_, err = client.CreateAlertTarget(&AlertTarget{AlertTargetInfo: &AlertTargetInfo{Name: "ops mail", NotificationType: NOTIFICATION_TYPE_EMAIL}})
+	assert.NoError(t, err)
+	alertTarget, err := client.AlertTargetByID(AlertTargetID("ops mail"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ops%20mail", alertTarget.ID())
+
+	// Not found
+	privilege, err = client.AmbariPrivilegeByID("AMBARI.ADMINISTRATOR/ops/GROUP")
+	assert.NoError(t, err)
+	assert.Nil(t, privilege)
+
+	service, err = client.ServiceByID("test/YARN")
+	assert.NoError(t, err)
+	assert.Nil(t, service)
+
+	// Invalid ID
+	_, err = client.HostComponentByID("test/worker01")
+	assert.Error(t, err)
+}
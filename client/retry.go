@@ -0,0 +1,274 @@
+// This file permit to retry the call on Ambari API when it failed because Ambari is not available, like when it restart
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy permit to set how the client retry the call on Ambari API
+// It retry when it can't connect on Ambari, or when Ambari return 429 or 5xx status.
+// The POST method is not idempotent, so it's retried only if RetryPost is true.
+// In Json and Yaml, the backoffs are written as duration, like 2s or 1m30s.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts, including the first call
+	MaxAttempts int `json:"max_attempts,omitempty" yaml:"max_attempts,omitempty"`
+	// MinBackoff is the wait time before the first retry. It's doubled on each retry.
+	MinBackoff time.Duration `json:"min_backoff,omitempty" yaml:"min_backoff,omitempty"`
+	// MaxBackoff is the max wait time between two attempts
+	MaxBackoff time.Duration `json:"max_backoff,omitempty" yaml:"max_backoff,omitempty"`
+	// RetryPost permit to retry POST method
+	RetryPost bool `json:"retry_post,omitempty" yaml:"retry_post,omitempty"`
+}
+
+// MarshalJSON write the backoffs as duration, like 2s
+func (p *RetryPolicy) MarshalJSON() ([]byte, error) {
+
+	policy := &struct {
+		MaxAttempts int    `json:"max_attempts,omitempty"`
+		MinBackoff  string `json:"min_backoff,omitempty"`
+		MaxBackoff  string `json:"max_backoff,omitempty"`
+		RetryPost   bool   `json:"retry_post,omitempty"`
+	}{
+		MaxAttempts: p.MaxAttempts,
+		RetryPost:   p.RetryPost,
+	}
+	if p.MinBackoff != 0 {
+		policy.MinBackoff = p.MinBackoff.String()
+	}
+	if p.MaxBackoff != 0 {
+		policy.MaxBackoff = p.MaxBackoff.String()
+	}
+
+	return json.Marshal(policy)
+}
+
+// UnmarshalJSON read the backoffs as duration, like 2s, or as number of nanoseconds
+func (p *RetryPolicy) UnmarshalJSON(data []byte) error {
+
+	policy := &struct {
+		MaxAttempts int         `json:"max_attempts"`
+		MinBackoff  interface{} `json:"min_backoff"`
+		MaxBackoff  interface{} `json:"max_backoff"`
+		RetryPost   bool        `json:"retry_post"`
+	}{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return err
+	}
+	minBackoff, err := parseBackoff("min_backoff", policy.MinBackoff)
+	if err != nil {
+		return err
+	}
+	maxBackoff, err := parseBackoff("max_backoff", policy.MaxBackoff)
+	if err != nil {
+		return err
+	}
+
+	p.MaxAttempts = policy.MaxAttempts
+	p.MinBackoff = minBackoff
+	p.MaxBackoff = maxBackoff
+	p.RetryPost = policy.RetryPost
+
+	return nil
+}
+
+// parseBackoff return the duration written as string, like 2s, or as number of nanoseconds
+func parseBackoff(field string, value interface{}) (time.Duration, error) {
+
+	switch value := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return time.Duration(value), nil
+	case string:
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, NewAmbariError(400, "%s must be duration, like 2s: %s", field, err.Error())
+		}
+		return duration, nil
+	default:
+		return 0, NewAmbariError(400, "%s must be duration, like 2s, got %v", field, value)
+	}
+}
+
+// DefaultRetryPolicy return the retry policy with the default values
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+type retryTransport struct {
+	next http.RoundTripper
+	log  *clientLogger
+	// mutex protect the policy, that can be changed while calls are sent
+	mutex  sync.RWMutex
+	policy RetryPolicy
+}
+
+// SetRetryPolicy permit to retry automatically the call on Ambari API
+// The nil policy disable the retry
+// Set the first policy before share the client between goroutines, then it can be changed at any time
+func (c *AmbariClient) SetRetryPolicy(policy *RetryPolicy) {
+
+	c.checkOwnTransport()
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*retryTransport)
+		return ok
+	})
+
+	if transport != nil {
+		if policy == nil {
+			transport.(*retryTransport).setPolicy(RetryPolicy{MaxAttempts: 1})
+		} else {
+			transport.(*retryTransport).setPolicy(*policy)
+		}
+		return
+	}
+
+	if policy != nil {
+		c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+			return &retryTransport{
+				next:   next,
+				policy: *policy,
+				log:    c.log,
+			}
+		})
+	}
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *retryTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+func (t *retryTransport) setPolicy(policy RetryPolicy) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.policy = policy
+}
+
+// currentPolicy return copy of the policy, so the call keep the same policy until its end
+func (t *retryTransport) currentPolicy() RetryPolicy {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.policy
+}
+
+// RoundTrip send the request and retry it if needed
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	policy := t.currentPolicy()
+	if !policy.isRetryable(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		currentReq := req
+		if attempt > 1 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			currentReq = req.Clone(req.Context())
+			currentReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(currentReq)
+		if attempt >= policy.MaxAttempts || !needRetry(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt, resp)
+		if err != nil {
+			t.log.Debugf("Call %s %s failed (%s), retry in %s", req.Method, req.URL.Path, err.Error(), wait)
+		} else {
+			t.log.Debugf("Call %s %s failed with status %s, retry in %s", req.Method, req.URL.Path, resp.Status, wait)
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err = sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isRetryable return true if the request can be sent many times
+func (p RetryPolicy) isRetryable(req *http.Request) bool {
+
+	if p.MaxAttempts <= 1 {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return p.RetryPost
+	}
+
+	return false
+}
+
+// needRetry return true if the call failed because Ambari is not available
+func needRetry(resp *http.Response, err error) bool {
+
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// backoff compute the wait time before the next attempt, with exponential backoff and jitter.
+// It use the Retry-After header if Ambari give it.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if wait > 0 {
+		wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	}
+
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && time.Duration(seconds)*time.Second > wait {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	return wait
+}
+
+// sleep wait the duration or the end of the context
+func sleep(ctx context.Context, duration time.Duration) error {
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
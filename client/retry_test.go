@@ -0,0 +1,189 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newRetryTestServer(nbFailures int, calls *int, bodies *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		*bodies = append(*bodies, string(body))
+		if *calls <= nbFailures {
+			w.WriteHeader(503)
+			return
+		}
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+}
+
+func newRetryTestPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  1 * time.Millisecond,
+		MaxBackoff:  5 * time.Millisecond,
+	}
+}
+
+func TestRetryOnGet(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := newRetryTestServer(2, &calls, &bodies)
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := newRetryTestServer(10, &calls, &bodies)
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	_, err := client.Cluster("test")
+	assert.Error(t, err)
+	assert.Equal(t, 3, calls)
+
+	// Disable retry
+	calls = 0
+	client.SetRetryPolicy(nil)
+	_, err = client.Cluster("test")
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestRetryOnPut(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := newRetryTestServer(1, &calls, &bodies)
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	resp, err := client.Client().R().SetBody(`{"Clusters": {"cluster_name": "test2"}}`).Put("/clusters/test")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{`{"Clusters": {"cluster_name": "test2"}}`, `{"Clusters": {"cluster_name": "test2"}}`}, bodies)
+}
+
+func TestRetryOnPost(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := newRetryTestServer(1, &calls, &bodies)
+	defer server.Close()
+
+	// Not retry POST by default
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	resp, err := client.Client().R().SetBody(`{}`).Post("/clusters/test")
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode())
+	assert.Equal(t, 1, calls)
+
+	// Retry POST when enabled
+	calls = 0
+	policy := newRetryTestPolicy()
+	policy.RetryPost = true
+	client.SetRetryPolicy(policy)
+	resp, err = client.Client().R().SetBody(`{}`).Post("/clusters/test")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryOnConnectionError(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := newRetryTestServer(0, &calls, &bodies)
+	server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	client.DisableVerifySSL()
+	_, err := client.Cluster("test")
+	assert.Error(t, err)
+}
+
+func TestRetryBackoff(t *testing.T) {
+
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		MinBackoff:  100 * time.Millisecond,
+		MaxBackoff:  1 * time.Second,
+	}
+	for attempt := 1; attempt < 10; attempt++ {
+		wait := policy.backoff(attempt, nil)
+		assert.True(t, wait <= 1*time.Second)
+		assert.True(t, wait >= 50*time.Millisecond)
+	}
+
+	// Use Retry-After header
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "1")
+	assert.Equal(t, 1*time.Second, policy.backoff(1, resp))
+}
+
+// Run with -race to check that the transports can be changed while calls are sent
+func TestChangeTransportWhileCalling(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetRetryPolicy(newRetryTestPolicy())
+	client.SetDryRun(NewDryRunRecorder())
+	client.SetAudit("admin", func(entry AuditEntry) {})
+	client.SetRateLimit(1000, 10)
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, err := client.Cluster("test")
+				assert.NoError(t, err)
+				client.DeleteAlertTarget(1)
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	for i := 0; ; i++ {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		client.SetRetryPolicy(nil)
+		client.SetRetryPolicy(newRetryTestPolicy())
+		client.SetDryRun(nil)
+		client.SetDryRun(NewDryRunRecorder())
+		client.SetAudit("other", func(entry AuditEntry) {})
+		client.SetRateLimit(float64(1000+i), 10)
+	}
+}
@@ -0,0 +1,495 @@
+// This file permit to export the security model of Ambari (users, groups, members and privileges) in file,
+// and to import it on another Ambari, like to get the same RBAC on many clusters.
+// Only the privileges given to users and groups are managed, the privileges of roles are not changed.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v2"
+	"sort"
+)
+
+// SecurityModel is the users, the groups and the privileges of Ambari
+type SecurityModel struct {
+	Users      []SecurityUser      `json:"users" yaml:"users"`
+	Groups     []SecurityGroup     `json:"groups" yaml:"groups"`
+	Privileges []SecurityPrivilege `json:"privileges" yaml:"privileges"`
+}
+
+// SecurityUser is user of security model, without its password
+type SecurityUser struct {
+	UserName string `json:"user_name" yaml:"user_name"`
+	Active   bool   `json:"active" yaml:"active"`
+	Ldap     bool   `json:"ldap,omitempty" yaml:"ldap,omitempty"`
+}
+
+// SecurityGroup is group of security model with its members
+type SecurityGroup struct {
+	GroupName string   `json:"group_name" yaml:"group_name"`
+	Ldap      bool     `json:"ldap,omitempty" yaml:"ldap,omitempty"`
+	Members   []string `json:"members,omitempty" yaml:"members,omitempty"`
+}
+
+// SecurityPrivilege is privilege of security model
+// The cluster name is not stored, the CLUSTER privileges are given on the cluster where the model is imported
+type SecurityPrivilege struct {
+	Type           string `json:"type" yaml:"type"`
+	ViewName       string `json:"view_name,omitempty" yaml:"view_name,omitempty"`
+	Version        string `json:"version,omitempty" yaml:"version,omitempty"`
+	InstanceName   string `json:"instance_name,omitempty" yaml:"instance_name,omitempty"`
+	PermissionName string `json:"permission_name" yaml:"permission_name"`
+	PrincipalName  string `json:"principal_name" yaml:"principal_name"`
+	PrincipalType  string `json:"principal_type" yaml:"principal_type"`
+}
+
+// String return security model as Json string
+func (m *SecurityModel) String() string {
+	json, _ := json.Marshal(m)
+	return string(json)
+}
+
+// Marshal permit to write security model in CONFIG_FORMAT_JSON or CONFIG_FORMAT_YAML
+func (m *SecurityModel) Marshal(format string) ([]byte, error) {
+
+	switch format {
+	case CONFIG_FORMAT_JSON:
+		return json.MarshalIndent(m, "", "  ")
+	case CONFIG_FORMAT_YAML:
+		return yaml.Marshal(m)
+	default:
+		return nil, NewAmbariError(400, "Format %s is not supported", format)
+	}
+}
+
+// UnmarshalSecurityModel permit to read security model written by Marshal
+func UnmarshalSecurityModel(data []byte, format string) (*SecurityModel, error) {
+
+	securityModel := &SecurityModel{}
+	var err error
+	switch format {
+	case CONFIG_FORMAT_JSON:
+		err = json.Unmarshal(data, securityModel)
+	case CONFIG_FORMAT_YAML:
+		err = yaml.Unmarshal(data, securityModel)
+	default:
+		return nil, NewAmbariError(400, "Format %s is not supported", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return securityModel, nil
+}
+
+// key return the unique name of privilege, like VIEW:FILES/1.0.0/files:GROUP:users:VIEW.USER
+func (p *SecurityPrivilege) key() string {
+	scope := p.Type
+	if p.Type == PRIVILEGE_TYPE_VIEW {
+		scope = fmt.Sprintf("%s:%s/%s/%s", p.Type, p.ViewName, p.Version, p.InstanceName)
+	}
+	return fmt.Sprintf("%s:%s:%s:%s", scope, p.PrincipalType, p.PrincipalName, p.PermissionName)
+}
+
+// ExportSecurityModel permit to get the users, the groups with their members and the privileges of Ambari
+// The CLUSTER privileges are only the ones given on the cluster, the AMBARI and VIEW privileges are all exported
+// It return the security model sorted by name
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ExportSecurityModel(clusterName string) (*SecurityModel, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("ExportSecurityModel", "cluster_name", clusterName)
+
+	securityModel, err := c.currentSecurityModel(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return security model: %s", securityModel)
+
+	return securityModel, nil
+}
+
+// ImportSecurityModel permit to reconcile Ambari with the security model, like the one exported from another Ambari
+// It create the missing local users with the password newUserPassword, and the missing local groups. The LDAP users and groups must be synchronized before, they are not created.
+// It set the active flag of users, and the members of local groups.
+// It create the missing privileges. The CLUSTER privileges are given on clusterName.
+// The privileges of users and groups that are not in the model are deleted only if deletePrivileges is true, else they are kept.
+// The AMBARI.ADMINISTRATOR privileges of the user logged in, given to itself or to its groups, are never deleted, and this user is never deactivated or removed from these groups,
+// so the import can't lock out the client. When the client use token or Kerberos, the user is not known, so no AMBARI.ADMINISTRATOR privilege is deleted.
+// The users and the groups that are not in the model are kept.
+// It return the change report, that is empty if Ambari has already this security model
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ImportSecurityModel(clusterName string, securityModel *SecurityModel, newUserPassword string, deletePrivileges bool) (*ChangeReport, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if securityModel == nil {
+		panic("SecurityModel can't be nil")
+	}
+	c.log.Debugw("ImportSecurityModel", "cluster_name", clusterName, "delete_privileges", deletePrivileges)
+
+	current, err := c.currentSecurityModel(clusterName)
+	if err != nil {
+		return nil, err
+	}
+	report := newChangeReport()
+	protected := newProtectedPrincipals(c.loginName(), current)
+
+	// Users
+	currentUsers := make(map[string]SecurityUser, len(current.Users))
+	for _, user := range current.Users {
+		currentUsers[user.UserName] = user
+	}
+	desiredUsers := make(map[string]SecurityUser, len(securityModel.Users))
+	for _, user := range securityModel.Users {
+		if user.UserName == "" {
+			return nil, NewAmbariError(400, "User name can't be empty")
+		}
+		desiredUsers[user.UserName] = user
+		currentUser, ok := currentUsers[user.UserName]
+		if !ok {
+			if user.Ldap {
+				c.log.Warnf("LDAP user %s not found, it must be synchronized before", user.UserName)
+				continue
+			}
+			if newUserPassword == "" {
+				return nil, NewAmbariError(400, "Password is needed to create user %s", user.UserName)
+			}
+			report.add(CHANGE_CREATE, "user", user.UserName, nil, user)
+			currentUsers[user.UserName] = user
+		} else if currentUser.Active != user.Active {
+			if !user.Active && protected.isLoginUser(user.UserName) {
+				c.log.Warnf("User %s is the user logged in, it's not deactivated", user.UserName)
+				continue
+			}
+			report.add(CHANGE_UPDATE, "user", user.UserName, currentUser, user)
+		}
+	}
+
+	// Groups and their members
+	members := make(map[string]*MemberInfo)
+	currentGroups := make(map[string]SecurityGroup, len(current.Groups))
+	for _, group := range current.Groups {
+		currentGroups[group.GroupName] = group
+	}
+	for _, group := range securityModel.Groups {
+		if group.GroupName == "" {
+			return nil, NewAmbariError(400, "Group name can't be empty")
+		}
+		currentGroup, ok := currentGroups[group.GroupName]
+		if !ok {
+			if group.Ldap {
+				c.log.Warnf("LDAP group %s not found, it must be synchronized before", group.GroupName)
+				continue
+			}
+			report.add(CHANGE_CREATE, "group", group.GroupName, nil, group)
+			currentGroup = SecurityGroup{GroupName: group.GroupName}
+			currentGroups[group.GroupName] = currentGroup
+		}
+		if group.Ldap || currentGroup.Ldap {
+			// The members of LDAP group are managed by the synchronization
+			continue
+		}
+		currentMembers := make(map[string]bool, len(currentGroup.Members))
+		for _, member := range currentGroup.Members {
+			currentMembers[member] = true
+		}
+		desiredMembers := make(map[string]bool, len(group.Members))
+		for _, member := range group.Members {
+			desiredMembers[member] = true
+			if currentMembers[member] {
+				continue
+			}
+			if _, ok := currentUsers[member]; !ok {
+				c.log.Warnf("User %s not found, it can't be added in group %s", member, group.GroupName)
+				continue
+			}
+			name := fmt.Sprintf("%s:%s", group.GroupName, member)
+			members[name] = &MemberInfo{GroupName: group.GroupName, UserName: member}
+			report.add(CHANGE_CREATE, "member", name, nil, member)
+		}
+		for _, member := range currentGroup.Members {
+			if !desiredMembers[member] {
+				if protected.isLoginUser(member) && protected.groups[group.GroupName] {
+					c.log.Warnf("User %s is the user logged in, it's not removed from group %s that give it %s", member, group.GroupName, PERMISSION_AMBARI_ADMINISTRATOR)
+					continue
+				}
+				name := fmt.Sprintf("%s:%s", group.GroupName, member)
+				members[name] = &MemberInfo{GroupName: group.GroupName, UserName: member}
+				report.add(CHANGE_DELETE, "member", name, member, nil)
+			}
+		}
+	}
+
+	// Privileges
+	currentPrivileges := make(map[string]SecurityPrivilege, len(current.Privileges))
+	for _, privilege := range current.Privileges {
+		currentPrivileges[privilege.key()] = privilege
+	}
+	desiredPrivileges := make(map[string]SecurityPrivilege, len(securityModel.Privileges))
+	for _, privilege := range securityModel.Privileges {
+		desiredPrivileges[privilege.key()] = privilege
+		if _, ok := currentPrivileges[privilege.key()]; ok {
+			continue
+		}
+		if privilege.PrincipalType == PRINCIPAL_TYPE_USER {
+			if _, ok := currentUsers[privilege.PrincipalName]; !ok {
+				c.log.Warnf("User %s not found, it can't get privilege %s", privilege.PrincipalName, privilege.key())
+				continue
+			}
+		} else if privilege.PrincipalType == PRINCIPAL_TYPE_GROUP {
+			if _, ok := currentGroups[privilege.PrincipalName]; !ok {
+				c.log.Warnf("Group %s not found, it can't get privilege %s", privilege.PrincipalName, privilege.key())
+				continue
+			}
+		} else {
+			return nil, NewAmbariError(400, "Principal type of privilege %s must be %s or %s", privilege.key(), PRINCIPAL_TYPE_USER, PRINCIPAL_TYPE_GROUP)
+		}
+		report.add(CHANGE_CREATE, "privilege", privilege.key(), nil, privilege)
+	}
+	for _, privilege := range current.Privileges {
+		if _, ok := desiredPrivileges[privilege.key()]; ok || !deletePrivileges {
+			continue
+		}
+		if protected.isProtected(privilege) {
+			c.log.Warnf("Privilege %s give %s to the user logged in, it's not deleted", privilege.key(), PERMISSION_AMBARI_ADMINISTRATOR)
+			continue
+		}
+		report.add(CHANGE_DELETE, "privilege", privilege.key(), privilege, nil)
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Resource {
+		case "user":
+			user := desiredUsers[change.Name]
+			if change.Action == CHANGE_CREATE {
+				_, err = c.CreateUser(&User{UserInfo: &UserInfo{UserName: user.UserName, Password: newUserPassword, Active: &user.Active}})
+			} else {
+				err = c.ActivateUser(user.UserName, user.Active)
+			}
+		case "group":
+			_, err = c.CreateGroup(change.Name)
+		case "member":
+			member := members[change.Name]
+			if change.Action == CHANGE_CREATE {
+				err = c.AddGroupMember(member.GroupName, member.UserName)
+			} else {
+				err = c.RemoveGroupMember(member.GroupName, member.UserName)
+			}
+		case "privilege":
+			if change.Action == CHANGE_CREATE {
+				err = c.createSecurityPrivilege(clusterName, desiredPrivileges[change.Name])
+			} else {
+				err = c.deleteSecurityPrivilege(clusterName, currentPrivileges[change.Name])
+			}
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// protectedPrincipals is the user logged in and its groups that give it AMBARI.ADMINISTRATOR, they must keep this permission
+type protectedPrincipals struct {
+	loginName string
+	groups    map[string]bool
+}
+
+// newProtectedPrincipals return the principals that give AMBARI.ADMINISTRATOR to the user logged in
+// The login name is empty when the client use token or Kerberos, so all the AMBARI.ADMINISTRATOR privileges are protected
+func newProtectedPrincipals(loginName string, current *SecurityModel) *protectedPrincipals {
+
+	protected := &protectedPrincipals{
+		loginName: loginName,
+		groups:    make(map[string]bool),
+	}
+	adminGroups := make(map[string]bool)
+	for _, privilege := range current.Privileges {
+		if privilege.Type == PRIVILEGE_TYPE_AMBARI && privilege.PermissionName == PERMISSION_AMBARI_ADMINISTRATOR && privilege.PrincipalType == PRINCIPAL_TYPE_GROUP {
+			adminGroups[privilege.PrincipalName] = true
+		}
+	}
+	for _, group := range current.Groups {
+		if !adminGroups[group.GroupName] {
+			continue
+		}
+		for _, member := range group.Members {
+			if member == loginName {
+				protected.groups[group.GroupName] = true
+			}
+		}
+	}
+
+	return protected
+}
+
+// isLoginUser return true if the user is the user logged in
+func (p *protectedPrincipals) isLoginUser(userName string) bool {
+	return p.loginName != "" && p.loginName == userName
+}
+
+// isProtected return true if the privilege give AMBARI.ADMINISTRATOR to the user logged in, or can give it when the user is not known
+func (p *protectedPrincipals) isProtected(privilege SecurityPrivilege) bool {
+
+	if privilege.Type != PRIVILEGE_TYPE_AMBARI || privilege.PermissionName != PERMISSION_AMBARI_ADMINISTRATOR {
+		return false
+	}
+	if p.loginName == "" {
+		return true
+	}
+	if privilege.PrincipalType == PRINCIPAL_TYPE_GROUP {
+		return p.groups[privilege.PrincipalName]
+	}
+
+	return p.isLoginUser(privilege.PrincipalName)
+}
+
+// currentSecurityModel return the security model of Ambari, with the CLUSTER privileges given on clusterName
+func (c *AmbariClient) currentSecurityModel(clusterName string) (*SecurityModel, error) {
+
+	users, err := c.Users()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.Groups()
+	if err != nil {
+		return nil, err
+	}
+
+	securityModel := &SecurityModel{
+		Users:      make([]SecurityUser, 0, len(users)),
+		Groups:     make([]SecurityGroup, 0, len(groups)),
+		Privileges: make([]SecurityPrivilege, 0),
+	}
+	for _, user := range users {
+		securityModel.Users = append(securityModel.Users, SecurityUser{
+			UserName: user.UserInfo.UserName,
+			Active:   user.UserInfo.IsActive(),
+			Ldap:     !user.UserInfo.IsLocal(),
+		})
+		privileges, err := c.UserPrivileges(user.UserInfo.UserName)
+		if err != nil {
+			return nil, err
+		}
+		securityModel.Privileges = append(securityModel.Privileges, toSecurityPrivileges(clusterName, PRINCIPAL_TYPE_USER, user.UserInfo.UserName, privileges)...)
+	}
+	for _, group := range groups {
+		members := group.MemberNames()
+		sort.Strings(members)
+		securityModel.Groups = append(securityModel.Groups, SecurityGroup{
+			GroupName: group.GroupInfo.GroupName,
+			Ldap:      !group.GroupInfo.IsLocal(),
+			Members:   members,
+		})
+		privileges, err := c.GroupPrivileges(group.GroupInfo.GroupName)
+		if err != nil {
+			return nil, err
+		}
+		securityModel.Privileges = append(securityModel.Privileges, toSecurityPrivileges(clusterName, PRINCIPAL_TYPE_GROUP, group.GroupInfo.GroupName, privileges)...)
+	}
+
+	sort.Slice(securityModel.Users, func(i, j int) bool {
+		return securityModel.Users[i].UserName < securityModel.Users[j].UserName
+	})
+	sort.Slice(securityModel.Groups, func(i, j int) bool {
+		return securityModel.Groups[i].GroupName < securityModel.Groups[j].GroupName
+	})
+	sort.Slice(securityModel.Privileges, func(i, j int) bool {
+		return securityModel.Privileges[i].key() < securityModel.Privileges[j].key()
+	})
+
+	return securityModel, nil
+}
+
+// toSecurityPrivileges return the privileges given directly to the principal, on Ambari, on clusterName and on view instances
+func toSecurityPrivileges(clusterName string, principalType string, principalName string, privileges []Privilege) []SecurityPrivilege {
+
+	securityPrivileges := make([]SecurityPrivilege, 0, len(privileges))
+	for _, privilege := range privileges {
+		info := privilege.PrivilegeInfo
+		if info == nil || info.PrincipalType != principalType || info.PrincipalName != principalName {
+			continue
+		}
+		if info.Type == PRIVILEGE_TYPE_CLUSTER && info.ClusterName != clusterName {
+			continue
+		}
+		securityPrivileges = append(securityPrivileges, SecurityPrivilege{
+			Type:           info.Type,
+			ViewName:       info.ViewName,
+			Version:        info.Version,
+			InstanceName:   info.InstanceName,
+			PermissionName: info.PermissionName,
+			PrincipalName:  info.PrincipalName,
+			PrincipalType:  info.PrincipalType,
+		})
+	}
+
+	return securityPrivileges
+}
+
+// createSecurityPrivilege permit to give the privilege on its scope
+func (c *AmbariClient) createSecurityPrivilege(clusterName string, securityPrivilege SecurityPrivilege) error {
+
+	privilege := &Privilege{
+		PrivilegeInfo: &PrivilegeInfo{
+			PermissionName: securityPrivilege.PermissionName,
+			PrincipalName:  securityPrivilege.PrincipalName,
+			PrincipalType:  securityPrivilege.PrincipalType,
+		},
+	}
+	var err error
+	switch securityPrivilege.Type {
+	case PRIVILEGE_TYPE_AMBARI:
+		_, err = c.CreateAmbariPrivilege(privilege)
+	case PRIVILEGE_TYPE_CLUSTER:
+		_, err = c.CreatePrivilege(clusterName, privilege)
+	case PRIVILEGE_TYPE_VIEW:
+		_, err = c.CreateViewPrivilege(securityPrivilege.ViewName, securityPrivilege.Version, securityPrivilege.InstanceName, privilege)
+	default:
+		err = NewAmbariError(400, "Type of privilege %s must be %s, %s or %s", securityPrivilege.key(), PRIVILEGE_TYPE_AMBARI, PRIVILEGE_TYPE_CLUSTER, PRIVILEGE_TYPE_VIEW)
+	}
+
+	return err
+}
+
+// deleteSecurityPrivilege permit to remove the privilege on its scope
+// The privilege is searched again, because the security model has not the privilege ID
+func (c *AmbariClient) deleteSecurityPrivilege(clusterName string, securityPrivilege SecurityPrivilege) error {
+
+	var privileges []Privilege
+	var err error
+	switch securityPrivilege.Type {
+	case PRIVILEGE_TYPE_AMBARI:
+		privileges, err = c.AmbariPrivileges()
+	case PRIVILEGE_TYPE_CLUSTER:
+		privileges, err = c.Privileges(clusterName)
+	case PRIVILEGE_TYPE_VIEW:
+		privileges, err = c.ViewPrivileges(securityPrivilege.ViewName, securityPrivilege.Version, securityPrivilege.InstanceName)
+	}
+	if err != nil {
+		return err
+	}
+	privilege := FindPrivilege(privileges, securityPrivilege.PermissionName, securityPrivilege.PrincipalName, securityPrivilege.PrincipalType)
+	if privilege == nil {
+		return nil
+	}
+
+	switch securityPrivilege.Type {
+	case PRIVILEGE_TYPE_AMBARI:
+		return c.DeleteAmbariPrivilege(privilege.PrivilegeInfo.PrivilegeId)
+	case PRIVILEGE_TYPE_VIEW:
+		return c.DeleteViewPrivilege(securityPrivilege.ViewName, securityPrivilege.Version, securityPrivilege.InstanceName, privilege.PrivilegeInfo.PrivilegeId)
+	default:
+		return c.DeletePrivilege(clusterName, privilege.PrivilegeInfo.PrivilegeId)
+	}
+}
@@ -0,0 +1,178 @@
+package client
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestSecurityModel(t *testing.T) {
+
+	// Source Ambari
+	source := ambaritest.NewServer()
+	defer source.Close()
+	source.AddCluster("paris", "HDP-2.6")
+	source.AddCluster("other", "HDP-2.6")
+	source.AddUser("admin", USER_TYPE_LOCAL, true)
+	source.AddUser("alice", USER_TYPE_LOCAL, true)
+	source.AddUser("bob", USER_TYPE_LOCAL, false)
+	source.AddUser("carol", USER_TYPE_LDAP, true)
+	source.AddGroup("ops", "bob", "alice")
+	source.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER)
+	source.AddClusterPrivilege("paris", PERMISSION_CLUSTER_OPERATOR, "ops", PRINCIPAL_TYPE_GROUP)
+	source.AddClusterPrivilege("other", PERMISSION_CLUSTER_USER, "alice", PRINCIPAL_TYPE_USER)
+	source.AddViewPrivilege("FILES", "1.0.0", "files", PERMISSION_VIEW_USER, "ops", PRINCIPAL_TYPE_GROUP)
+
+	securityModel, err := New(source.BaseURL(), "admin", "admin").ExportSecurityModel("paris")
+	assert.NoError(t, err)
+	assert.Equal(t, []SecurityUser{
+		{UserName: "admin", Active: true},
+		{UserName: "alice", Active: true},
+		{UserName: "bob", Active: false},
+		{UserName: "carol", Active: true, Ldap: true},
+	}, securityModel.Users)
+	assert.Equal(t, []SecurityGroup{{GroupName: "ops", Members: []string{"alice", "bob"}}}, securityModel.Groups)
+	// The privilege on other cluster is not exported
+	assert.Equal(t, []SecurityPrivilege{
+		{Type: PRIVILEGE_TYPE_AMBARI, PermissionName: PERMISSION_AMBARI_ADMINISTRATOR, PrincipalName: "admin", PrincipalType: PRINCIPAL_TYPE_USER},
+		{Type: PRIVILEGE_TYPE_CLUSTER, PermissionName: PERMISSION_CLUSTER_OPERATOR, PrincipalName: "ops", PrincipalType: PRINCIPAL_TYPE_GROUP},
+		{Type: PRIVILEGE_TYPE_VIEW, ViewName: "FILES", Version: "1.0.0", InstanceName: "files", PermissionName: PERMISSION_VIEW_USER, PrincipalName: "ops", PrincipalType: PRINCIPAL_TYPE_GROUP},
+	}, securityModel.Privileges)
+
+	// Marshal and unmarshal
+	data, err := securityModel.Marshal(CONFIG_FORMAT_YAML)
+	assert.NoError(t, err)
+	securityModel, err = UnmarshalSecurityModel(data, CONFIG_FORMAT_YAML)
+	assert.NoError(t, err)
+
+	// Target Ambari
+	target := ambaritest.NewServer()
+	defer target.Close()
+	target.AddCluster("london", "HDP-2.6")
+	target.AddUser("admin", USER_TYPE_LOCAL, true)
+	target.AddUser("bob", USER_TYPE_LOCAL, true)
+	target.AddUser("dave", USER_TYPE_LOCAL, true)
+	target.AddGroup("ops", "dave")
+	target.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER)
+	target.AddClusterPrivilege("london", PERMISSION_CLUSTER_ADMINISTRATOR, "dave", PRINCIPAL_TYPE_USER)
+	client := New(target.BaseURL(), "admin", "admin")
+
+	// Password is needed to create users
+	_, err = client.ImportSecurityModel("london", securityModel, "", true)
+	assert.Error(t, err)
+
+	// Dry run
+	client.SetDryRun(NewDryRunRecorder())
+	report, err := client.ImportSecurityModel("london", securityModel, "changeme", true)
+	assert.NoError(t, err)
+	changes := make([]string, 0, len(report.Changes))
+	for _, change := range report.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s %s", change.Action, change.Resource, change.Name))
+	}
+	assert.Equal(t, []string{
+		"create user alice",
+		"update user bob",
+		"create member ops:alice",
+		"create member ops:bob",
+		"delete member ops:dave",
+		"create privilege CLUSTER:GROUP:ops:CLUSTER.OPERATOR",
+		"create privilege VIEW:FILES/1.0.0/files:GROUP:ops:VIEW.USER",
+		"delete privilege CLUSTER:USER:dave:CLUSTER.ADMINISTRATOR",
+	}, changes)
+	user, err := client.User("alice")
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	// Import
+	client.SetDryRun(nil)
+	report, err = client.ImportSecurityModel("london", securityModel, "changeme", true)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(report.Changes))
+	user, err = client.User("alice")
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+	user, err = client.User("bob")
+	assert.NoError(t, err)
+	assert.False(t, user.UserInfo.IsActive())
+	user, err = client.User("dave")
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+	user, err = client.User("carol")
+	assert.NoError(t, err)
+	assert.Nil(t, user)
+
+	// Nothing to do on second import
+	report, err = client.ImportSecurityModel("london", securityModel, "changeme", true)
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+	importedModel, err := client.ExportSecurityModel("london")
+	assert.NoError(t, err)
+	assert.Equal(t, securityModel.Groups, importedModel.Groups)
+	assert.Equal(t, securityModel.Privileges, importedModel.Privileges)
+}
+
+func TestImportSecurityModelKeepLoginAdministrator(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("london", "HDP-2.6")
+	server.AddUser("admin", USER_TYPE_LOCAL, true)
+	server.AddUser("alice", USER_TYPE_LOCAL, true)
+	server.AddUser("bob", USER_TYPE_LOCAL, true)
+	server.AddGroup("admins", "admin", "alice")
+	server.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER)
+	server.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "admins", PRINCIPAL_TYPE_GROUP)
+	server.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "bob", PRINCIPAL_TYPE_USER)
+	server.AddClusterPrivilege("london", PERMISSION_CLUSTER_USER, "alice", PRINCIPAL_TYPE_USER)
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// The model from another Ambari, where admin is not administrator
+	securityModel := &SecurityModel{
+		Users: []SecurityUser{
+			{UserName: "admin", Active: false},
+			{UserName: "alice", Active: true},
+			{UserName: "bob", Active: true},
+		},
+		Groups: []SecurityGroup{{GroupName: "admins", Members: []string{"alice"}}},
+	}
+
+	// The privileges not in model are kept by default
+	client.SetDryRun(NewDryRunRecorder())
+	report, err := client.ImportSecurityModel("london", securityModel, "changeme", false)
+	assert.NoError(t, err)
+	for _, change := range report.Changes {
+		assert.NotEqual(t, "privilege", change.Resource)
+	}
+
+	// The user logged in keep AMBARI.ADMINISTRATOR
+	client.SetDryRun(nil)
+	report, err = client.ImportSecurityModel("london", securityModel, "changeme", true)
+	assert.NoError(t, err)
+	changes := make([]string, 0, len(report.Changes))
+	for _, change := range report.Changes {
+		changes = append(changes, fmt.Sprintf("%s %s %s", change.Action, change.Resource, change.Name))
+	}
+	assert.Equal(t, []string{
+		"delete privilege AMBARI:USER:bob:AMBARI.ADMINISTRATOR",
+		"delete privilege CLUSTER:USER:alice:CLUSTER.USER",
+	}, changes)
+	user, err := client.User("admin")
+	assert.NoError(t, err)
+	assert.True(t, user.UserInfo.IsActive())
+	privileges, err := client.AmbariPrivileges()
+	assert.NoError(t, err)
+	assert.NotNil(t, FindPrivilege(privileges, PERMISSION_AMBARI_ADMINISTRATOR, "admin", PRINCIPAL_TYPE_USER))
+	assert.NotNil(t, FindPrivilege(privileges, PERMISSION_AMBARI_ADMINISTRATOR, "admins", PRINCIPAL_TYPE_GROUP))
+	assert.Nil(t, FindPrivilege(privileges, PERMISSION_AMBARI_ADMINISTRATOR, "bob", PRINCIPAL_TYPE_USER))
+
+	// When the user logged in is not known, no AMBARI.ADMINISTRATOR privilege is deleted
+	server.AddAmbariPrivilege(PERMISSION_AMBARI_ADMINISTRATOR, "bob", PRINCIPAL_TYPE_USER)
+	client.SetDryRun(NewDryRunRecorder())
+	client.Client().UserInfo = nil
+	report, err = client.ImportSecurityModel("london", securityModel, "changeme", true)
+	assert.NoError(t, err)
+	for _, change := range report.Changes {
+		assert.NotEqual(t, "privilege", change.Resource)
+	}
+}
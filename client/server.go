@@ -0,0 +1,84 @@
+// This file permit to get informations about Ambari server, like its version
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	SERVICE_AMBARI          = "AMBARI"
+	COMPONENT_AMBARI_SERVER = "AMBARI_SERVER"
+)
+
+// ServerInfo object
+type ServerInfo struct {
+	ServerInfoInfo *ServerInfoInfo `json:"RootServiceComponents"`
+}
+type ServerInfoInfo struct {
+	ServiceName   string            `json:"service_name,omitempty"`
+	ComponentName string            `json:"component_name,omitempty"`
+	Version       string            `json:"component_version,omitempty"`
+	ServerClock   int64             `json:"server_clock,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+}
+
+// String return server info object as Json string
+func (s *ServerInfo) String() string {
+	json, _ := json.Marshal(s)
+	return string(json)
+}
+
+// ServerInfo permit to get informations about Ambari server, like its version
+// It return the server info if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ServerInfo(opts ...RequestOption) (*ServerInfo, error) {
+
+	path := "/services/" + SERVICE_AMBARI + "/components/" + COMPONENT_AMBARI_SERVER
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	serverInfo := &ServerInfo{}
+	err = json.Unmarshal(resp.Body(), serverInfo)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return server info: %s", serverInfo)
+
+	return serverInfo, nil
+}
+
+// Ping permit to check that Ambari server is reachable and that the client can use it
+// The timeout 0 mean no timeout
+// It return error if Ambari not respond before the timeout or if it refuse the call, like bad credentials
+func (c *AmbariClient) Ping(timeout time.Duration) error {
+
+	c.log.Debugw("Ping", "timeout", timeout)
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	path := "/services/" + SERVICE_AMBARI + "/components/" + COMPONENT_AMBARI_SERVER
+	resp, err := c.Client().R().SetContext(ctx).SetQueryParam("fields", "RootServiceComponents/component_version").Get(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to ping: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
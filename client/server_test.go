@@ -0,0 +1,60 @@
+package client
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func (s *ClientTestSuite) TestServerInfo() {
+
+	serverInfo, err := s.client.ServerInfo()
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), serverInfo)
+	if serverInfo != nil {
+		assert.Equal(s.T(), COMPONENT_AMBARI_SERVER, serverInfo.ServerInfoInfo.ComponentName)
+		assert.NotEmpty(s.T(), serverInfo.ServerInfoInfo.Version)
+	}
+
+	err = s.client.Ping(10 * time.Second)
+	assert.NoError(s.T(), err)
+}
+
+func TestPing(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services/AMBARI/components/AMBARI_SERVER" {
+			w.WriteHeader(404)
+			return
+		}
+		if _, password, _ := r.BasicAuth(); password != "admin" {
+			w.WriteHeader(401)
+			return
+		}
+		if r.URL.Query().Get("slow") == "true" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Write([]byte(`{"RootServiceComponents": {"component_name": "AMBARI_SERVER", "component_version": "2.7.5.0", "service_name": "AMBARI"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	err := client.Ping(time.Second)
+	assert.NoError(t, err)
+	serverInfo, err := client.ServerInfo()
+	assert.NoError(t, err)
+	assert.Equal(t, "2.7.5.0", serverInfo.ServerInfoInfo.Version)
+
+	// Timeout
+	client.Client().SetQueryParam("slow", "true")
+	err = client.Ping(50 * time.Millisecond)
+	assert.Error(t, err)
+
+	// Bad credentials
+	client = New(server.URL, "admin", "bad")
+	err = client.Ping(time.Second)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+}
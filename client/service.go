@@ -4,10 +4,9 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	log "github.com/sirupsen/logrus"
-	"time"
 )
 
 const (
@@ -25,6 +24,10 @@ type Service struct {
 	ServiceInfo *ServiceInfo `json:"ServiceInfo"`
 	Components  []Component  `json:"components,omitempty"`
 }
+type ServicesResponse struct {
+	Response
+	Items []Service `json:"items"`
+}
 type ServiceInfo struct {
 	ClusterName      string `json:"cluster_name,omitempty"`
 	ServiceName      string `json:"service_name,omitempty"`
@@ -53,7 +56,7 @@ func (c *AmbariClient) CreateService(service *Service) (*Service, error) {
 	if service == nil {
 		panic("Service can't be nil")
 	}
-	log.Debugf("Service: %s", service.String())
+	c.log.Debugf("Service: %s", service.String())
 
 	service.CleanBeforeSave()
 	service.ServiceInfo.State = SERVICE_INIT
@@ -67,9 +70,9 @@ func (c *AmbariClient) CreateService(service *Service) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to create: ", resp)
+	c.log.Debug("Response to create: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	service, err = c.Service(service.ServiceInfo.ClusterName, service.ServiceInfo.ServiceName)
@@ -77,7 +80,7 @@ func (c *AmbariClient) CreateService(service *Service) (*Service, error) {
 		return nil, err
 	}
 
-	log.Debugf("Return service: %s", service)
+	c.log.Debugf("Return service: %s", service)
 
 	return service, nil
 
@@ -87,7 +90,7 @@ func (c *AmbariClient) CreateService(service *Service) (*Service, error) {
 // It return Service if is found
 // It return nil is service is not found
 // It return error if something wrong with the API call
-func (c *AmbariClient) Service(clusterName string, serviceName string) (*Service, error) {
+func (c *AmbariClient) Service(clusterName string, serviceName string, opts ...RequestOption) (*Service, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
@@ -95,20 +98,19 @@ func (c *AmbariClient) Service(clusterName string, serviceName string) (*Service
 	if serviceName == "" {
 		panic("ServiceName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
+	c.log.Debugw("Service", "cluster_name", clusterName, "service_name", serviceName)
 
 	path := fmt.Sprintf("/clusters/%s/services/%s", clusterName, serviceName)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	service := &Service{}
@@ -116,7 +118,7 @@ func (c *AmbariClient) Service(clusterName string, serviceName string) (*Service
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return service: %s", service)
+	c.log.Debugf("Return service: %s", service)
 
 	return service, nil
 }
@@ -129,7 +131,12 @@ func (c *AmbariClient) UpdateService(service *Service) (*Service, error) {
 	if service == nil {
 		panic("Service can't be nil")
 	}
-	log.Debug("Service: ", service)
+	c.log.Debugw("UpdateService", "service", service)
+	if c.validation {
+		if err := ValidateService(service); err != nil {
+			return nil, err
+		}
+	}
 	service.CleanBeforeSave()
 
 	path := fmt.Sprintf("/clusters/%s/services/%s", service.ServiceInfo.ClusterName, service.ServiceInfo.ServiceName)
@@ -141,9 +148,9 @@ func (c *AmbariClient) UpdateService(service *Service) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to update: ", resp)
+	c.log.Debug("Response to update: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 
 	// Get the service
@@ -155,7 +162,7 @@ func (c *AmbariClient) UpdateService(service *Service) (*Service, error) {
 		return nil, NewAmbariError(500, "Can't get service that just updated")
 	}
 
-	log.Debugf("Return service: %s", service.String())
+	c.log.Debugf("Return service: %s", service.String())
 
 	return service, err
 
@@ -172,8 +179,7 @@ func (c *AmbariClient) DeleteService(clusterName string, serviceName string) err
 	if serviceName == "" {
 		panic("ServiceName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
+	c.log.Debugw("DeleteService", "cluster_name", clusterName, "service_name", serviceName)
 
 	// Stop service before to delete it
 	_, err := c.StopService(clusterName, serviceName, false, true)
@@ -196,9 +202,9 @@ func (c *AmbariClient) DeleteService(clusterName string, serviceName string) err
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to delete service: ", resp)
+	c.log.Debug("Response to delete service: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	return nil
@@ -215,8 +221,13 @@ func (c *AmbariClient) SendRequestService(request *Request) (*RequestTask, error
 	if request == nil {
 		panic("Request can't be nil")
 	}
-	log.Debug("Request: ", request)
+	c.log.Debugw("SendRequestService", "request", request)
 	service := request.Body.(*Service)
+	if c.validation {
+		if err := ValidateService(service); err != nil {
+			return nil, err
+		}
+	}
 	serviceTemp := &Service{
 		ServiceInfo: &ServiceInfo{
 			State:            service.ServiceInfo.State,
@@ -225,7 +236,7 @@ func (c *AmbariClient) SendRequestService(request *Request) (*RequestTask, error
 	}
 	request.Body = serviceTemp
 
-	log.Debug("Sended Request: ", request)
+	c.log.Debug("Sended Request: ", request)
 
 	path := fmt.Sprintf("/clusters/%s/services/%s", service.ServiceInfo.ClusterName, service.ServiceInfo.ServiceName)
 	jsonData, err := json.Marshal(request)
@@ -236,9 +247,9 @@ func (c *AmbariClient) SendRequestService(request *Request) (*RequestTask, error
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response when send request: ", resp)
+	c.log.Debug("Response when send request: ", resp)
 	if resp.StatusCode() >= 300 {
-		return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+		return nil, NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
 		return nil, nil
@@ -248,7 +259,7 @@ func (c *AmbariClient) SendRequestService(request *Request) (*RequestTask, error
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	return requestTask, err
 
@@ -262,11 +273,11 @@ func (c *AmbariClient) InstallService(service *Service) (*Service, error) {
 	if service == nil {
 		panic("Service can't be nil")
 	}
-	log.Debug("Service: ", service)
+	c.log.Debugw("InstallService", "service", service)
 
 	// Check if service is already installed
 	if service.ServiceInfo.State == SERVICE_INSTALLED {
-		log.Debugf("The service %s is already installed", service.ServiceInfo.ServiceName)
+		c.log.Debugf("The service %s is already installed", service.ServiceInfo.ServiceName)
 		return service, nil
 	}
 
@@ -276,15 +287,10 @@ func (c *AmbariClient) InstallService(service *Service) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
-	for service.ServiceInfo.State != SERVICE_INSTALLED {
-		time.Sleep(10 * time.Second)
-		service, err = c.Service(service.ServiceInfo.ClusterName, service.ServiceInfo.ServiceName)
-		if err != nil {
-			return nil, err
-		}
-	}
+	ctx, cancel := c.waitTimeout(context.Background())
+	defer cancel()
 
-	return service, nil
+	return c.WaitForServiceState(ctx, service.ServiceInfo.ClusterName, service.ServiceInfo.ServiceName, SERVICE_INSTALLED)
 }
 
 // StartService start the HDP service
@@ -298,9 +304,7 @@ func (c *AmbariClient) StartService(clusterName string, serviceName string, disa
 	if serviceName == "" {
 		panic("ServiceName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
-	log.Debug("DisableMaintenanceMode: ", disableMaintenanceMode)
+	c.log.Debugw("StartService", "cluster_name", clusterName, "service_name", serviceName, "disable_maintenance_mode", disableMaintenanceMode)
 
 	// Get the service
 	service, err := c.Service(clusterName, serviceName)
@@ -313,7 +317,7 @@ func (c *AmbariClient) StartService(clusterName string, serviceName string, disa
 
 	// Check if service is already started
 	if service.ServiceInfo.State == SERVICE_STARTED {
-		log.Debugf("Service %s is already started", service.ServiceInfo.ServiceName)
+		c.log.Debugf("Service %s is already started", service.ServiceInfo.ServiceName)
 		return service, nil
 	}
 
@@ -343,7 +347,7 @@ func (c *AmbariClient) StartService(clusterName string, serviceName string, disa
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 	}
 
@@ -367,10 +371,7 @@ func (c *AmbariClient) StopService(clusterName string, serviceName string, enabl
 	if serviceName == "" {
 		panic("ServiceName can't be empty")
 	}
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("ServiceName: ", serviceName)
-	log.Debug("EnableMaintenanceMode: ", enableMaintenanceMode)
-	log.Debug("Force: ", force)
+	c.log.Debugw("StopService", "cluster_name", clusterName, "service_name", serviceName, "enable_maintenance_mode", enableMaintenanceMode, "force", force)
 
 	// Get the service
 	service, err := c.Service(clusterName, serviceName)
@@ -383,7 +384,7 @@ func (c *AmbariClient) StopService(clusterName string, serviceName string, enabl
 
 	// Check if service is already stopped
 	if service.ServiceInfo.State == SERVICE_STOPPED {
-		log.Debugf("The service %s is already stopped", service.ServiceInfo.ServiceName)
+		c.log.Debugf("The service %s is already stopped", service.ServiceInfo.ServiceName)
 		return service, nil
 	}
 
@@ -413,7 +414,7 @@ func (c *AmbariClient) StopService(clusterName string, serviceName string, enabl
 
 		// Check the status
 		if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-			return nil, NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+			return nil, NewRequestError(requestTask)
 		}
 
 	}
@@ -444,9 +445,7 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 	if cluster == nil {
 		panic("Cluster can't be nil")
 	}
-	log.Debug("Cluster: ", cluster)
-	log.Debug("EnableMaintenanceMode: ", enableMaintenanceMode)
-	log.Debug("Force: ", force)
+	c.log.Debugw("StopAllServices", "cluster", cluster, "enable_maintenance_mode", enableMaintenanceMode, "force", force)
 
 	// Stop all services
 	service := &Service{
@@ -456,7 +455,7 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 	}
 	if force == true {
 		service.ServiceInfo.MaintenanceState = MAINTENANCE_STATE_OFF
-		log.Debugf("Disable maintenance state before stop all services")
+		c.log.Debugf("Disable maintenance state before stop all services")
 	}
 	request := &Request{
 		RequestInfo: &RequestInfo{
@@ -473,13 +472,13 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to stop all services: ", resp)
+	c.log.Debug("Response to stop all services: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 
 	if len(resp.Body()) == 0 {
-		log.Debugf("All service already stopped")
+		c.log.Debugf("All service already stopped")
 		return nil
 	}
 	requestTask := &RequestTask{}
@@ -487,7 +486,7 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 	if err != nil {
 		return err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	// Wait the end of the request
 	err = requestTask.Wait(c, cluster.ClusterInfo.ClusterName)
@@ -497,13 +496,13 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 
 	// Check the status
 	if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-		return NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+		return NewRequestError(requestTask)
 	}
 
 	// Put all services in maintenance state if needed
 	if enableMaintenanceMode == true {
 
-		log.Debugf("Enable maintenance state after stop all services")
+		c.log.Debugf("Enable maintenance state after stop all services")
 		service := &Service{
 			ServiceInfo: &ServiceInfo{
 				MaintenanceState: MAINTENANCE_STATE_ON,
@@ -518,9 +517,9 @@ func (c *AmbariClient) StopAllServices(cluster *Cluster, enableMaintenanceMode b
 		if err != nil {
 			return err
 		}
-		log.Debug("Response to put all services in maintenance state: ", resp)
+		c.log.Debug("Response to put all services in maintenance state: ", resp)
 		if resp.StatusCode() >= 300 {
-			return NewAmbariError(resp.StatusCode(), resp.Status())
+			return NewAmbariErrorFromResponse(resp)
 		}
 	}
 
@@ -535,7 +534,7 @@ func (c *AmbariClient) StartAllServices(cluster *Cluster, disableMaintenanceMode
 	if cluster == nil {
 		panic("Cluster can't be nil")
 	}
-	log.Debug("Cluster: ", cluster)
+	c.log.Debugw("StartAllServices", "cluster", cluster)
 
 	// Start all services
 	service := &Service{
@@ -545,7 +544,7 @@ func (c *AmbariClient) StartAllServices(cluster *Cluster, disableMaintenanceMode
 	}
 	if disableMaintenanceMode == true {
 		service.ServiceInfo.MaintenanceState = MAINTENANCE_STATE_OFF
-		log.Debugf("Disable maintenance state in all services before start them")
+		c.log.Debugf("Disable maintenance state in all services before start them")
 	}
 	request := &Request{
 		RequestInfo: &RequestInfo{
@@ -562,12 +561,12 @@ func (c *AmbariClient) StartAllServices(cluster *Cluster, disableMaintenanceMode
 	if err != nil {
 		return err
 	}
-	log.Debug("Response to start all services: ", resp)
+	c.log.Debug("Response to start all services: ", resp)
 	if resp.StatusCode() >= 300 {
-		return NewAmbariError(resp.StatusCode(), resp.Status())
+		return NewAmbariErrorFromResponse(resp)
 	}
 	if len(resp.Body()) == 0 {
-		log.Debugf("All service already started")
+		c.log.Debugf("All service already started")
 		return nil
 	}
 	requestTask := &RequestTask{}
@@ -575,7 +574,7 @@ func (c *AmbariClient) StartAllServices(cluster *Cluster, disableMaintenanceMode
 	if err != nil {
 		return err
 	}
-	log.Debugf("Return request: %s", requestTask)
+	c.log.Debugf("Return request: %s", requestTask)
 
 	// Wait the end of the request
 	err = requestTask.Wait(c, cluster.ClusterInfo.ClusterName)
@@ -585,7 +584,7 @@ func (c *AmbariClient) StartAllServices(cluster *Cluster, disableMaintenanceMode
 
 	// Check the status
 	if requestTask.RequestTaskInfo.Status != REQUEST_COMPLETED {
-		return NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+		return NewRequestError(requestTask)
 	}
 
 	return nil
@@ -0,0 +1,258 @@
+// This file permit to manage settings in Ambari API
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/settings-resources.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	SETTING_TYPE_AMBARI_SERVER = "ambari-server"
+)
+
+// Setting object
+type Setting struct {
+	SettingInfo *SettingInfo `json:"Settings"`
+}
+type SettingsResponse struct {
+	Response
+	Items []Setting `json:"items"`
+}
+type SettingInfo struct {
+	Name            string `json:"name,omitempty"`
+	SettingType     string `json:"setting_type,omitempty"`
+	Content         string `json:"content,omitempty"`
+	UpdatedBy       string `json:"updated_by,omitempty"`
+	UpdateTimestamp int64  `json:"update_timestamp,omitempty"`
+}
+
+// String return setting object as Json string
+func (s *Setting) String() string {
+	json, _ := json.Marshal(s)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update setting
+func (s *Setting) CleanBeforeSave() *Setting {
+
+	return &Setting{
+		SettingInfo: &SettingInfo{
+			Name:        s.SettingInfo.Name,
+			SettingType: s.SettingInfo.SettingType,
+			Content:     s.SettingInfo.Content,
+		},
+	}
+}
+
+// Setting return existing setting from is name
+// It return the setting if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Setting(name string, opts ...RequestOption) (*Setting, error) {
+
+	if name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("Setting", "name", name)
+
+	path := fmt.Sprintf("/settings/%s", name)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	setting := &Setting{}
+	err = json.Unmarshal(resp.Body(), setting)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return setting: %s", setting)
+
+	return setting, nil
+}
+
+// Settings return all settings
+// It return the list of settings.
+// If not setting, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Settings(opts ...RequestOption) ([]Setting, error) {
+
+	resp, err := c.get("/settings", opts, Fields("Settings/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	settingsResponse := &SettingsResponse{}
+	err = json.Unmarshal(resp.Body(), settingsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Settings: ", settingsResponse.Items)
+
+	return settingsResponse.Items, nil
+}
+
+// CreateSetting permit to create new setting
+// It return the setting if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateSetting(setting *Setting) (*Setting, error) {
+
+	if setting == nil {
+		panic("Setting can't be nil")
+	}
+	if setting.SettingInfo.Name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("CreateSetting", "setting", setting)
+
+	// Create the setting
+	settingPayload := setting.CleanBeforeSave()
+	jsonData, err := json.Marshal(settingPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post("/settings")
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the setting
+	setting, err = c.Setting(setting.SettingInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, NewAmbariError(500, "Can't get setting that just created")
+	}
+
+	return setting, err
+
+}
+
+// UpdateSetting permit to update existing setting
+// It return the setting if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateSetting(setting *Setting) (*Setting, error) {
+
+	if setting == nil {
+		panic("Setting can't be nil")
+	}
+	if setting.SettingInfo.Name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("UpdateSetting", "setting", setting)
+
+	// Update the setting
+	path := fmt.Sprintf("/settings/%s", setting.SettingInfo.Name)
+	settingPayload := setting.CleanBeforeSave()
+	jsonData, err := json.Marshal(settingPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the setting after update
+	setting, err = c.Setting(setting.SettingInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, NewAmbariError(500, "Can't get setting that just updated")
+	}
+
+	return setting, err
+
+}
+
+// DeleteSetting permit to delete existing setting
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteSetting(name string) error {
+
+	if name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("DeleteSetting", "name", name)
+
+	path := fmt.Sprintf("/settings/%s", name)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete setting: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
+
+// ApplySetting permit to create the setting if not exist or update it if its content is not the desired one
+// It return the change report, that is empty if the setting is already as desired
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ApplySetting(setting *Setting) (*ChangeReport, error) {
+
+	if setting == nil {
+		panic("Setting can't be nil")
+	}
+	if setting.SettingInfo.Name == "" {
+		panic("Name can't be empty")
+	}
+	c.log.Debugw("ApplySetting", "setting", setting)
+
+	report := newChangeReport()
+	currentSetting, err := c.Setting(setting.SettingInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+	if currentSetting == nil {
+		report.add(CHANGE_CREATE, "setting", setting.SettingInfo.Name, nil, setting.CleanBeforeSave())
+	} else if currentSetting.SettingInfo.Content != setting.SettingInfo.Content || currentSetting.SettingInfo.SettingType != setting.SettingInfo.SettingType {
+		report.add(CHANGE_UPDATE, "setting", setting.SettingInfo.Name, currentSetting.CleanBeforeSave(), setting.CleanBeforeSave())
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			_, err = c.CreateSetting(setting)
+		case CHANGE_UPDATE:
+			_, err = c.UpdateSetting(setting)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
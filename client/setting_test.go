@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestSetting() {
+
+	// Create setting
+	setting := &Setting{
+		SettingInfo: &SettingInfo{
+			Name:        "test",
+			SettingType: SETTING_TYPE_AMBARI_SERVER,
+			Content:     `{"key": "value"}`,
+		},
+	}
+	setting, err := s.client.CreateSetting(setting)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), setting)
+	if setting != nil {
+		assert.Equal(s.T(), "test", setting.SettingInfo.Name)
+		assert.Equal(s.T(), SETTING_TYPE_AMBARI_SERVER, setting.SettingInfo.SettingType)
+		assert.Equal(s.T(), `{"key": "value"}`, setting.SettingInfo.Content)
+		assert.Equal(s.T(), "admin", setting.SettingInfo.UpdatedBy)
+	}
+
+	// Get setting
+	setting, err = s.client.Setting("test")
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), setting)
+
+	// Update setting
+	setting.SettingInfo.Content = `{"key": "value2"}`
+	setting, err = s.client.UpdateSetting(setting)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), setting)
+	if setting != nil {
+		assert.Equal(s.T(), `{"key": "value2"}`, setting.SettingInfo.Content)
+	}
+
+	// Get all settings
+	settings, err := s.client.Settings()
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), settings)
+
+	// Delete setting
+	err = s.client.DeleteSetting("test")
+	assert.NoError(s.T(), err)
+	setting, err = s.client.Setting("test")
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), setting)
+}
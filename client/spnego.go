@@ -0,0 +1,165 @@
+// This file permit to authenticate on Ambari API with Kerberos (SPNEGO) instead of basic auth
+
+package client
+
+import (
+	"fmt"
+	krbclient "github.com/jcmturner/gokrb5/v8/client"
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/credentials"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/jcmturner/gokrb5/v8/spnego"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// KerberosConfig permit to set how to get the Kerberos ticket
+// It use the keytab if KeytabPath is set, else it use the credential cache (like after kinit)
+type KerberosConfig struct {
+	// Krb5ConfPath is the path of krb5.conf, /etc/krb5.conf by default
+	Krb5ConfPath string `json:"krb5_conf_path,omitempty" yaml:"krb5_conf_path,omitempty"`
+	// Username and Realm are the principal to use with the keytab
+	Username string `json:"username,omitempty" yaml:"username,omitempty"`
+	Realm    string `json:"realm,omitempty" yaml:"realm,omitempty"`
+	// KeytabPath is the keytab that contain the principal key
+	KeytabPath string `json:"keytab_path,omitempty" yaml:"keytab_path,omitempty"`
+	// CCachePath is the credential cache, KRB5CCNAME or /tmp/krb5cc_<uid> by default
+	CCachePath string `json:"ccache_path,omitempty" yaml:"ccache_path,omitempty"`
+	// SPN is the service principal of Ambari, HTTP/<ambari host> by default
+	SPN string `json:"spn,omitempty" yaml:"spn,omitempty"`
+}
+
+type spnegoTransport struct {
+	next      http.RoundTripper
+	negotiate func(req *http.Request) error
+	log       *clientLogger
+}
+
+// SetKerberosAuth permit to use Kerberos authentication instead of basic auth
+// The client negotiate when Ambari ask it, and negotiate again each time Ambari return 401 (like when the session expire)
+// It return error if it can't get the Kerberos ticket
+func (c *AmbariClient) SetKerberosAuth(kerberosConfig *KerberosConfig) error {
+
+	if kerberosConfig == nil {
+		panic("KerberosConfig can't be nil")
+	}
+	c.log.Debugw("SetKerberosAuth", "kerberos_config", kerberosConfig)
+
+	krb5ConfPath := kerberosConfig.Krb5ConfPath
+	if krb5ConfPath == "" {
+		krb5ConfPath = "/etc/krb5.conf"
+	}
+	krb5Conf, err := config.Load(krb5ConfPath)
+	if err != nil {
+		return err
+	}
+
+	var krbClient *krbclient.Client
+	if kerberosConfig.KeytabPath != "" {
+		if kerberosConfig.Username == "" {
+			return NewAmbariError(400, "Username is needed to use keytab %s", kerberosConfig.KeytabPath)
+		}
+		realm := kerberosConfig.Realm
+		if realm == "" {
+			realm = krb5Conf.LibDefaults.DefaultRealm
+		}
+		kt, err := keytab.Load(kerberosConfig.KeytabPath)
+		if err != nil {
+			return err
+		}
+		krbClient = krbclient.NewWithKeytab(kerberosConfig.Username, realm, kt, krb5Conf, krbclient.DisablePAFXFAST(true))
+	} else {
+		ccachePath := kerberosConfig.CCachePath
+		if ccachePath == "" {
+			ccachePath = defaultCCachePath()
+		}
+		ccache, err := credentials.LoadCCache(ccachePath)
+		if err != nil {
+			return err
+		}
+		krbClient, err = krbclient.NewFromCCache(ccache, krb5Conf, krbclient.DisablePAFXFAST(true))
+		if err != nil {
+			return err
+		}
+	}
+	if err = krbClient.Login(); err != nil {
+		return err
+	}
+
+	c.setNegotiate(func(req *http.Request) error {
+		return spnego.SetSPNEGOHeader(krbClient, req, kerberosConfig.SPN)
+	})
+
+	return nil
+}
+
+// setNegotiate permit to replace basic auth by the negotiate function
+func (c *AmbariClient) setNegotiate(negotiate func(req *http.Request) error) {
+
+	c.checkOwnTransport()
+	c.client.UserInfo = nil
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*spnegoTransport)
+		return ok
+	})
+	if transport != nil {
+		transport.(*spnegoTransport).negotiate = negotiate
+		return
+	}
+
+	c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		return &spnegoTransport{
+			next:      next,
+			negotiate: negotiate,
+			log:       c.log,
+		}
+	})
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *spnegoTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+// RoundTrip send the request and negotiate if Ambari ask it
+func (t *spnegoTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !strings.HasPrefix(resp.Header.Get("WWW-Authenticate"), "Negotiate") {
+		return resp, err
+	}
+
+	// Send the request again with Kerberos ticket
+	authReq := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return resp, nil
+		}
+		if authReq.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	t.log.Debugf("Negotiate Kerberos authentication for %s %s", req.Method, req.URL.Path)
+	if err = t.negotiate(authReq); err != nil {
+		return nil, fmt.Errorf("Can't negotiate Kerberos authentication: %s", err.Error())
+	}
+
+	return t.next.RoundTrip(authReq)
+}
+
+// defaultCCachePath return the credential cache used by kinit
+func defaultCCachePath() string {
+
+	if ccachePath := os.Getenv("KRB5CCNAME"); ccachePath != "" {
+		return strings.TrimPrefix(ccachePath, "FILE:")
+	}
+
+	return fmt.Sprintf("/tmp/krb5cc_%d", os.Getuid())
+}
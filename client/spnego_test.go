@@ -0,0 +1,83 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestSpnegoNegotiate(t *testing.T) {
+
+	calls := 0
+	bodies := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Authorization") != "Negotiate token" {
+			w.Header().Set("WWW-Authenticate", "Negotiate")
+			w.WriteHeader(401)
+			return
+		}
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.setNegotiate(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Negotiate token")
+		return nil
+	})
+
+	// Negotiate on GET
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	assert.Equal(t, 2, calls)
+
+	// Negotiate on PUT with body
+	calls = 0
+	bodies = make([]string, 0)
+	resp, err := client.Client().R().SetBody(`{"Clusters": {"cluster_name": "test2"}}`).Put("/clusters/test")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode())
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestSpnegoNotNegotiate(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(401)
+	}))
+	defer server.Close()
+
+	negotiate := false
+	client := New(server.URL, "admin", "admin")
+	client.setNegotiate(func(req *http.Request) error {
+		negotiate = true
+		return nil
+	})
+	_, err := client.Cluster("test")
+	assert.Error(t, err)
+	assert.False(t, negotiate)
+}
+
+func TestDefaultCCachePath(t *testing.T) {
+
+	os.Setenv("KRB5CCNAME", "FILE:/tmp/krb5cc_test")
+	defer os.Unsetenv("KRB5CCNAME")
+	assert.Equal(t, "/tmp/krb5cc_test", defaultCCachePath())
+}
+
+func TestSetKerberosAuthWithoutConfig(t *testing.T) {
+
+	client := New("http://ambari-server:8080/api/v1", "admin", "admin")
+	err := client.SetKerberosAuth(&KerberosConfig{
+		Krb5ConfPath: "/not/exist/krb5.conf",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,366 @@
+// This file permit to read the stack definitions from Ambari API
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Stack object
+type Stack struct {
+	StackInfo *StackInfo `json:"Stacks"`
+}
+type StacksResponse struct {
+	Response
+	Items []Stack `json:"items"`
+}
+type StackInfo struct {
+	StackName string `json:"stack_name"`
+}
+
+// StackVersion object
+type StackVersion struct {
+	StackVersionInfo *StackVersionInfo `json:"Versions"`
+}
+type StackVersionsResponse struct {
+	Response
+	Items []StackVersion `json:"items"`
+}
+type StackVersionInfo struct {
+	StackName          string `json:"stack_name"`
+	StackVersion       string `json:"stack_version"`
+	Active             bool   `json:"active"`
+	MinUpgradeVersion  string `json:"min_upgrade_version,omitempty"`
+	MinJdk             string `json:"min_jdk,omitempty"`
+	MaxJdk             string `json:"max_jdk,omitempty"`
+	ParentStackVersion string `json:"parent_stack_version,omitempty"`
+}
+
+// StackService object
+type StackService struct {
+	StackServiceInfo *StackServiceInfo `json:"StackServices"`
+}
+type StackServicesResponse struct {
+	Response
+	Items []StackService `json:"items"`
+}
+type StackServiceInfo struct {
+	StackName        string                     `json:"stack_name"`
+	StackVersion     string                     `json:"stack_version"`
+	ServiceName      string                     `json:"service_name"`
+	ServiceVersion   string                     `json:"service_version,omitempty"`
+	ServiceType      string                     `json:"service_type,omitempty"`
+	DisplayName      string                     `json:"display_name,omitempty"`
+	Comments         string                     `json:"comments,omitempty"`
+	UserName         string                     `json:"user_name,omitempty"`
+	Selection        string                     `json:"selection,omitempty"`
+	RequiredServices []string                   `json:"required_services,omitempty"`
+	ConfigTypes      map[string]StackConfigType `json:"config_types,omitempty"`
+}
+type StackConfigType struct {
+	Supports map[string]string `json:"supports,omitempty"`
+}
+
+// StackComponent object
+type StackComponent struct {
+	StackComponentInfo *StackComponentInfo `json:"StackServiceComponents"`
+	Dependencies       []StackDependency   `json:"dependencies,omitempty"`
+}
+type StackComponentsResponse struct {
+	Response
+	Items []StackComponent `json:"items"`
+}
+type StackComponentInfo struct {
+	StackName         string `json:"stack_name"`
+	StackVersion      string `json:"stack_version"`
+	ServiceName       string `json:"service_name"`
+	ComponentName     string `json:"component_name"`
+	DisplayName       string `json:"display_name,omitempty"`
+	ComponentCategory string `json:"component_category"`
+	Cardinality       string `json:"cardinality,omitempty"`
+	IsClient          bool   `json:"is_client"`
+	IsMaster          bool   `json:"is_master"`
+	RecoveryEnabled   bool   `json:"recovery_enabled"`
+}
+type StackDependency struct {
+	StackDependencyInfo *StackDependencyInfo `json:"Dependencies"`
+}
+type StackDependencyInfo struct {
+	ComponentName          string `json:"component_name"`
+	ServiceName            string `json:"service_name,omitempty"`
+	DependentComponentName string `json:"dependent_component_name"`
+	DependentServiceName   string `json:"dependent_service_name,omitempty"`
+	Scope                  string `json:"scope,omitempty"`
+}
+
+// StackConfiguration object
+type StackConfiguration struct {
+	StackConfigurationInfo *StackConfigurationInfo `json:"StackConfigurations"`
+}
+type StackConfigurationsResponse struct {
+	Response
+	Items []StackConfiguration `json:"items"`
+}
+type StackConfigurationInfo struct {
+	StackName           string   `json:"stack_name"`
+	StackVersion        string   `json:"stack_version"`
+	ServiceName         string   `json:"service_name"`
+	Type                string   `json:"type"`
+	PropertyName        string   `json:"property_name"`
+	PropertyValue       string   `json:"property_value"`
+	PropertyDescription string   `json:"property_description,omitempty"`
+	PropertyType        []string `json:"property_type,omitempty"`
+}
+
+// String return stack component object as Json string
+func (s *StackComponent) String() string {
+	json, _ := json.Marshal(s)
+	return string(json)
+}
+
+// IsValidCardinality permit to check if the number of hosts where the component is installed respect the cardinality defined by the stack
+// The cardinality can be like 1, 0+, 1+, 1-2 or ALL. The totalHosts is the number of hosts in cluster and it's only used with ALL.
+func (s *StackComponentInfo) IsValidCardinality(count int, totalHosts int) bool {
+
+	cardinality := strings.TrimSpace(s.Cardinality)
+	switch {
+	case cardinality == "":
+		return true
+	case cardinality == "ALL":
+		return count == totalHosts
+	case strings.HasSuffix(cardinality, "+"):
+		min, err := strconv.Atoi(strings.TrimSuffix(cardinality, "+"))
+		if err != nil {
+			return false
+		}
+		return count >= min
+	case strings.Contains(cardinality, "-"):
+		bounds := strings.SplitN(cardinality, "-", 2)
+		min, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return false
+		}
+		max, err := strconv.Atoi(bounds[1])
+		if err != nil {
+			return false
+		}
+		return count >= min && count <= max
+	default:
+		exact, err := strconv.Atoi(cardinality)
+		if err != nil {
+			return false
+		}
+		return count == exact
+	}
+}
+
+// Stacks return the name of all stacks available on Ambari
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Stacks(opts ...RequestOption) ([]Stack, error) {
+
+	resp, err := c.get("/stacks", opts, Fields("Stacks/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	stacksResponse := &StacksResponse{}
+	err = json.Unmarshal(resp.Body(), stacksResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Stacks: ", stacksResponse.Items)
+
+	return stacksResponse.Items, nil
+}
+
+// StackVersions return all versions of stack
+// It return nil if stack not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StackVersions(stackName string, opts ...RequestOption) ([]StackVersion, error) {
+
+	if stackName == "" {
+		panic("StackName can't be empty")
+	}
+	c.log.Debugw("StackVersions", "stack_name", stackName)
+
+	path := fmt.Sprintf("/stacks/%s/versions", stackName)
+	resp, err := c.get(path, opts, Fields("Versions/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	stackVersionsResponse := &StackVersionsResponse{}
+	err = json.Unmarshal(resp.Body(), stackVersionsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Stack versions: ", stackVersionsResponse.Items)
+
+	return stackVersionsResponse.Items, nil
+}
+
+// StackServices return all services available on stack version
+// It return nil if stack version not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StackServices(stackName string, stackVersion string, opts ...RequestOption) ([]StackService, error) {
+
+	if stackName == "" {
+		panic("StackName can't be empty")
+	}
+	if stackVersion == "" {
+		panic("StackVersion can't be empty")
+	}
+	c.log.Debugw("StackServices", "stack_name", stackName, "stack_version", stackVersion)
+
+	path := fmt.Sprintf("/stacks/%s/versions/%s/services", stackName, stackVersion)
+	resp, err := c.get(path, opts, Fields("StackServices/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	stackServicesResponse := &StackServicesResponse{}
+	err = json.Unmarshal(resp.Body(), stackServicesResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Stack services: ", stackServicesResponse.Items)
+
+	return stackServicesResponse.Items, nil
+}
+
+// StackComponents return all components of service on stack version, with is category, cardinality and dependencies
+// It return nil if service not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StackComponents(stackName string, stackVersion string, serviceName string, opts ...RequestOption) ([]StackComponent, error) {
+
+	if stackName == "" {
+		panic("StackName can't be empty")
+	}
+	if stackVersion == "" {
+		panic("StackVersion can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	c.log.Debugw("StackComponents", "stack_name", stackName, "stack_version", stackVersion, "service_name", serviceName)
+
+	path := fmt.Sprintf("/stacks/%s/versions/%s/services/%s/components", stackName, stackVersion, serviceName)
+	resp, err := c.get(path, opts, Fields("StackServiceComponents/*,dependencies/Dependencies/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	stackComponentsResponse := &StackComponentsResponse{}
+	err = json.Unmarshal(resp.Body(), stackComponentsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Stack components: ", stackComponentsResponse.Items)
+
+	return stackComponentsResponse.Items, nil
+}
+
+// StackConfigurations return all default properties of service on stack version
+// It return nil if service not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StackConfigurations(stackName string, stackVersion string, serviceName string, opts ...RequestOption) ([]StackConfiguration, error) {
+
+	if stackName == "" {
+		panic("StackName can't be empty")
+	}
+	if stackVersion == "" {
+		panic("StackVersion can't be empty")
+	}
+	if serviceName == "" {
+		panic("ServiceName can't be empty")
+	}
+	c.log.Debugw("StackConfigurations", "stack_name", stackName, "stack_version", stackVersion, "service_name", serviceName)
+
+	path := fmt.Sprintf("/stacks/%s/versions/%s/services/%s/configurations", stackName, stackVersion, serviceName)
+	resp, err := c.get(path, opts, Fields("StackConfigurations/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	stackConfigurationsResponse := &StackConfigurationsResponse{}
+	err = json.Unmarshal(resp.Body(), stackConfigurationsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Stack configurations: ", stackConfigurationsResponse.Items)
+
+	return stackConfigurationsResponse.Items, nil
+}
+
+// StackDefaultConfigurations return the default configuration of service on stack version, grouped by configuration type (like hdfs-site)
+// It return nil if service not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StackDefaultConfigurations(stackName string, stackVersion string, serviceName string) ([]Configuration, error) {
+
+	stackConfigurations, err := c.StackConfigurations(stackName, stackVersion, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	if stackConfigurations == nil {
+		return nil, nil
+	}
+
+	return groupStackConfigurations(stackConfigurations), nil
+}
+
+// groupStackConfigurations permit to convert the list of stack properties to configurations
+func groupStackConfigurations(stackConfigurations []StackConfiguration) []Configuration {
+
+	configurations := make([]Configuration, 0)
+	index := make(map[string]int)
+	for _, stackConfiguration := range stackConfigurations {
+		configurationType := strings.TrimSuffix(stackConfiguration.StackConfigurationInfo.Type, ".xml")
+		position, ok := index[configurationType]
+		if !ok {
+			position = len(configurations)
+			index[configurationType] = position
+			configurations = append(configurations, Configuration{
+				Type:       configurationType,
+				Properties: make(map[string]string),
+			})
+		}
+		configurations[position].Properties[stackConfiguration.StackConfigurationInfo.PropertyName] = stackConfiguration.StackConfigurationInfo.PropertyValue
+	}
+
+	return configurations
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func (s *ClientTestSuite) TestStack() {
+
+	// Get stacks
+	stacks, err := s.client.Stacks()
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), stacks)
+
+	// Get stack versions
+	stackVersions, err := s.client.StackVersions("HDP")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), stackVersions)
+
+	// Get stack services
+	stackServices, err := s.client.StackServices("HDP", "2.6")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), stackServices)
+
+	// Get stack components
+	stackComponents, err := s.client.StackComponents("HDP", "2.6", "ZOOKEEPER")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), stackComponents)
+	for _, stackComponent := range stackComponents {
+		if stackComponent.StackComponentInfo.ComponentName == "ZOOKEEPER_SERVER" {
+			assert.Equal(s.T(), COMPONENT_MASTER, stackComponent.StackComponentInfo.ComponentCategory)
+			assert.Equal(s.T(), "1+", stackComponent.StackComponentInfo.Cardinality)
+		}
+	}
+
+	// Get default configurations
+	configurations, err := s.client.StackDefaultConfigurations("HDP", "2.6", "ZOOKEEPER")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), configurations)
+
+	// Stack not found
+	stackServices, err = s.client.StackServices("HDP", "0.0")
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), stackServices)
+}
+
+func TestStackComponentCardinality(t *testing.T) {
+
+	stackComponentInfo := &StackComponentInfo{Cardinality: "1"}
+	assert.True(t, stackComponentInfo.IsValidCardinality(1, 3))
+	assert.False(t, stackComponentInfo.IsValidCardinality(2, 3))
+
+	stackComponentInfo.Cardinality = "1+"
+	assert.True(t, stackComponentInfo.IsValidCardinality(3, 3))
+	assert.False(t, stackComponentInfo.IsValidCardinality(0, 3))
+
+	stackComponentInfo.Cardinality = "1-2"
+	assert.True(t, stackComponentInfo.IsValidCardinality(2, 3))
+	assert.False(t, stackComponentInfo.IsValidCardinality(3, 3))
+
+	stackComponentInfo.Cardinality = "ALL"
+	assert.True(t, stackComponentInfo.IsValidCardinality(3, 3))
+	assert.False(t, stackComponentInfo.IsValidCardinality(2, 3))
+
+	stackComponentInfo.Cardinality = ""
+	assert.True(t, stackComponentInfo.IsValidCardinality(0, 3))
+}
+
+func TestGroupStackConfigurations(t *testing.T) {
+
+	stackConfigurations := []StackConfiguration{
+		{StackConfigurationInfo: &StackConfigurationInfo{Type: "zoo.cfg.xml", PropertyName: "tickTime", PropertyValue: "3000"}},
+		{StackConfigurationInfo: &StackConfigurationInfo{Type: "zookeeper-env.xml", PropertyName: "zk_user", PropertyValue: "zookeeper"}},
+		{StackConfigurationInfo: &StackConfigurationInfo{Type: "zoo.cfg.xml", PropertyName: "clientPort", PropertyValue: "2181"}},
+	}
+	configurations := groupStackConfigurations(stackConfigurations)
+	assert.Equal(t, 2, len(configurations))
+	assert.Equal(t, "zoo.cfg", configurations[0].Type)
+	assert.Equal(t, "2181", configurations[0].Properties["clientPort"])
+	assert.Equal(t, "zookeeper-env", configurations[1].Type)
+}
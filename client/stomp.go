@@ -0,0 +1,125 @@
+// This file permit to read and write the STOMP frames used by Ambari events
+// STOMP documentation: https://stomp.github.io/stomp-specification-1.2.html
+
+package client
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+)
+
+const (
+	stompConnect    = "CONNECT"
+	stompConnected  = "CONNECTED"
+	stompSubscribe  = "SUBSCRIBE"
+	stompMessage    = "MESSAGE"
+	stompError      = "ERROR"
+	stompDisconnect = "DISCONNECT"
+)
+
+var stompHeaderReplacer = strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r", ":", "\\c")
+var stompHeaderUnreplacer = strings.NewReplacer("\\\\", "\\", "\\n", "\n", "\\r", "\r", "\\c", ":")
+
+// stompFrame is STOMP frame, like MESSAGE
+type stompFrame struct {
+	command string
+	headers map[string]string
+	body    []byte
+}
+
+func newStompFrame(command string, headers map[string]string) *stompFrame {
+	return &stompFrame{
+		command: command,
+		headers: headers,
+	}
+}
+
+// marshal return the frame as expected by STOMP server
+func (f *stompFrame) marshal() []byte {
+
+	buffer := &bytes.Buffer{}
+	buffer.WriteString(f.command + "\n")
+	keys := make([]string, 0, len(f.headers))
+	for key := range f.headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if f.command == stompConnect {
+			buffer.WriteString(key + ":" + f.headers[key] + "\n")
+		} else {
+			buffer.WriteString(stompHeaderReplacer.Replace(key) + ":" + stompHeaderReplacer.Replace(f.headers[key]) + "\n")
+		}
+	}
+	buffer.WriteString("\n")
+	buffer.Write(f.body)
+	buffer.WriteByte(0)
+
+	return buffer.Bytes()
+}
+
+// stompReader read the frames from the data received, that can contain many frames or partial frame
+type stompReader struct {
+	buffer []byte
+}
+
+// read return the complete frames received, and keep the partial frame for the next call
+// The heart beats (empty lines) are ignored
+func (r *stompReader) read(data []byte) ([]*stompFrame, error) {
+
+	r.buffer = append(r.buffer, data...)
+	frames := make([]*stompFrame, 0)
+	for {
+		r.buffer = bytes.TrimLeft(r.buffer, "\r\n")
+		end := bytes.IndexByte(r.buffer, 0)
+		if end < 0 {
+			return frames, nil
+		}
+		frame, err := parseStompFrame(r.buffer[:end])
+		if err != nil {
+			return frames, err
+		}
+		frames = append(frames, frame)
+		r.buffer = r.buffer[end+1:]
+	}
+}
+
+// parseStompFrame read the frame without its final NULL
+func parseStompFrame(data []byte) (*stompFrame, error) {
+
+	separator := bytes.Index(data, []byte("\n\n"))
+	separatorLength := 2
+	if crlfSeparator := bytes.Index(data, []byte("\r\n\r\n")); crlfSeparator >= 0 && (separator < 0 || crlfSeparator < separator) {
+		separator = crlfSeparator
+		separatorLength = 4
+	}
+	if separator < 0 {
+		return nil, NewAmbariError(500, "Invalid STOMP frame: %s", string(data))
+	}
+
+	lines := strings.Split(strings.ReplaceAll(string(data[:separator]), "\r\n", "\n"), "\n")
+	frame := &stompFrame{
+		command: lines[0],
+		headers: make(map[string]string, len(lines)-1),
+		body:    data[separator+separatorLength:],
+	}
+	for _, line := range lines[1:] {
+		index := strings.Index(line, ":")
+		if index < 0 {
+			return nil, NewAmbariError(500, "Invalid STOMP header: %s", line)
+		}
+		key := line[:index]
+		value := line[index+1:]
+		if frame.command != stompConnected {
+			key = stompHeaderUnreplacer.Replace(key)
+			value = stompHeaderUnreplacer.Replace(value)
+		}
+		// The first header win when it's repeated
+		if _, ok := frame.headers[key]; !ok {
+			frame.headers[key] = value
+		}
+	}
+
+	return frame, nil
+}
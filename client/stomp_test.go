@@ -0,0 +1,35 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStompFrame(t *testing.T) {
+
+	// Marshal
+	frame := newStompFrame(stompSubscribe, map[string]string{
+		"id":          "sub-0",
+		"destination": "/events/a:b",
+	})
+	assert.Equal(t, "SUBSCRIBE\ndestination:/events/a\\cb\nid:sub-0\n\n\x00", string(frame.marshal()))
+
+	// Read many frames, with heart beats and partial frame
+	reader := &stompReader{}
+	frames, err := reader.read([]byte("\nCONNECTED\nversion:1.2\n\n\x00MESSAGE\ndestination:/events/hosts\n\n{\"hostName\""))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(frames))
+	assert.Equal(t, stompConnected, frames[0].command)
+	assert.Equal(t, "1.2", frames[0].headers["version"])
+
+	frames, err = reader.read([]byte(":\"worker01\"}\x00\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(frames))
+	assert.Equal(t, stompMessage, frames[0].command)
+	assert.Equal(t, "/events/hosts", frames[0].headers["destination"])
+	assert.Equal(t, `{"hostName":"worker01"}`, string(frames[0].body))
+
+	// Invalid frame
+	_, err = reader.read([]byte("MESSAGE\x00"))
+	assert.Error(t, err)
+}
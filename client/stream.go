@@ -0,0 +1,225 @@
+// This file permit to read very large resources collections without load all the response in memory
+// The gzip compression can be asked to Ambari to reduce the size of the responses on the network.
+
+package client
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// SetCompression permit to always ask Ambari to send gzip responses, the responses are decompressed by the client
+// The default http.Transport already ask gzip, but not the transports with DisableCompression or the custom round trippers.
+// Ambari compress the responses only when api.compression.enabled is true (default)
+func (c *AmbariClient) SetCompression(enabled bool) {
+	c.log.Debugw("SetCompression", "compression", enabled)
+
+	if enabled {
+		c.client.SetHeader("Accept-Encoding", "gzip")
+	} else {
+		c.client.Header.Del("Accept-Encoding")
+	}
+}
+
+// StreamItems permit to read the items of resources collection one by one, like /clusters/test/requests/1/tasks
+// The callback is called with the Json of each item while the response is read, so the collection is never fully in memory.
+// The streaming stop on the first error returned by the callback, and this error is returned.
+// It return nil without call the callback if the collection not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) StreamItems(path string, callback func(item json.RawMessage) error, opts ...RequestOption) error {
+
+	if path == "" {
+		panic("Path can't be empty")
+	}
+	if callback == nil {
+		panic("Callback can't be nil")
+	}
+	c.log.Debugw("StreamItems", "path", path)
+
+	return c.stream(path, callback, opts)
+}
+
+// StreamTasks permit to read the tasks of request one by one, without their output
+// It's the same as Tasks, but it use little memory on the requests with thousand of tasks
+// It return error if something wrong when it call the API or if the callback return error
+func (c *AmbariClient) StreamTasks(clusterName string, requestId int, callback func(task *Task) error, opts ...RequestOption) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if callback == nil {
+		panic("Callback can't be nil")
+	}
+	c.log.Debugw("StreamTasks", "cluster_name", clusterName, "request_id", requestId)
+
+	path := fmt.Sprintf("/clusters/%s/requests/%d/tasks", clusterName, requestId)
+	return c.stream(path, func(item json.RawMessage) error {
+		task := &Task{}
+		if err := json.Unmarshal(item, task); err != nil {
+			return err
+		}
+		return callback(task)
+	}, opts, Fields("Tasks/id", "Tasks/request_id", "Tasks/cluster_name", "Tasks/host_name", "Tasks/role", "Tasks/command", "Tasks/command_detail", "Tasks/status", "Tasks/exit_code", "Tasks/start_time", "Tasks/end_time"))
+}
+
+// StreamAlertHistory permit to read the state changes of alerts on cluster one by one
+// Use the predicates to get only some alerts, like Where(Gt("AlertHistory/timestamp", "1546300800000"))
+// It return error if something wrong when it call the API or if the callback return error
+func (c *AmbariClient) StreamAlertHistory(clusterName string, callback func(alertHistory *AlertHistory) error, opts ...RequestOption) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if callback == nil {
+		panic("Callback can't be nil")
+	}
+	c.log.Debugw("StreamAlertHistory", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/alert_history", clusterName)
+	return c.stream(path, func(item json.RawMessage) error {
+		alertHistory := &AlertHistory{}
+		if err := json.Unmarshal(item, alertHistory); err != nil {
+			return err
+		}
+		return callback(alertHistory)
+	}, opts, Fields("AlertHistory/*"))
+}
+
+// StreamHostsMetrics permit to read metrics of all hosts of cluster, host by host
+// The callback is called with the hostname and one series per metric found on host
+// It return error if something wrong when it call the API or if the callback return error
+func (c *AmbariClient) StreamHostsMetrics(clusterName string, query *MetricQuery, callback func(hostname string, series []MetricSeries) error) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if query == nil {
+		panic("Query can't be nil")
+	}
+	if len(query.Metrics) == 0 {
+		panic("Metrics can't be empty")
+	}
+	if callback == nil {
+		panic("Callback can't be nil")
+	}
+	c.log.Debugw("StreamHostsMetrics", "cluster_name", clusterName, "query", query)
+
+	path := fmt.Sprintf("/clusters/%s/hosts", clusterName)
+	return c.stream(path, func(item json.RawMessage) error {
+		host := &struct {
+			HostInfo *HostInfo `json:"Hosts"`
+		}{}
+		if err := json.Unmarshal(item, host); err != nil {
+			return err
+		}
+		if host.HostInfo == nil {
+			return NewAmbariError(500, "Host name not found in response")
+		}
+		series, err := parseMetrics(item)
+		if err != nil {
+			return err
+		}
+		return callback(host.HostInfo.Hostname, series)
+	}, nil, Fields("Hosts/host_name", query.Fields()))
+}
+
+// stream call the API with GET method and decode the items of response one by one
+func (c *AmbariClient) stream(path string, callback func(item json.RawMessage) error, opts []RequestOption, defaultOpts ...RequestOption) error {
+
+	options := newRequestOptions(opts, defaultOpts)
+	resp, err := options.request(c.Client()).SetDoNotParseResponse(true).Get(options.url(path))
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer body.Close()
+	c.log.Debug("Response status to get: ", resp.Status())
+
+	var reader io.Reader = body
+	if strings.EqualFold(resp.Header().Get("Content-Encoding"), "gzip") {
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+		respBody, _ := ioutil.ReadAll(reader)
+		return newAmbariErrorFromBody(resp, respBody)
+	}
+
+	nbItems, err := decodeItems(json.NewDecoder(reader), callback)
+	c.log.Debugf("Read %d items", nbItems)
+
+	return err
+}
+
+// decodeItems read the object and call the callback for each element of its items field, the other fields are skipped
+// It return the number of items read
+func decodeItems(decoder *json.Decoder, callback func(item json.RawMessage) error) (int, error) {
+
+	nbItems := 0
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nbItems, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nbItems, err
+		}
+		if token != "items" {
+			var skipped json.RawMessage
+			if err = decoder.Decode(&skipped); err != nil {
+				return nbItems, err
+			}
+			continue
+		}
+		token, err = decoder.Token()
+		if err != nil {
+			return nbItems, err
+		}
+		if token == nil {
+			continue
+		}
+		if token != json.Delim('[') {
+			return nbItems, NewAmbariError(500, "Invalid response, expected '[' but got '%v'", token)
+		}
+		for decoder.More() {
+			var item json.RawMessage
+			if err = decoder.Decode(&item); err != nil {
+				return nbItems, err
+			}
+			nbItems++
+			if err = callback(item); err != nil {
+				return nbItems, err
+			}
+		}
+		if err = expectDelim(decoder, ']'); err != nil {
+			return nbItems, err
+		}
+	}
+
+	return nbItems, expectDelim(decoder, '}')
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return NewAmbariError(500, "Invalid response, expected '%s' but got '%v'", delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestStreamTasks(t *testing.T) {
+
+	acceptEncoding := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		switch r.URL.Path {
+		case "/clusters/test/requests/1/tasks":
+			items := make([]string, 0, 1000)
+			for i := 1; i <= 1000; i++ {
+				items = append(items, fmt.Sprintf(`{"href": "task/%d", "Tasks": {"id": %d, "request_id": 1, "host_name": "worker%04d", "status": "COMPLETED"}}`, i, i, i))
+			}
+			body := fmt.Sprintf(`{"href": "tasks", "items": [%s], "itemTotal": 1000}`, strings.Join(items, ","))
+			if strings.Contains(acceptEncoding, "gzip") {
+				w.Header().Set("Content-Encoding", "gzip")
+				gzipWriter := gzip.NewWriter(w)
+				gzipWriter.Write([]byte(body))
+				gzipWriter.Close()
+			} else {
+				w.Write([]byte(body))
+			}
+		case "/clusters/test/requests/2/tasks":
+			w.Write([]byte(`{"href": "tasks", "items": null}`))
+		case "/clusters/test/requests/3/tasks":
+			w.WriteHeader(500)
+			w.Write([]byte(`{"status": 500, "message": "Server Error"}`))
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	// Without and with compression
+	for _, compression := range []bool{false, true} {
+		client.SetCompression(compression)
+		nbTasks := 0
+		err := client.StreamTasks("test", 1, func(task *Task) error {
+			nbTasks++
+			assert.Equal(t, fmt.Sprintf("worker%04d", nbTasks), task.TaskInfo.Hostname)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1000, nbTasks)
+		if compression {
+			assert.Equal(t, "gzip", acceptEncoding)
+		}
+	}
+
+	// The callback stop the streaming
+	errStop := errors.New("stop")
+	nbTasks := 0
+	err := client.StreamTasks("test", 1, func(task *Task) error {
+		nbTasks++
+		if nbTasks == 10 {
+			return errStop
+		}
+		return nil
+	})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, 10, nbTasks)
+
+	// No items
+	err = client.StreamTasks("test", 2, func(task *Task) error {
+		assert.Fail(t, "No task expected")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Not found
+	err = client.StreamTasks("test", 4, func(task *Task) error {
+		assert.Fail(t, "No task expected")
+		return nil
+	})
+	assert.NoError(t, err)
+
+	// Error
+	err = client.StreamTasks("test", 3, func(task *Task) error {
+		return nil
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "Server Error", err.(AmbariError).Message)
+	assert.Equal(t, 500, err.(AmbariError).Code)
+}
+
+func TestStreamHostsMetrics(t *testing.T) {
+
+	fields := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields = r.URL.Query().Get("fields")
+		w.Write([]byte(`{"items": [
+			{"Hosts": {"host_name": "worker01"}, "metrics": {"cpu": {"cpu_user": 12.5}}},
+			{"Hosts": {"host_name": "worker02"}, "metrics": {"cpu": {"cpu_user": 50}}}
+		]}`))
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+
+	values := map[string]float64{}
+	err := client.StreamHostsMetrics("test", &MetricQuery{Metrics: []string{"cpu/cpu_user"}}, func(hostname string, series []MetricSeries) error {
+		assert.Equal(t, 1, len(series))
+		assert.Equal(t, "cpu/cpu_user", series[0].Name)
+		values[hostname] = series[0].Points[0].Value
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hosts/host_name,metrics/cpu/cpu_user", fields)
+	assert.Equal(t, map[string]float64{"worker01": 12.5, "worker02": 50}, values)
+}
@@ -4,9 +4,10 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	log "github.com/sirupsen/logrus"
 	"time"
 )
 
@@ -15,6 +16,7 @@ const (
 	REQUEST_ACCEPTED  = "ACCEPTED"
 	REQUEST_COMPLETED = "COMPLETED"
 	REQUEST_ABORDED   = "ABORDED"
+	REQUEST_ABORTED   = "ABORTED"
 )
 
 type RequestTask struct {
@@ -33,6 +35,40 @@ type RequestTaskInfo struct {
 	ClusterName     string  `json:"cluster_name,omitempty"`
 }
 
+type Task struct {
+	TaskInfo *TaskInfo `json:"Tasks,omitempty"`
+}
+type TaskInfo struct {
+	Id            int                    `json:"id,omitempty"`
+	RequestId     int                    `json:"request_id,omitempty"`
+	ClusterName   string                 `json:"cluster_name,omitempty"`
+	Hostname      string                 `json:"host_name,omitempty"`
+	Role          string                 `json:"role,omitempty"`
+	Command       string                 `json:"command,omitempty"`
+	CommandDetail string                 `json:"command_detail,omitempty"`
+	Status        string                 `json:"status,omitempty"`
+	ExitCode      int                    `json:"exit_code,omitempty"`
+	StartTime     int64                  `json:"start_time,omitempty"`
+	EndTime       int64                  `json:"end_time,omitempty"`
+	Stdout        string                 `json:"stdout,omitempty"`
+	Stderr        string                 `json:"stderr,omitempty"`
+	ErrorLog      string                 `json:"error_log,omitempty"`
+	OutputLog     string                 `json:"output_log,omitempty"`
+	StructuredOut map[string]interface{} `json:"structured_out,omitempty"`
+}
+type TasksResponse struct {
+	Response
+	Items []Task `json:"items"`
+}
+
+type requestAbort struct {
+	RequestAbortInfo *requestAbortInfo `json:"Requests"`
+}
+type requestAbortInfo struct {
+	Status      string `json:"request_status"`
+	AbortReason string `json:"abort_reason"`
+}
+
 type RequestsTask struct {
 	Items []RequestTask `json:"Items"`
 }
@@ -44,31 +80,74 @@ func (r *RequestTask) String() string {
 }
 
 // Permit to wait the rerquest task is finished
+// It return error with code 408 if the request is not finished after the timeout set with SetRequestTimeout
 // It can return error if API call failed
 func (r *RequestTask) Wait(c *AmbariClient, clusterName string) error {
-	if r.RequestTaskInfo != nil {
-		isRun := true
-		for isRun {
-			requestTask, err := c.Request(clusterName, r.RequestTaskInfo.Id)
-			if err != nil {
-				return err
-			}
-			*r = *requestTask
-			if r.RequestTaskInfo.ProgressPercent < 100 {
-				log.Debugf("Task '%s' (%d) is not yet finished, state is %s (%f %%)", r.RequestTaskInfo.Context, r.RequestTaskInfo.Id, r.RequestTaskInfo.Status, r.RequestTaskInfo.ProgressPercent)
-				time.Sleep(10 * time.Second)
-			} else {
-				isRun = false
-			}
-		}
+	return r.WaitContext(context.Background(), c, clusterName)
+}
 
-		log.Debugf("Task '%s' (%d) is finished with state %s", r.RequestTaskInfo.Context, r.RequestTaskInfo.Id, r.RequestTaskInfo.Status)
-	} else {
-		log.Debugf("Task is empty...")
+// WaitContext permit to wait the request task is finished, like Wait, until the context is done
+// It return the error of context if it's done before the end of request
+func (r *RequestTask) WaitContext(ctx context.Context, c *AmbariClient, clusterName string) error {
+	if r.RequestTaskInfo == nil {
+		c.log.Debugf("Task is empty...")
+		return nil
+	}
+
+	timeoutCtx, cancel := c.waitTimeout(ctx)
+	defer cancel()
+	err := c.poll(timeoutCtx, func() (bool, error) {
+		requestTask, err := c.Request(clusterName, r.RequestTaskInfo.Id)
+		if err != nil {
+			return false, err
+		}
+		if requestTask == nil {
+			return false, NewAmbariError(404, "Request %d not found in cluster %s", r.RequestTaskInfo.Id, clusterName)
+		}
+		*r = *requestTask
+		if r.RequestTaskInfo.ProgressPercent < 100 {
+			c.log.Debugf("Task '%s' (%d) is not yet finished, state is %s (%f %%)", r.RequestTaskInfo.Context, r.RequestTaskInfo.Id, r.RequestTaskInfo.Status, r.RequestTaskInfo.ProgressPercent)
+			return false, nil
+		}
+		return true, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return NewRequestTimeoutError(r, c.requestTimeout)
+	}
+	if err != nil {
+		return err
 	}
+	c.log.Debugf("Task '%s' (%d) is finished with state %s", r.RequestTaskInfo.Context, r.RequestTaskInfo.Id, r.RequestTaskInfo.Status)
 
 	return nil
+}
+
+// SetRequestTimeout permit to stop to wait the requests, like when it start service, after the timeout
+// Ambari continue to run the request, only the client stop to wait it. Set 0 to wait forever.
+func (c *AmbariClient) SetRequestTimeout(timeout time.Duration) {
+
+	if timeout < 0 {
+		panic("Timeout can't be negative")
+	}
+	c.log.Debugw("SetRequestTimeout", "request_timeout", timeout)
+
+	c.requestTimeout = timeout
+}
+
+// NewRequestError return the error when the request is finished but not completed
+func NewRequestError(requestTask *RequestTask) AmbariError {
+	ambariError := NewAmbariError(500, "Request %d failed with status %s, task completed %d, task aborded %d, task failed %d", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.CompletedTask, requestTask.RequestTaskInfo.AbordedTask, requestTask.RequestTaskInfo.FailedTask)
+	ambariError.RequestId = requestTask.RequestTaskInfo.Id
+
+	return ambariError
+}
+
+// NewRequestTimeoutError return the error when the request is not finished after the timeout
+func NewRequestTimeoutError(requestTask *RequestTask, timeout time.Duration) AmbariError {
+	ambariError := NewAmbariError(408, "Request %d '%s' not finished after %s, status is %s (%.0f%%)", requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Context, timeout, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.ProgressPercent)
+	ambariError.RequestId = requestTask.RequestTaskInfo.Id
 
+	return ambariError
 }
 
 // String permit to get Request object as Json string
@@ -81,26 +160,25 @@ func (r *RequestsTask) String() string {
 // It return RequestTask if is found
 // It return nil is request is not found
 // It return error if something wrong with the API call
-func (c *AmbariClient) Request(clusterName string, Id int) (*RequestTask, error) {
+func (c *AmbariClient) Request(clusterName string, Id int, opts ...RequestOption) (*RequestTask, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
-	log.Debug("Id: ", Id)
+	c.log.Debugw("Request", "cluster_name", clusterName, "id", Id)
 
 	path := fmt.Sprintf("/clusters/%s/requests/%d", clusterName, Id)
-	resp, err := c.Client().R().Get(path)
+	resp, err := c.get(path, opts)
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	requestTask := &RequestTask{}
@@ -108,7 +186,7 @@ func (c *AmbariClient) Request(clusterName string, Id int) (*RequestTask, error)
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return requestTask: %s", requestTask)
+	c.log.Debugf("Return requestTask: %s", requestTask)
 
 	return requestTask, nil
 }
@@ -117,25 +195,25 @@ func (c *AmbariClient) Request(clusterName string, Id int) (*RequestTask, error)
 // It return the list of requestTask
 // It return empty list if there are no tasks
 // It return error if something wrong with the API call
-func (c *AmbariClient) Requests(clusterName string) ([]RequestTask, error) {
+func (c *AmbariClient) Requests(clusterName string, opts ...RequestOption) ([]RequestTask, error) {
 
 	if clusterName == "" {
 		panic("ClusterName can't be empty")
 	}
 
-	log.Debug("ClusterName: ", clusterName)
+	c.log.Debugw("Requests", "cluster_name", clusterName)
 
-	path := fmt.Sprintf("/clusters/%s/requests?fields=*", clusterName)
-	resp, err := c.Client().R().Get(path)
+	path := fmt.Sprintf("/clusters/%s/requests", clusterName)
+	resp, err := c.get(path, opts, Fields("*"))
 	if err != nil {
 		return nil, err
 	}
-	log.Debug("Response to get: ", resp)
+	c.log.Debug("Response to get: ", resp)
 	if resp.StatusCode() >= 300 {
 		if resp.StatusCode() == 404 {
 			return nil, nil
 		} else {
-			return nil, NewAmbariError(resp.StatusCode(), resp.Status())
+			return nil, NewAmbariErrorFromResponse(resp)
 		}
 	}
 	requestsTask := &RequestsTask{}
@@ -143,7 +221,125 @@ func (c *AmbariClient) Requests(clusterName string) ([]RequestTask, error) {
 	if err != nil {
 		return nil, err
 	}
-	log.Debugf("Return requestsTask: %s", requestsTask)
+	c.log.Debugf("Return requestsTask: %s", requestsTask)
 
 	return requestsTask.Items, nil
 }
+
+// AbortRequest permit to abort request that is not yet finished, like a restart that wait a dead host
+// The tasks that are already finished are not rollbacked
+// It return the request if all work fine
+// It return error if request not found or if something wrong with the API call
+func (c *AmbariClient) AbortRequest(clusterName string, Id int) (*RequestTask, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("AbortRequest", "cluster_name", clusterName, "id", Id)
+
+	path := fmt.Sprintf("/clusters/%s/requests/%d", clusterName, Id)
+	jsonData, err := json.Marshal(&requestAbort{
+		RequestAbortInfo: &requestAbortInfo{
+			Status:      REQUEST_ABORTED,
+			AbortReason: "Aborted by user",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to abort: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	requestTask, err := c.Request(clusterName, Id)
+	if err != nil {
+		return nil, err
+	}
+	if requestTask == nil {
+		return nil, NewAmbariError(500, "Can't get request that just aborted")
+	}
+	c.log.Debugf("Return requestTask: %s", requestTask)
+
+	return requestTask, nil
+}
+
+// String permit to get Task object as Json string
+func (t *Task) String() string {
+	json, _ := json.Marshal(t)
+	return string(json)
+}
+
+// Task permit to get task of request, with its output (stdout, stderr, error_log and structured_out)
+// It permit to know why the task failed
+// It return the task if is found
+// It return nil if task is not found
+// It return error if something wrong with the API call
+func (c *AmbariClient) Task(clusterName string, requestId int, taskId int, opts ...RequestOption) (*Task, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Task", "cluster_name", clusterName, "request_id", requestId, "task_id", taskId)
+
+	path := fmt.Sprintf("/clusters/%s/requests/%d/tasks/%d", clusterName, requestId, taskId)
+	resp, err := c.get(path, opts, Fields("Tasks/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	task := &Task{}
+	err = json.Unmarshal(resp.Body(), task)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return task: %s", task)
+
+	return task, nil
+}
+
+// Tasks permit to get all tasks of request, without their output
+// Use the predicates to get only the failed tasks, like Where(Eq("Tasks/status", "FAILED"))
+// It return the list of tasks
+// It return empty list if there are no tasks
+// It return error if something wrong with the API call
+func (c *AmbariClient) Tasks(clusterName string, requestId int, opts ...RequestOption) ([]Task, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Tasks", "cluster_name", clusterName, "request_id", requestId)
+
+	path := fmt.Sprintf("/clusters/%s/requests/%d/tasks", clusterName, requestId)
+	resp, err := c.get(path, opts, Fields("Tasks/id", "Tasks/request_id", "Tasks/cluster_name", "Tasks/host_name", "Tasks/role", "Tasks/command", "Tasks/command_detail", "Tasks/status", "Tasks/exit_code", "Tasks/start_time", "Tasks/end_time"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	tasksResponse := &TasksResponse{}
+	err = json.Unmarshal(resp.Body(), tasksResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Tasks: ", tasksResponse.Items)
+
+	return tasksResponse.Items, nil
+}
@@ -2,6 +2,9 @@ package client
 
 import (
 	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+	"time"
 )
 
 func (s *ClientTestSuite) TestTask() {
@@ -28,3 +31,70 @@ func (s *ClientTestSuite) TestTask() {
 	err = requestTask.Wait(s.client, "test")
 	assert.NoError(s.T(), err)
 }
+
+func TestAbortRequest(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	id := server.AddRequest("test", "Start HDFS", "IN_PROGRESS")
+
+	client := New(server.BaseURL(), "admin", "admin")
+	requestTask, err := client.AbortRequest("test", int(id))
+	assert.NoError(t, err)
+	assert.NotNil(t, requestTask)
+	assert.Equal(t, REQUEST_ABORTED, requestTask.RequestTaskInfo.Status)
+
+	_, err = client.AbortRequest("test", int(id)+1)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestTaskOutput(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	requestId := server.AddRequest("test", "Start HDFS", REQUEST_FAILED)
+	server.AddTask("test", requestId, `{"host_name": "worker01", "role": "NAMENODE", "command": "START", "status": "COMPLETED", "exit_code": 0}`)
+	server.AddTask("test", requestId, `{"host_name": "worker01", "role": "DATANODE", "command": "START", "status": "FAILED", "exit_code": 1,
+		"stdout": "Start DataNode", "stderr": "Port 50010 in use", "error_log": "/var/lib/ambari-agent/data/errors-3.txt", "structured_out": {"version": "2.6.4.0-91"}}`)
+
+	client := New(server.BaseURL(), "admin", "admin")
+	tasks, err := client.Tasks("test", int(requestId), Where(Eq("Tasks/status", "FAILED")))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(tasks))
+
+	task, err := client.Task("test", int(requestId), tasks[0].TaskInfo.Id)
+	assert.NoError(t, err)
+	assert.NotNil(t, task)
+	assert.Equal(t, "DATANODE", task.TaskInfo.Role)
+	assert.Equal(t, "Port 50010 in use", task.TaskInfo.Stderr)
+	assert.Equal(t, "Start DataNode", task.TaskInfo.Stdout)
+	assert.Equal(t, "/var/lib/ambari-agent/data/errors-3.txt", task.TaskInfo.ErrorLog)
+	assert.Equal(t, "2.6.4.0-91", task.TaskInfo.StructuredOut["version"])
+
+	task, err = client.Task("test", int(requestId), tasks[0].TaskInfo.Id+1)
+	assert.NoError(t, err)
+	assert.Nil(t, task)
+}
+
+func TestRequestTimeout(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddCluster("test", "HDP-2.6")
+	id := server.AddRequest("test", "Start HDFS", "IN_PROGRESS")
+
+	client := New(server.BaseURL(), "admin", "admin")
+	client.SetRequestTimeout(time.Nanosecond)
+	requestTask := &RequestTask{RequestTaskInfo: &RequestTaskInfo{Id: int(id)}}
+	err := requestTask.Wait(client, "test")
+	assert.True(t, IsTimeout(err))
+	ambariError, ok := err.(AmbariError)
+	assert.True(t, ok)
+	assert.Equal(t, int(id), ambariError.RequestId)
+
+	err = NewRequestError(&RequestTask{RequestTaskInfo: &RequestTaskInfo{Id: 13, Status: REQUEST_FAILED, FailedTask: 1}})
+	assert.False(t, IsTimeout(err))
+	assert.Equal(t, 13, err.(AmbariError).RequestId)
+}
@@ -0,0 +1,83 @@
+// This file permit to set the TLS options used to call Ambari API, like an internal CA or a client certificate
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+)
+
+// TLSConfig permit to set how to check Ambari certificate and how to authenticate with certificate
+type TLSConfig struct {
+	// CACertPath is the PEM file with the CA that signed Ambari certificate. They are added to the system CA.
+	CACertPath string `json:"ca_cert_path,omitempty" yaml:"ca_cert_path,omitempty"`
+	// CACert is the same as CACertPath but with the PEM content
+	CACert []byte `json:"ca_cert,omitempty" yaml:"ca_cert,omitempty"`
+	// ClientCertPath and ClientKeyPath are the PEM files with the client certificate and key (mTLS)
+	ClientCertPath string `json:"client_cert_path,omitempty" yaml:"client_cert_path,omitempty"`
+	ClientKeyPath  string `json:"client_key_path,omitempty" yaml:"client_key_path,omitempty"`
+	// InsecureSkipVerify permit to not check Ambari certificate
+	InsecureSkipVerify bool `json:"insecure_skip_verify,omitempty" yaml:"insecure_skip_verify,omitempty"`
+}
+
+// SetTLSConfig permit to set the TLS options used to call Ambari
+// It return error if it can't read the certificates
+func (c *AmbariClient) SetTLSConfig(tlsConfig *TLSConfig) error {
+
+	c.checkOwnTransport()
+	if tlsConfig == nil {
+		panic("TLSConfig can't be nil")
+	}
+	c.log.Debugw("SetTLSConfig", "tls_config", tlsConfig)
+
+	config := &tls.Config{
+		InsecureSkipVerify: tlsConfig.InsecureSkipVerify,
+	}
+
+	// CA
+	caCerts := [][]byte{}
+	if tlsConfig.CACertPath != "" {
+		caCert, err := ioutil.ReadFile(tlsConfig.CACertPath)
+		if err != nil {
+			return err
+		}
+		caCerts = append(caCerts, caCert)
+	}
+	if len(tlsConfig.CACert) > 0 {
+		caCerts = append(caCerts, tlsConfig.CACert)
+	}
+	if len(caCerts) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			c.log.Debugf("Can't load system CA, use only the given CA: %s", err.Error())
+			pool = x509.NewCertPool()
+		}
+		for _, caCert := range caCerts {
+			if !pool.AppendCertsFromPEM(caCert) {
+				return NewAmbariError(400, "Can't find certificate in CA %s", tlsConfig.CACertPath)
+			}
+		}
+		config.RootCAs = pool
+	}
+
+	// Client certificate
+	if tlsConfig.ClientCertPath != "" || tlsConfig.ClientKeyPath != "" {
+		if tlsConfig.ClientCertPath == "" || tlsConfig.ClientKeyPath == "" {
+			return NewAmbariError(400, "ClientCertPath and ClientKeyPath must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(tlsConfig.ClientCertPath, tlsConfig.ClientKeyPath)
+		if err != nil {
+			return err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	transport, err := c.transport()
+	if err != nil {
+		return err
+	}
+	transport.TLSClientConfig = config
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSetTLSConfig(t *testing.T) {
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(401)
+			return
+		}
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// Write the server certificate and key, used as CA and as client certificate
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+	cert := server.TLS.Certificates[0]
+	key, err := x509.MarshalPKCS8PrivateKey(cert.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600)
+	ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key}), 0600)
+
+	// Unknown CA
+	client := New(server.URL, "admin", "admin")
+	_, err = client.Cluster("test")
+	assert.Error(t, err)
+
+	// With CA and client certificate
+	err = client.SetTLSConfig(&TLSConfig{
+		CACertPath:     certPath,
+		ClientCertPath: certPath,
+		ClientKeyPath:  keyPath,
+	})
+	assert.NoError(t, err)
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+
+	// Insecure without client certificate
+	client = New(server.URL, "admin", "admin")
+	err = client.SetTLSConfig(&TLSConfig{InsecureSkipVerify: true})
+	assert.NoError(t, err)
+	_, err = client.Cluster("test")
+	assert.Error(t, err)
+
+	// Bad config
+	err = client.SetTLSConfig(&TLSConfig{ClientCertPath: certPath})
+	assert.Error(t, err)
+	err = client.SetTLSConfig(&TLSConfig{CACert: []byte("not a certificate")})
+	assert.Error(t, err)
+}
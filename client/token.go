@@ -0,0 +1,150 @@
+// This file permit to authenticate on Ambari API with token, like JWT from Knox SSO, instead of basic auth
+
+package client
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+const (
+	JWT_COOKIE_NAME = "hadoop-jwt"
+)
+
+// TokenProvider permit to give the token to send to Ambari
+type TokenProvider interface {
+	// Token return the current token. It's called before each call, so it need to cache the token.
+	Token() (string, error)
+	// RefreshToken is called when Ambari reject the token. It return the new token or error if it can't refresh it.
+	RefreshToken() (string, error)
+}
+
+type staticTokenProvider struct {
+	token string
+}
+
+type tokenTransport struct {
+	next       http.RoundTripper
+	provider   TokenProvider
+	cookieName string
+	log        *clientLogger
+}
+
+// StaticToken return TokenProvider that always give the same token
+func StaticToken(token string) TokenProvider {
+	return &staticTokenProvider{token: token}
+}
+
+// Token return the token
+func (p *staticTokenProvider) Token() (string, error) {
+	return p.token, nil
+}
+
+// RefreshToken return error because the static token can't be refreshed
+func (p *staticTokenProvider) RefreshToken() (string, error) {
+	return "", NewAmbariError(401, "Static token can't be refreshed")
+}
+
+// SetBearerTokenAuth permit to send the token in Authorization header instead of basic auth
+func (c *AmbariClient) SetBearerTokenAuth(provider TokenProvider) {
+
+	if provider == nil {
+		panic("Provider can't be nil")
+	}
+
+	c.setTokenAuth(provider, "")
+}
+
+// SetJWTCookieAuth permit to send the token in cookie instead of basic auth, like Knox SSO do
+// The cookie name is hadoop-jwt if empty
+func (c *AmbariClient) SetJWTCookieAuth(provider TokenProvider, cookieName string) {
+
+	if provider == nil {
+		panic("Provider can't be nil")
+	}
+	if cookieName == "" {
+		cookieName = JWT_COOKIE_NAME
+	}
+	c.log.Debug("CookieName: ", cookieName)
+
+	c.setTokenAuth(provider, cookieName)
+}
+
+func (c *AmbariClient) setTokenAuth(provider TokenProvider, cookieName string) {
+
+	c.checkOwnTransport()
+	c.client.UserInfo = nil
+
+	transport := c.findTransport(func(transport http.RoundTripper) bool {
+		_, ok := transport.(*tokenTransport)
+		return ok
+	})
+	if transport != nil {
+		transport.(*tokenTransport).provider = provider
+		transport.(*tokenTransport).cookieName = cookieName
+		return
+	}
+
+	c.wrapTransport(func(next http.RoundTripper) http.RoundTripper {
+		return &tokenTransport{
+			next:       next,
+			provider:   provider,
+			cookieName: cookieName,
+			log:        c.log,
+		}
+	})
+}
+
+// Unwrap return the round tripper used to send the request
+func (t *tokenTransport) Unwrap() http.RoundTripper {
+	return t.next
+}
+
+// RoundTrip send the request with the token, and send it again with new token if Ambari reject it
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	token, err := t.provider.Token()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(t.withToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Refresh the token
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	token, err = t.provider.RefreshToken()
+	if err != nil {
+		t.log.Debugf("Can't refresh token: %s", err.Error())
+		return resp, nil
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	t.log.Debugf("Token refreshed, call again %s %s", req.Method, req.URL.Path)
+	authReq := t.withToken(req, token)
+	if req.Body != nil && req.Body != http.NoBody {
+		if authReq.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.next.RoundTrip(authReq)
+}
+
+// withToken return copy of the request with the token
+func (t *tokenTransport) withToken(req *http.Request, token string) *http.Request {
+
+	authReq := req.Clone(req.Context())
+	if t.cookieName == "" {
+		authReq.Header.Set("Authorization", "Bearer "+token)
+	} else {
+		authReq.AddCookie(&http.Cookie{Name: t.cookieName, Value: token})
+	}
+
+	return authReq
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type testTokenProvider struct {
+	token     string
+	refreshed int
+}
+
+func (p *testTokenProvider) Token() (string, error) {
+	return p.token, nil
+}
+
+func (p *testTokenProvider) RefreshToken() (string, error) {
+	p.refreshed++
+	p.token = "new-token"
+	return p.token, nil
+}
+
+func newTokenTestServer(calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		cookie, _ := r.Cookie(JWT_COOKIE_NAME)
+		_, _, hasBasicAuth := r.BasicAuth()
+		if hasBasicAuth || (r.Header.Get("Authorization") != "Bearer new-token" && (cookie == nil || cookie.Value != "new-token")) {
+			w.WriteHeader(401)
+			return
+		}
+		w.Write([]byte(`{"Clusters": {"cluster_name": "test"}}`))
+	}))
+}
+
+func TestBearerTokenAuth(t *testing.T) {
+
+	calls := 0
+	server := newTokenTestServer(&calls)
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	provider := &testTokenProvider{token: "expired-token"}
+	client.SetBearerTokenAuth(provider)
+
+	// Token refreshed when rejected
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, provider.refreshed)
+
+	// Use the new token
+	calls = 0
+	_, err = client.Cluster("test")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestJWTCookieAuth(t *testing.T) {
+
+	calls := 0
+	server := newTokenTestServer(&calls)
+	defer server.Close()
+
+	client := New(server.URL, "admin", "admin")
+	client.SetJWTCookieAuth(StaticToken("new-token"), "")
+	cluster, err := client.Cluster("test")
+	assert.NoError(t, err)
+	assert.NotNil(t, cluster)
+	assert.Equal(t, 1, calls)
+
+	// Static token can't be refreshed
+	calls = 0
+	client.SetJWTCookieAuth(StaticToken("bad-token"), "")
+	_, err = client.Cluster("test")
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+	assert.Equal(t, 1, calls)
+}
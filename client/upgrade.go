@@ -0,0 +1,324 @@
+// This file permit to read the upgrades of cluster and to finalize them
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/index.md
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	UPGRADE_DIRECTION_UPGRADE   = "UPGRADE"
+	UPGRADE_DIRECTION_DOWNGRADE = "DOWNGRADE"
+
+	UPGRADE_ITEM_HOLDING          = "HOLDING"
+	UPGRADE_ITEM_HOLDING_FAILED   = "HOLDING_FAILED"
+	UPGRADE_ITEM_HOLDING_TIMEDOUT = "HOLDING_TIMEDOUT"
+	UPGRADE_ITEM_COMPLETED        = "COMPLETED"
+)
+
+// Upgrade is upgrade or downgrade of cluster, its ID is the request ID
+type Upgrade struct {
+	UpgradeInfo   *UpgradeInfo   `json:"Upgrade"`
+	UpgradeGroups []UpgradeGroup `json:"upgrade_groups,omitempty"`
+}
+type UpgradeInfo struct {
+	Id                       int     `json:"request_id,omitempty"`
+	ClusterName              string  `json:"cluster_name,omitempty"`
+	Direction                string  `json:"direction,omitempty"`
+	UpgradeType              string  `json:"upgrade_type,omitempty"`
+	AssociatedVersion        string  `json:"associated_version,omitempty"`
+	Context                  string  `json:"request_context,omitempty"`
+	Status                   string  `json:"request_status,omitempty"`
+	ProgressPercent          float64 `json:"progress_percent,omitempty"`
+	CreateTime               int64   `json:"create_time,omitempty"`
+	StartTime                int64   `json:"start_time,omitempty"`
+	EndTime                  int64   `json:"end_time,omitempty"`
+	SkipFailures             bool    `json:"skip_failures,omitempty"`
+	SkipServiceCheckFailures bool    `json:"skip_service_check_failures,omitempty"`
+	Suspended                bool    `json:"suspended,omitempty"`
+}
+type UpgradeGroup struct {
+	UpgradeGroupInfo *UpgradeGroupInfo `json:"UpgradeGroup"`
+	UpgradeItems     []UpgradeItem     `json:"upgrade_items,omitempty"`
+}
+type UpgradeGroupInfo struct {
+	Id              int     `json:"group_id,omitempty"`
+	Name            string  `json:"name,omitempty"`
+	Title           string  `json:"title,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	ProgressPercent float64 `json:"progress_percent,omitempty"`
+}
+type UpgradeItem struct {
+	UpgradeItemInfo *UpgradeItemInfo `json:"UpgradeItem"`
+}
+type UpgradeItemInfo struct {
+	StageId int    `json:"stage_id,omitempty"`
+	GroupId int    `json:"group_id,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Context string `json:"context,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// String permit to get upgrade object as Json string
+func (u *Upgrade) String() string {
+	json, _ := json.Marshal(u)
+	return string(json)
+}
+
+// Started return the time when upgrade started, zero time if it's not started
+func (u *UpgradeInfo) Started() time.Time {
+	if u.StartTime <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, u.StartTime*int64(time.Millisecond))
+}
+
+// Ended return the time when upgrade ended, zero time if it's not ended
+func (u *UpgradeInfo) Ended() time.Time {
+	if u.EndTime <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, u.EndTime*int64(time.Millisecond))
+}
+
+// HoldingItems return the items that wait action from user, with one of status
+func (u *Upgrade) HoldingItems(status ...string) []UpgradeItem {
+	items := make([]UpgradeItem, 0)
+	for _, group := range u.UpgradeGroups {
+		for _, item := range group.UpgradeItems {
+			for _, s := range status {
+				if item.UpgradeItemInfo.Status == s {
+					items = append(items, item)
+					break
+				}
+			}
+		}
+	}
+	return items
+}
+
+// Upgrades permit to get the upgrades and downgrades of cluster, like the history of upgrades
+// It return the list of upgrades
+// It return empty list if there are no upgrade
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Upgrades(clusterName string, opts ...RequestOption) ([]Upgrade, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Upgrades", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/upgrades", clusterName)
+	resp, err := c.get(path, opts, Fields("Upgrade/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	upgrades := &struct {
+		Items []Upgrade `json:"items"`
+	}{}
+	err = json.Unmarshal(resp.Body(), upgrades)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return upgrades: %v", upgrades.Items)
+
+	return upgrades.Items, nil
+}
+
+// Upgrade permit to get upgrade of cluster with its groups and their items
+// It return the upgrade if found
+// It return nil if upgrade not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Upgrade(clusterName string, id int, opts ...RequestOption) (*Upgrade, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Upgrade", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/upgrades/%d", clusterName, id)
+	resp, err := c.get(path, opts, Fields("Upgrade/*", "upgrade_groups/UpgradeGroup/*", "upgrade_groups/upgrade_items/UpgradeItem/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	upgrade := &Upgrade{}
+	err = json.Unmarshal(resp.Body(), upgrade)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return upgrade: %s", upgrade)
+
+	return upgrade, nil
+}
+
+// FinalizeUpgrade permit to finalize upgrade that wait the confirmation of user on its last step, like Ambari UI do with "Finalize" button
+// It return the upgrade
+// It return error if upgrade not found, if it not wait the confirmation or if something wrong when it call the API
+func (c *AmbariClient) FinalizeUpgrade(clusterName string, id int) (*Upgrade, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("FinalizeUpgrade", "cluster_name", clusterName, "id", id)
+
+	upgrade, err := c.pendingUpgrade(clusterName, id)
+	if err != nil {
+		return nil, err
+	}
+	items := upgrade.HoldingItems(UPGRADE_ITEM_HOLDING)
+	if len(items) == 0 {
+		return nil, NewAmbariError(409, "Upgrade %d not wait to be finalized", id)
+	}
+	if err = c.completeUpgradeItems(clusterName, id, items); err != nil {
+		return nil, err
+	}
+
+	return c.Upgrade(clusterName, id)
+}
+
+// SkipAndFinalizeUpgrade permit to finalize upgrade that is stopped on failed steps, like Ambari UI do with "Ignore and Proceed" then "Finalize".
+// It ask Ambari to skip the next failures, skip the steps that failed, wait the last step and confirm it.
+// It wait the last step until the request timeout set with SetRequestTimeout.
+// It return the upgrade
+// It return error if upgrade not found or already finished, or if something wrong when it call the API
+func (c *AmbariClient) SkipAndFinalizeUpgrade(clusterName string, id int) (*Upgrade, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("SkipAndFinalizeUpgrade", "cluster_name", clusterName, "id", id)
+
+	upgrade, err := c.pendingUpgrade(clusterName, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the next failures
+	if !upgrade.UpgradeInfo.SkipFailures || !upgrade.UpgradeInfo.SkipServiceCheckFailures {
+		c.log.Infof("Skip failures on upgrade %d", id)
+		err = c.updateUpgrade(clusterName, id, &UpgradeInfo{SkipFailures: true, SkipServiceCheckFailures: true})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Skip the failed steps until the last step wait the confirmation
+	ctx, cancel := c.waitTimeout(context.Background())
+	defer cancel()
+	err = c.poll(ctx, func() (bool, error) {
+		upgrade, err = c.Upgrade(clusterName, id)
+		if err != nil {
+			return false, err
+		}
+		if upgrade == nil {
+			return false, NewAmbariError(404, "Upgrade %d not found in cluster %s", id, clusterName)
+		}
+		if err = c.completeUpgradeItems(clusterName, id, upgrade.HoldingItems(UPGRADE_ITEM_HOLDING_FAILED, UPGRADE_ITEM_HOLDING_TIMEDOUT)); err != nil {
+			return false, err
+		}
+		items := upgrade.HoldingItems(UPGRADE_ITEM_HOLDING)
+		if len(items) > 0 {
+			return true, c.completeUpgradeItems(clusterName, id, items)
+		}
+		if upgrade.UpgradeInfo.ProgressPercent >= 100 {
+			return false, NewAmbariError(409, "Upgrade %d is finished with status %s without to be finalized", id, upgrade.UpgradeInfo.Status)
+		}
+		c.log.Debugf("Upgrade %d is %s (%.0f%%), wait the last step", id, upgrade.UpgradeInfo.Status, upgrade.UpgradeInfo.ProgressPercent)
+		return false, nil
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		ambariError := NewAmbariError(408, "Upgrade %d not wait to be finalized after %s", id, c.requestTimeout)
+		ambariError.RequestId = id
+		return nil, ambariError
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Upgrade(clusterName, id)
+}
+
+// pendingUpgrade return the upgrade if it's not finished
+func (c *AmbariClient) pendingUpgrade(clusterName string, id int) (*Upgrade, error) {
+
+	upgrade, err := c.Upgrade(clusterName, id)
+	if err != nil {
+		return nil, err
+	}
+	if upgrade == nil {
+		return nil, NewAmbariError(404, "Upgrade %d not found in cluster %s", id, clusterName)
+	}
+	if upgrade.UpgradeInfo.ProgressPercent >= 100 {
+		return nil, NewAmbariError(409, "Upgrade %d is already finished with status %s", id, upgrade.UpgradeInfo.Status)
+	}
+
+	return upgrade, nil
+}
+
+// completeUpgradeItems permit to set the items as completed, to continue the upgrade
+func (c *AmbariClient) completeUpgradeItems(clusterName string, id int, items []UpgradeItem) error {
+
+	for _, item := range items {
+		c.log.Infof("Complete step '%s' of upgrade %d", item.UpgradeItemInfo.Context, id)
+		path := fmt.Sprintf("/clusters/%s/upgrades/%d/upgrade_groups/%d/upgrade_items/%d", clusterName, id, item.UpgradeItemInfo.GroupId, item.UpgradeItemInfo.StageId)
+		jsonData, err := json.Marshal(&UpgradeItem{
+			UpgradeItemInfo: &UpgradeItemInfo{
+				Status: UPGRADE_ITEM_COMPLETED,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		resp, err := c.Client().R().SetBody(jsonData).Put(path)
+		if err != nil {
+			return err
+		}
+		c.log.Debug("Response to update: ", resp)
+		if resp.StatusCode() >= 300 {
+			return NewAmbariErrorFromResponse(resp)
+		}
+	}
+
+	return nil
+}
+
+// updateUpgrade permit to change the options of upgrade
+func (c *AmbariClient) updateUpgrade(clusterName string, id int, upgradeInfo *UpgradeInfo) error {
+
+	path := fmt.Sprintf("/clusters/%s/upgrades/%d", clusterName, id)
+	jsonData, err := json.Marshal(&Upgrade{UpgradeInfo: upgradeInfo})
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
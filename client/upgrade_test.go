@@ -0,0 +1,130 @@
+package client
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+	"time"
+)
+
+// newUpgradeTestServer return fake Ambari with finished upgrade, and upgrade with service check step then finalize step
+// It return the server and the ID of upgrade not finished
+func newUpgradeTestServer(serviceCheck string, finalize string) (*ambaritest.Server, int) {
+
+	server := ambaritest.NewServer()
+	server.AddCluster("test", "HDP-2.6")
+	finishedId := server.AddUpgrade("test", UPGRADE_DIRECTION_UPGRADE)
+	server.AddUpgradeItem("test", finishedId, "FINALIZE", "Finalize upgrade", UPGRADE_ITEM_COMPLETED)
+	id := server.AddUpgrade("test", UPGRADE_DIRECTION_UPGRADE)
+	server.AddUpgradeItem("test", id, "SERVICE_CHECK", "Service Check HDFS", serviceCheck)
+	server.AddUpgradeItem("test", id, "FINALIZE", "Finalize upgrade", finalize)
+
+	return server, int(id)
+}
+
+// newUpgradeTestClient return client that keep the path of calls that change Ambari
+func newUpgradeTestClient(server *ambaritest.Server, updates *[]string) *AmbariClient {
+
+	client := New(server.BaseURL(), "admin", "admin")
+	client.SetPollPolicy(&PollPolicy{MinInterval: time.Millisecond, MaxInterval: time.Millisecond})
+	client.SetAudit("test", func(entry AuditEntry) {
+		*updates = append(*updates, entry.Path)
+	})
+
+	return client
+}
+
+func TestUpgrades(t *testing.T) {
+
+	server, id := newUpgradeTestServer(UPGRADE_ITEM_COMPLETED, UPGRADE_ITEM_HOLDING)
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	upgrades, err := client.Upgrades("test")
+	assert.NoError(t, err)
+	assert.Len(t, upgrades, 2)
+	assert.Equal(t, id, upgrades[1].UpgradeInfo.Id)
+	assert.Equal(t, UPGRADE_DIRECTION_UPGRADE, upgrades[1].UpgradeInfo.Direction)
+	assert.Equal(t, UPGRADE_ITEM_HOLDING, upgrades[1].UpgradeInfo.Status)
+
+	upgrade, err := client.Upgrade("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, upgrade)
+	assert.Len(t, upgrade.UpgradeGroups, 2)
+	assert.Len(t, upgrade.HoldingItems(UPGRADE_ITEM_HOLDING), 1)
+
+	// Upgrade not found
+	upgrade, err = client.Upgrade("test", id+100)
+	assert.NoError(t, err)
+	assert.Nil(t, upgrade)
+
+	assert.Panics(t, func() { client.Upgrades("") })
+}
+
+func TestFinalizeUpgrade(t *testing.T) {
+
+	server, id := newUpgradeTestServer(UPGRADE_ITEM_COMPLETED, UPGRADE_ITEM_HOLDING)
+	defer server.Close()
+	updates := make([]string, 0)
+	client := newUpgradeTestClient(server, &updates)
+
+	upgrade, err := client.FinalizeUpgrade("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, upgrade)
+	assert.Equal(t, []string{fmt.Sprintf("/api/v1/clusters/test/upgrades/%d/upgrade_groups/2/upgrade_items/2", id)}, updates)
+
+	// Upgrade already finished
+	_, err = client.FinalizeUpgrade("test", id)
+	assert.Error(t, err)
+	assert.Equal(t, 409, err.(AmbariError).Code)
+
+	// Upgrade not found
+	_, err = client.FinalizeUpgrade("test", id+100)
+	assert.Error(t, err)
+	assert.Equal(t, 404, err.(AmbariError).Code)
+}
+
+func TestFinalizeUpgradeNotHolding(t *testing.T) {
+
+	server, id := newUpgradeTestServer(UPGRADE_ITEM_HOLDING_FAILED, "PENDING")
+	defer server.Close()
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Nothing to finalize
+	_, err := client.FinalizeUpgrade("test", id)
+	assert.Error(t, err)
+	assert.Equal(t, 409, err.(AmbariError).Code)
+}
+
+func TestSkipAndFinalizeUpgrade(t *testing.T) {
+
+	server, id := newUpgradeTestServer(UPGRADE_ITEM_HOLDING_FAILED, "PENDING")
+	defer server.Close()
+	updates := make([]string, 0)
+	client := newUpgradeTestClient(server, &updates)
+
+	upgrade, err := client.SkipAndFinalizeUpgrade("test", id)
+	assert.NoError(t, err)
+	assert.NotNil(t, upgrade)
+	if upgrade != nil {
+		assert.True(t, upgrade.UpgradeInfo.SkipFailures)
+		assert.Equal(t, REQUEST_COMPLETED, upgrade.UpgradeInfo.Status)
+		assert.Empty(t, upgrade.HoldingItems(UPGRADE_ITEM_HOLDING, UPGRADE_ITEM_HOLDING_FAILED))
+	}
+	assert.Equal(t, []string{
+		fmt.Sprintf("/api/v1/clusters/test/upgrades/%d", id),
+		fmt.Sprintf("/api/v1/clusters/test/upgrades/%d/upgrade_groups/1/upgrade_items/1", id),
+		fmt.Sprintf("/api/v1/clusters/test/upgrades/%d/upgrade_groups/2/upgrade_items/2", id),
+	}, updates)
+
+	// Timeout when the last step is never reached
+	server2, id := newUpgradeTestServer(UPGRADE_ITEM_COMPLETED, "PENDING")
+	defer server2.Close()
+	updates = make([]string, 0)
+	client = newUpgradeTestClient(server2, &updates)
+	client.SetRequestTimeout(20 * time.Millisecond)
+	_, err = client.SkipAndFinalizeUpgrade("test", id)
+	assert.True(t, IsTimeout(err))
+	assert.Equal(t, []string{fmt.Sprintf("/api/v1/clusters/test/upgrades/%d", id)}, updates)
+}
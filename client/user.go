@@ -0,0 +1,282 @@
+// This file permit to manage the users of Ambari
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/users.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	USER_TYPE_LOCAL = "LOCAL"
+	USER_TYPE_LDAP  = "LDAP"
+)
+
+// User object
+type User struct {
+	UserInfo *UserInfo `json:"Users"`
+}
+type UsersResponse struct {
+	Response
+	Items []User `json:"items"`
+}
+type UserInfo struct {
+	UserName      string   `json:"user_name,omitempty"`
+	LocalUserName string   `json:"local_user_name,omitempty"`
+	DisplayName   string   `json:"display_name,omitempty"`
+	Password      string   `json:"password,omitempty"`
+	Active        *bool    `json:"active,omitempty"`
+	Admin         bool     `json:"admin,omitempty"`
+	LdapUser      bool     `json:"ldap_user,omitempty"`
+	UserType      string   `json:"user_type,omitempty"`
+	Groups        []string `json:"groups,omitempty"`
+}
+
+// String return user object as Json string, without the password
+func (u *User) String() string {
+	json, _ := json.Marshal(u)
+	return redact(string(json))
+}
+
+// IsLocal return true if the user is managed by Ambari, false if it come from LDAP or another directory
+func (u *UserInfo) IsLocal() bool {
+	return !u.LdapUser && (u.UserType == "" || u.UserType == USER_TYPE_LOCAL)
+}
+
+// IsActive return true if the user can log in Ambari, the user is active when the flag is not set
+func (u *UserInfo) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// Users permit to get all users of Ambari
+// It return the list of users
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Users(opts ...RequestOption) ([]User, error) {
+
+	resp, err := c.get("/users", opts, Fields("Users/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+	usersResponse := &UsersResponse{}
+	err = json.Unmarshal(resp.Body(), usersResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return users: %v", usersResponse.Items)
+
+	return usersResponse.Items, nil
+}
+
+// User permit to get user of Ambari
+// It return the user if found
+// It return nil if user not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) User(userName string, opts ...RequestOption) (*User, error) {
+
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("User", "user_name", userName)
+
+	path := fmt.Sprintf("/users/%s", userName)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	user := &User{}
+	err = json.Unmarshal(resp.Body(), user)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return user: %s", user)
+
+	return user, nil
+}
+
+// CreateUser permit to create local user in Ambari, with its password
+// The user is active if Active is not set. The display name and the local user name are only sent to Ambari 2.7 and later.
+// It return the user if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateUser(user *User) (*User, error) {
+
+	if user == nil || user.UserInfo == nil {
+		panic("User can't be nil")
+	}
+	if user.UserInfo.UserName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("CreateUser", "user", user)
+
+	active := user.UserInfo.IsActive()
+	userInfo := &UserInfo{
+		UserName:      user.UserInfo.UserName,
+		LocalUserName: user.UserInfo.LocalUserName,
+		DisplayName:   user.UserInfo.DisplayName,
+		Password:      user.UserInfo.Password,
+		Active:        &active,
+		Admin:         user.UserInfo.Admin,
+	}
+	// Before Ambari 2.7, the user name is given by the path
+	path := "/users"
+	if c.notSupports(FEATURE_USER_API_V2) {
+		path = fmt.Sprintf("/users/%s", user.UserInfo.UserName)
+	}
+	jsonData, err := c.userPayload(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	return c.User(user.UserInfo.UserName)
+}
+
+// ActivateUser permit to activate or deactivate user, a deactivated user can't log in Ambari
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ActivateUser(userName string, active bool) error {
+
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("ActivateUser", "user_name", userName, "active", active)
+
+	path := fmt.Sprintf("/users/%s", userName)
+	jsonData, err := c.userPayload(&UserInfo{UserName: userName, Active: &active})
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// ApplyUser permit to reconcile the user of Ambari with the user given
+// It create the local user with its password if it not exist, or it activate or deactivate the user. The password of existing user is not changed.
+// The LDAP users must be synchronized before, they are not created.
+// It return the change report, that is empty if the user is already like the user given
+// It return error if the user must be created without password, or if something wrong when it call the API
+func (c *AmbariClient) ApplyUser(user *User) (*ChangeReport, error) {
+
+	if user == nil || user.UserInfo == nil {
+		panic("User can't be nil")
+	}
+	if user.UserInfo.UserName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("ApplyUser", "user", user)
+
+	currentUser, err := c.User(user.UserInfo.UserName)
+	if err != nil {
+		return nil, err
+	}
+	active := user.UserInfo.IsActive()
+	desiredUser := &User{UserInfo: &UserInfo{UserName: user.UserInfo.UserName, Active: &active}}
+	report := newChangeReport()
+	if currentUser == nil {
+		if !user.UserInfo.IsLocal() {
+			return nil, NewAmbariError(404, "LDAP user %s not found, it must be synchronized before", user.UserInfo.UserName)
+		}
+		if user.UserInfo.Password == "" {
+			return nil, NewAmbariError(400, "Password is needed to create user %s", user.UserInfo.UserName)
+		}
+		report.add(CHANGE_CREATE, "user", user.UserInfo.UserName, nil, desiredUser)
+	} else if currentUser.UserInfo.IsActive() != active {
+		currentActive := currentUser.UserInfo.IsActive()
+		report.add(CHANGE_UPDATE, "user", user.UserInfo.UserName, &User{UserInfo: &UserInfo{UserName: user.UserInfo.UserName, Active: &currentActive}}, desiredUser)
+	}
+	c.log.Debugf("Changes: %s", report)
+	if c.isDryRun() {
+		return report, nil
+	}
+
+	for _, change := range report.Changes {
+		switch change.Action {
+		case CHANGE_CREATE:
+			_, err = c.CreateUser(user)
+		case CHANGE_UPDATE:
+			err = c.ActivateUser(user.UserInfo.UserName, active)
+		}
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// DeleteUser permit to delete user of Ambari
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteUser(userName string) error {
+
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("DeleteUser", "user_name", userName)
+
+	path := fmt.Sprintf("/users/%s", userName)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete user: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+}
+
+// UserPrivileges permit to get the privileges given to the user, on Ambari, on clusters and on view instances
+// It return the list of privileges, with their scope
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UserPrivileges(userName string, opts ...RequestOption) ([]Privilege, error) {
+
+	if userName == "" {
+		panic("UserName can't be empty")
+	}
+	c.log.Debugw("UserPrivileges", "user_name", userName)
+
+	return c.scopedPrivileges(fmt.Sprintf("/users/%s/privileges", userName), opts)
+}
+
+// userPayload return the user as Json
+// Ambari before 2.7 reject the user name, the local user name and the display name in the body, so they are removed.
+func (c *AmbariClient) userPayload(userInfo *UserInfo) ([]byte, error) {
+
+	if c.notSupports(FEATURE_USER_API_V2) {
+		c.log.Debug("Remove user_name, local_user_name and display_name not supported by Ambari")
+		oldUserInfo := *userInfo
+		oldUserInfo.UserName = ""
+		oldUserInfo.LocalUserName = ""
+		oldUserInfo.DisplayName = ""
+		userInfo = &oldUserInfo
+	}
+
+	return json.Marshal(&User{UserInfo: userInfo})
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"go-ambari-rest/client/ambaritest"
+	"testing"
+)
+
+func TestCreateUser(t *testing.T) {
+
+	for _, version := range []string{"2.7.5.0", "2.6.2.0"} {
+		server := ambaritest.NewServer()
+		server.SetServerVersion(version)
+		client := New(server.BaseURL(), "admin", "admin")
+
+		// The user is active by default
+		user, err := client.CreateUser(&User{UserInfo: &UserInfo{UserName: "alice", DisplayName: "Alice", Password: "changeme"}})
+		assert.NoError(t, err, version)
+		assert.NotNil(t, user, version)
+		if user != nil {
+			assert.True(t, user.UserInfo.IsActive(), version)
+			assert.True(t, user.UserInfo.IsLocal(), version)
+		}
+
+		inactive := false
+		user, err = client.CreateUser(&User{UserInfo: &UserInfo{UserName: "bob", Password: "changeme", Active: &inactive}})
+		assert.NoError(t, err, version)
+		assert.NotNil(t, user, version)
+		if user != nil {
+			assert.False(t, user.UserInfo.IsActive(), version)
+		}
+
+		assert.NoError(t, client.ActivateUser("bob", true), version)
+		user, err = client.User("bob")
+		assert.NoError(t, err, version)
+		assert.True(t, user.UserInfo.IsActive(), version)
+
+		_, err = client.CreateUser(&User{UserInfo: &UserInfo{UserName: "bob", Password: "changeme"}})
+		assert.True(t, IsConflict(err), version)
+
+		server.Close()
+	}
+}
+
+func TestApplyUser(t *testing.T) {
+
+	server := ambaritest.NewServer()
+	defer server.Close()
+	server.AddUser("bob", USER_TYPE_LOCAL, true)
+	client := New(server.BaseURL(), "admin", "admin")
+
+	// Password is needed to create user, and LDAP users are not created
+	_, err := client.ApplyUser(&User{UserInfo: &UserInfo{UserName: "alice"}})
+	assert.Error(t, err)
+	_, err = client.ApplyUser(&User{UserInfo: &UserInfo{UserName: "carol", UserType: USER_TYPE_LDAP, Password: "changeme"}})
+	assert.True(t, IsNotFound(err))
+
+	report, err := client.ApplyUser(&User{UserInfo: &UserInfo{UserName: "alice", Password: "changeme"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_CREATE, report.Changes[0].Action)
+	user, err := client.User("alice")
+	assert.NoError(t, err)
+	assert.NotNil(t, user)
+
+	inactive := false
+	report, err = client.ApplyUser(&User{UserInfo: &UserInfo{UserName: "bob", Active: &inactive}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Changes))
+	assert.Equal(t, CHANGE_UPDATE, report.Changes[0].Action)
+	user, err = client.User("bob")
+	assert.NoError(t, err)
+	assert.False(t, user.UserInfo.IsActive())
+
+	report, err = client.ApplyUser(&User{UserInfo: &UserInfo{UserName: "bob", Active: &inactive}})
+	assert.NoError(t, err)
+	assert.False(t, report.HasChanges())
+}
@@ -0,0 +1,204 @@
+// This file permit to check the bodies before to send them to Ambari, to get clear error instead of the Ambari error like "org.apache.ambari.server.controller.spi.SystemException"
+// The validation is disabled by default, use SetValidation to enable it.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	PRINCIPAL_TYPE_USER  = "USER"
+	PRINCIPAL_TYPE_GROUP = "GROUP"
+	PRINCIPAL_TYPE_ROLE  = "ROLE"
+
+	PERMISSION_AMBARI_ADMINISTRATOR  = "AMBARI.ADMINISTRATOR"
+	PERMISSION_CLUSTER_ADMINISTRATOR = "CLUSTER.ADMINISTRATOR"
+	PERMISSION_CLUSTER_OPERATOR      = "CLUSTER.OPERATOR"
+	PERMISSION_SERVICE_ADMINISTRATOR = "SERVICE.ADMINISTRATOR"
+	PERMISSION_SERVICE_OPERATOR      = "SERVICE.OPERATOR"
+	PERMISSION_CLUSTER_USER          = "CLUSTER.USER"
+	PERMISSION_VIEW_USER             = "VIEW.USER"
+
+	HOST_COMPONENT_DISABLED = "DISABLED"
+)
+
+var principalTypes = []string{PRINCIPAL_TYPE_USER, PRINCIPAL_TYPE_GROUP, PRINCIPAL_TYPE_ROLE}
+var permissionNames = []string{PERMISSION_AMBARI_ADMINISTRATOR, PERMISSION_CLUSTER_ADMINISTRATOR, PERMISSION_CLUSTER_OPERATOR, PERMISSION_SERVICE_ADMINISTRATOR, PERMISSION_SERVICE_OPERATOR, PERMISSION_CLUSTER_USER, PERMISSION_VIEW_USER}
+
+// The states that can be asked to Ambari
+var serviceStates = []string{SERVICE_INIT, SERVICE_INSTALLED, SERVICE_STARTED}
+var hostComponentStates = []string{SERVICE_INIT, SERVICE_INSTALLED, SERVICE_STARTED, HOST_COMPONENT_DISABLED}
+var maintenanceStates = []string{MAINTENANCE_STATE_ON, MAINTENANCE_STATE_OFF}
+
+// SetValidation permit to check the privileges, the services, the host components and the blueprints before to send them to Ambari.
+// When the body is not valid, the methods return error with code 400 that explain why, without to call Ambari.
+func (c *AmbariClient) SetValidation(enabled bool) {
+	c.log.Debugw("SetValidation", "validation", enabled)
+	c.validation = enabled
+}
+
+// newValidationError return the error when the body is not valid
+func newValidationError(resource string, message string, params ...interface{}) AmbariError {
+	return NewAmbariError(400, "Invalid %s: %s", resource, fmt.Sprintf(message, params...))
+}
+
+// checkEnum return error if the value is not one of the values
+func checkEnum(resource string, field string, value string, values []string) error {
+	for _, v := range values {
+		if v == value {
+			return nil
+		}
+	}
+	return newValidationError(resource, "%s must be %s, got '%s'", field, strings.Join(values, ", "), value)
+}
+
+// ValidatePrivilege permit to check the privilege before to create it
+// The principal name is required, the principal type must be USER, GROUP or ROLE and the permission must be one of the Ambari permissions, like CLUSTER.OPERATOR
+// It return error with code 400 if the privilege is not valid
+func ValidatePrivilege(privilege *Privilege) error {
+
+	if privilege == nil || privilege.PrivilegeInfo == nil {
+		return newValidationError("privilege", "PrivilegeInfo is required")
+	}
+	if privilege.PrivilegeInfo.PrincipalName == "" {
+		return newValidationError("privilege", "principal_name is required")
+	}
+	if err := checkEnum("privilege", "principal_type", privilege.PrivilegeInfo.PrincipalType, principalTypes); err != nil {
+		return err
+	}
+
+	return checkEnum("privilege", "permission_name", privilege.PrivilegeInfo.PermissionName, permissionNames)
+}
+
+// ValidateService permit to check the service before to update it
+// The cluster name and the service name are required, the state can be empty or INIT, INSTALLED or STARTED, and the maintenance state can be empty, ON or OFF
+// It return error with code 400 if the service is not valid
+func ValidateService(service *Service) error {
+
+	if service == nil || service.ServiceInfo == nil {
+		return newValidationError("service", "ServiceInfo is required")
+	}
+	if service.ServiceInfo.ClusterName == "" {
+		return newValidationError("service", "cluster_name is required")
+	}
+	if service.ServiceInfo.ServiceName == "" {
+		return newValidationError("service", "service_name is required")
+	}
+	if service.ServiceInfo.State != "" {
+		if err := checkEnum("service "+service.ServiceInfo.ServiceName, "state", service.ServiceInfo.State, serviceStates); err != nil {
+			return err
+		}
+	}
+	if service.ServiceInfo.MaintenanceState != "" {
+		if err := checkEnum("service "+service.ServiceInfo.ServiceName, "maintenance_state", service.ServiceInfo.MaintenanceState, maintenanceStates); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateHostComponent permit to check the host component before to update it
+// The cluster name, the hostname and the component name are required, and the state can be empty or INIT, INSTALLED, STARTED or DISABLED
+// It return error with code 400 if the host component is not valid
+func ValidateHostComponent(hostComponent *HostComponent) error {
+
+	if hostComponent == nil || hostComponent.HostComponentInfo == nil {
+		return newValidationError("host component", "HostRoles is required")
+	}
+	if hostComponent.HostComponentInfo.ClusterName == "" {
+		return newValidationError("host component", "cluster_name is required")
+	}
+	if hostComponent.HostComponentInfo.Hostname == "" {
+		return newValidationError("host component", "host_name is required")
+	}
+	if hostComponent.HostComponentInfo.ComponentName == "" {
+		return newValidationError("host component", "component_name is required")
+	}
+	if hostComponent.HostComponentInfo.State != "" {
+		resource := fmt.Sprintf("host component %s on %s", hostComponent.HostComponentInfo.ComponentName, hostComponent.HostComponentInfo.Hostname)
+		return checkEnum(resource, "state", hostComponent.HostComponentInfo.State, hostComponentStates)
+	}
+
+	return nil
+}
+
+// ValidateBlueprint permit to check the structure of blueprint before to register it
+// The stack name and the stack version are required, and it need at least one host group.
+// Each host group need an unique name and at least one component with name.
+// It return error with code 400 if the blueprint is not valid
+func ValidateBlueprint(blueprint *Blueprint) error {
+
+	if blueprint == nil {
+		return newValidationError("blueprint", "blueprint is required")
+	}
+	if blueprint.BlueprintInfo.Stack == "" {
+		return newValidationError("blueprint", "Blueprints/stack_name is required")
+	}
+	if blueprint.BlueprintInfo.Version == "" {
+		return newValidationError("blueprint", "Blueprints/stack_version is required")
+	}
+	if len(blueprint.HostGroups) == 0 {
+		return newValidationError("blueprint", "host_groups need at least one host group")
+	}
+
+	hostGroupNames := make(map[string]bool, len(blueprint.HostGroups))
+	for i, hostGroup := range blueprint.HostGroups {
+		if hostGroup.Name == "" {
+			return newValidationError("blueprint", "host_groups[%d] need name", i)
+		}
+		if hostGroupNames[hostGroup.Name] {
+			return newValidationError("blueprint", "host group %s is defined more than once", hostGroup.Name)
+		}
+		hostGroupNames[hostGroup.Name] = true
+		if len(hostGroup.Components) == 0 {
+			return newValidationError("blueprint", "host group %s need at least one component", hostGroup.Name)
+		}
+		for j, component := range hostGroup.Components {
+			if component["name"] == "" {
+				return newValidationError("blueprint", "host group %s components[%d] need name", hostGroup.Name, j)
+			}
+		}
+	}
+
+	return nil
+}
+
+// blueprintJson is the part of blueprint that is validated
+// The configurations are kept as raw Json, because the properties_attributes can't be read by Blueprint.
+type blueprintJson struct {
+	Configurations json.RawMessage `json:"configurations"`
+	HostGroups     []struct {
+		Components     []map[string]interface{} `json:"components"`
+		Configurations json.RawMessage          `json:"configurations"`
+		Name           string                   `json:"name"`
+	} `json:"host_groups"`
+	BlueprintInfo BlueprintInfo `json:"Blueprints"`
+}
+
+// validateBlueprintJson permit to check the blueprint given as Json
+func validateBlueprintJson(jsonBlueprint string) error {
+
+	data := &blueprintJson{}
+	if err := json.Unmarshal([]byte(jsonBlueprint), data); err != nil {
+		return newValidationError("blueprint", "%s", err.Error())
+	}
+
+	blueprint := &Blueprint{
+		BlueprintInfo: data.BlueprintInfo,
+		HostGroups:    make([]HostGroup, 0, len(data.HostGroups)),
+	}
+	for _, hostGroup := range data.HostGroups {
+		components := make([]map[string]string, 0, len(hostGroup.Components))
+		for _, component := range hostGroup.Components {
+			name, _ := component["name"].(string)
+			components = append(components, map[string]string{"name": name})
+		}
+		blueprint.HostGroups = append(blueprint.HostGroups, HostGroup{Name: hostGroup.Name, Components: components})
+	}
+
+	return ValidateBlueprint(blueprint)
+}
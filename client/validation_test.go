@@ -0,0 +1,103 @@
+package client
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidatePrivilege(t *testing.T) {
+
+	assert.NoError(t, ValidatePrivilege(&Privilege{PrivilegeInfo: &PrivilegeInfo{PrincipalName: "ops", PrincipalType: PRINCIPAL_TYPE_GROUP, PermissionName: PERMISSION_CLUSTER_OPERATOR}}))
+
+	err := ValidatePrivilege(&Privilege{PrivilegeInfo: &PrivilegeInfo{PrincipalName: "ops", PrincipalType: "user", PermissionName: PERMISSION_CLUSTER_OPERATOR}})
+	assert.Error(t, err)
+	assert.Equal(t, "Invalid privilege: principal_type must be USER, GROUP, ROLE, got 'user'", err.Error())
+	assert.True(t, errors.Is(err, ErrBadRequest))
+
+	err = ValidatePrivilege(&Privilege{PrivilegeInfo: &PrivilegeInfo{PrincipalName: "ops", PrincipalType: PRINCIPAL_TYPE_USER, PermissionName: "CLUSTER.OPERATORS"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "permission_name")
+
+	assert.Error(t, ValidatePrivilege(&Privilege{PrivilegeInfo: &PrivilegeInfo{PrincipalType: PRINCIPAL_TYPE_USER, PermissionName: PERMISSION_CLUSTER_USER}}))
+	assert.Error(t, ValidatePrivilege(&Privilege{}))
+}
+
+func TestValidateServiceAndHostComponent(t *testing.T) {
+
+	assert.NoError(t, ValidateService(&Service{ServiceInfo: &ServiceInfo{ClusterName: "test", ServiceName: "HDFS", State: SERVICE_STARTED, MaintenanceState: MAINTENANCE_STATE_OFF}}))
+	assert.NoError(t, ValidateService(&Service{ServiceInfo: &ServiceInfo{ClusterName: "test", ServiceName: "HDFS"}}))
+	err := ValidateService(&Service{ServiceInfo: &ServiceInfo{ClusterName: "test", ServiceName: "HDFS", State: "STOPPED"}})
+	assert.Error(t, err)
+	assert.Equal(t, "Invalid service HDFS: state must be INIT, INSTALLED, STARTED, got 'STOPPED'", err.Error())
+	assert.Error(t, ValidateService(&Service{ServiceInfo: &ServiceInfo{ClusterName: "test", ServiceName: "HDFS", MaintenanceState: "true"}}))
+	assert.Error(t, ValidateService(&Service{ServiceInfo: &ServiceInfo{ServiceName: "HDFS"}}))
+
+	assert.NoError(t, ValidateHostComponent(&HostComponent{HostComponentInfo: &HostComponentInfo{ClusterName: "test", Hostname: "worker01", ComponentName: "DATANODE", State: HOST_COMPONENT_DISABLED}}))
+	err = ValidateHostComponent(&HostComponent{HostComponentInfo: &HostComponentInfo{ClusterName: "test", Hostname: "worker01", ComponentName: "DATANODE", State: "RUNNING"}})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "host component DATANODE on worker01")
+	assert.Error(t, ValidateHostComponent(&HostComponent{HostComponentInfo: &HostComponentInfo{ClusterName: "test", ComponentName: "DATANODE"}}))
+}
+
+func TestValidateBlueprint(t *testing.T) {
+
+	assert.NoError(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": [{"name": "master", "cardinality": "1", "components": [{"name": "NAMENODE"}]}]}`))
+
+	// Configurations with properties attributes, global and by host group
+	assert.NoError(t, validateBlueprintJson(`{
+		"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"},
+		"configurations": [{"hdfs-site": {"properties": {"dfs.replication": "3"}, "properties_attributes": {"final": {"dfs.replication": "true"}}}}],
+		"host_groups": [{
+			"name": "master",
+			"configurations": [{"core-site": {"properties": {"fs.trash.interval": "360"}, "properties_attributes": {"final": {"fs.trash.interval": "true"}}}}],
+			"components": [{"name": "NAMENODE"}, {"name": "ZKFC", "provision_action": "INSTALL_ONLY"}]
+		}]
+	}`))
+
+	err := validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP"}, "host_groups": [{"name": "master", "components": [{"name": "NAMENODE"}]}]}`)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "stack_version")
+
+	assert.Error(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": []}`))
+	assert.Error(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": [{"name": "master", "components": [{"name": "NAMENODE"}]}, {"name": "master", "components": [{"name": "DATANODE"}]}]}`))
+	assert.Error(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": [{"name": "master", "components": []}]}`))
+	assert.Error(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": [{"name": "master", "components": [{"provision_action": "INSTALL_ONLY"}]}]}`))
+	assert.Error(t, validateBlueprintJson(`{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": {"name": "master"}}`))
+}
+
+func TestSetValidation(t *testing.T) {
+
+	nbCalls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nbCalls++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status": 400, "message": "org.apache.ambari.server.controller.spi.SystemException: An internal system exception occurred"}`))
+	}))
+	defer server.Close()
+	client := New(server.URL, "admin", "admin")
+	privilege := &Privilege{PrivilegeInfo: &PrivilegeInfo{PrincipalName: "ops", PrincipalType: "TEAM", PermissionName: PERMISSION_CLUSTER_OPERATOR}}
+
+	// Disabled by default, Ambari return its error
+	_, err := client.CreatePrivilege("test", privilege)
+	assert.Error(t, err)
+	assert.Equal(t, 1, nbCalls)
+	assert.Contains(t, err.Error(), "SystemException")
+
+	// Enabled, Ambari is not called
+	client.SetValidation(true)
+	_, err = client.CreatePrivilege("test", privilege)
+	assert.Error(t, err)
+	assert.Equal(t, 1, nbCalls)
+	assert.Contains(t, err.Error(), "principal_type")
+
+	_, err = client.CreateBlueprint("test", `{"Blueprints": {"stack_name": "HDP", "stack_version": "2.6"}, "host_groups": []}`)
+	assert.Error(t, err)
+	_, err = client.UpdateService(&Service{ServiceInfo: &ServiceInfo{ClusterName: "test", ServiceName: "HDFS", State: "STOPPED"}})
+	assert.Error(t, err)
+	_, err = client.CreateViewPrivilege("FILES", "1.0.0", "files", privilege)
+	assert.Error(t, err)
+	assert.Equal(t, 1, nbCalls)
+}
@@ -0,0 +1,272 @@
+// This file permit to manage view instance in Ambari API
+// Ambari documentation: https://github.com/apache/ambari/blob/trunk/ambari-server/docs/api/v1/view-instance-resources.md
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ViewInstance object
+type ViewInstance struct {
+	ViewInstanceInfo *ViewInstanceInfo `json:"ViewInstanceInfo"`
+}
+type ViewInstancesResponse struct {
+	Response
+	Items []ViewInstance `json:"items"`
+}
+type ViewInstanceInfo struct {
+	ViewName      string            `json:"view_name,omitempty"`
+	Version       string            `json:"version,omitempty"`
+	InstanceName  string            `json:"instance_name,omitempty"`
+	Label         string            `json:"label,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	Visible       *bool             `json:"visible,omitempty"`
+	IconPath      string            `json:"icon_path,omitempty"`
+	Icon64Path    string            `json:"icon64_path,omitempty"`
+	ClusterHandle int64             `json:"cluster_handle,omitempty"`
+	ClusterType   string            `json:"cluster_type,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+	InstanceData  map[string]string `json:"instance_data,omitempty"`
+	Static        bool              `json:"static,omitempty"`
+}
+
+const (
+	VIEW_CLUSTER_LOCAL  = "LOCAL_AMBARI"
+	VIEW_CLUSTER_REMOTE = "REMOTE_AMBARI"
+	VIEW_CLUSTER_NONE   = "NONE"
+)
+
+// String return view instance object as Json string
+func (v *ViewInstance) String() string {
+	json, _ := json.Marshal(v)
+	return string(json)
+}
+
+// IsVisible return true if the view instance is shown to the users, Ambari show it by default
+func (i *ViewInstanceInfo) IsVisible() bool {
+	return i.Visible == nil || *i.Visible
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update view instance
+func (v *ViewInstance) CleanBeforeSave() *ViewInstance {
+
+	return &ViewInstance{
+		ViewInstanceInfo: &ViewInstanceInfo{
+			Label:         v.ViewInstanceInfo.Label,
+			Description:   v.ViewInstanceInfo.Description,
+			Visible:       v.ViewInstanceInfo.Visible,
+			IconPath:      v.ViewInstanceInfo.IconPath,
+			Icon64Path:    v.ViewInstanceInfo.Icon64Path,
+			ClusterHandle: v.ViewInstanceInfo.ClusterHandle,
+			ClusterType:   v.ViewInstanceInfo.ClusterType,
+			Properties:    v.ViewInstanceInfo.Properties,
+			InstanceData:  v.ViewInstanceInfo.InstanceData,
+		},
+	}
+}
+
+// ViewInstance return existing view instance
+// It return the view instance if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ViewInstance(viewName string, version string, instanceName string, opts ...RequestOption) (*ViewInstance, error) {
+
+	if viewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if version == "" {
+		panic("Version can't be empty")
+	}
+	if instanceName == "" {
+		panic("InstanceName can't be empty")
+	}
+	c.log.Debugw("ViewInstance", "view_name", viewName, "version", version, "instance_name", instanceName)
+
+	path := fmt.Sprintf("/views/%s/versions/%s/instances/%s", viewName, version, instanceName)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	viewInstance := &ViewInstance{}
+	err = json.Unmarshal(resp.Body(), viewInstance)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return view instance: %s", viewInstance)
+
+	return viewInstance, nil
+}
+
+// ViewInstances return all instances of view version
+// It return the list of view instances.
+// If not view instance, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) ViewInstances(viewName string, version string, opts ...RequestOption) ([]ViewInstance, error) {
+
+	if viewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if version == "" {
+		panic("Version can't be empty")
+	}
+	c.log.Debugw("ViewInstances", "view_name", viewName, "version", version)
+
+	path := fmt.Sprintf("/views/%s/versions/%s/instances", viewName, version)
+	resp, err := c.get(path, opts, Fields("ViewInstanceInfo/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	viewInstancesResponse := &ViewInstancesResponse{}
+	err = json.Unmarshal(resp.Body(), viewInstancesResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("View instances: ", viewInstancesResponse.Items)
+
+	return viewInstancesResponse.Items, nil
+}
+
+// CreateViewInstance permit to create new view instance
+// It return the view instance if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateViewInstance(viewInstance *ViewInstance) (*ViewInstance, error) {
+
+	if viewInstance == nil {
+		panic("ViewInstance can't be nil")
+	}
+	if viewInstance.ViewInstanceInfo.ViewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if viewInstance.ViewInstanceInfo.Version == "" {
+		panic("Version can't be empty")
+	}
+	if viewInstance.ViewInstanceInfo.InstanceName == "" {
+		panic("InstanceName can't be empty")
+	}
+	c.log.Debugw("CreateViewInstance", "view_instance", viewInstance)
+
+	// Create the view instance
+	path := fmt.Sprintf("/views/%s/versions/%s/instances/%s", viewInstance.ViewInstanceInfo.ViewName, viewInstance.ViewInstanceInfo.Version, viewInstance.ViewInstanceInfo.InstanceName)
+	viewInstancePayload := viewInstance.CleanBeforeSave()
+	c.log.Debug("ViewInstance payload: ", viewInstancePayload)
+	jsonData, err := json.Marshal(viewInstancePayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the view instance
+	viewInstance, err = c.ViewInstance(viewInstance.ViewInstanceInfo.ViewName, viewInstance.ViewInstanceInfo.Version, viewInstance.ViewInstanceInfo.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+	if viewInstance == nil {
+		return nil, NewAmbariError(500, "Can't get view instance that just created")
+	}
+
+	return viewInstance, err
+
+}
+
+// UpdateViewInstance permit to update existing view instance, like is properties or settings
+// It return the view instance if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateViewInstance(viewInstance *ViewInstance) (*ViewInstance, error) {
+
+	if viewInstance == nil {
+		panic("ViewInstance can't be nil")
+	}
+	if viewInstance.ViewInstanceInfo.ViewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if viewInstance.ViewInstanceInfo.Version == "" {
+		panic("Version can't be empty")
+	}
+	if viewInstance.ViewInstanceInfo.InstanceName == "" {
+		panic("InstanceName can't be empty")
+	}
+	c.log.Debugw("UpdateViewInstance", "view_instance", viewInstance)
+
+	// Update the view instance
+	path := fmt.Sprintf("/views/%s/versions/%s/instances/%s", viewInstance.ViewInstanceInfo.ViewName, viewInstance.ViewInstanceInfo.Version, viewInstance.ViewInstanceInfo.InstanceName)
+	viewInstancePayload := viewInstance.CleanBeforeSave()
+	c.log.Debug("ViewInstance payload: ", viewInstancePayload)
+	jsonData, err := json.Marshal(viewInstancePayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the view instance after update
+	viewInstance, err = c.ViewInstance(viewInstance.ViewInstanceInfo.ViewName, viewInstance.ViewInstanceInfo.Version, viewInstance.ViewInstanceInfo.InstanceName)
+	if err != nil {
+		return nil, err
+	}
+	if viewInstance == nil {
+		return nil, NewAmbariError(500, "Can't get view instance that just updated")
+	}
+
+	return viewInstance, err
+
+}
+
+// DeleteViewInstance permit to delete existing view instance
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteViewInstance(viewName string, version string, instanceName string) error {
+
+	if viewName == "" {
+		panic("ViewName can't be empty")
+	}
+	if version == "" {
+		panic("Version can't be empty")
+	}
+	if instanceName == "" {
+		panic("InstanceName can't be empty")
+	}
+	c.log.Debugw("DeleteViewInstance", "view_name", viewName, "version", version, "instance_name", instanceName)
+
+	path := fmt.Sprintf("/views/%s/versions/%s/instances/%s", viewName, version, instanceName)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete view instance: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
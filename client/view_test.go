@@ -0,0 +1,78 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestViewInstanceHidden(t *testing.T) {
+
+	visible := false
+	viewInstance := &ViewInstance{ViewInstanceInfo: &ViewInstanceInfo{Label: "Files", Visible: &visible}}
+	assert.Contains(t, viewInstance.CleanBeforeSave().String(), `"visible":false`)
+	assert.False(t, viewInstance.ViewInstanceInfo.IsVisible())
+
+	// Ambari keep the visibility when it's not set
+	viewInstance = &ViewInstance{ViewInstanceInfo: &ViewInstanceInfo{Label: "Files"}}
+	assert.NotContains(t, viewInstance.CleanBeforeSave().String(), `"visible"`)
+	assert.True(t, viewInstance.ViewInstanceInfo.IsVisible())
+}
+
+func (s *ClientTestSuite) TestViewInstance() {
+
+	// Create view instance
+	viewInstance := &ViewInstance{
+		ViewInstanceInfo: &ViewInstanceInfo{
+			ViewName:     "FILES",
+			Version:      "1.0.0",
+			InstanceName: "test",
+			Label:        "Files test",
+			Description:  "Files view for test",
+			Properties: map[string]string{
+				"webhdfs.url": "webhdfs://ambari-agent2:50070",
+			},
+		},
+	}
+	viewInstance, err := s.client.CreateViewInstance(viewInstance)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), viewInstance)
+	if viewInstance != nil {
+		assert.Equal(s.T(), "FILES", viewInstance.ViewInstanceInfo.ViewName)
+		assert.Equal(s.T(), "1.0.0", viewInstance.ViewInstanceInfo.Version)
+		assert.Equal(s.T(), "test", viewInstance.ViewInstanceInfo.InstanceName)
+		assert.Equal(s.T(), "Files test", viewInstance.ViewInstanceInfo.Label)
+		assert.Equal(s.T(), "webhdfs://ambari-agent2:50070", viewInstance.ViewInstanceInfo.Properties["webhdfs.url"])
+	}
+
+	// Get view instance
+	viewInstance, err = s.client.ViewInstance("FILES", "1.0.0", "test")
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), viewInstance)
+	if viewInstance != nil {
+		assert.Equal(s.T(), "Files test", viewInstance.ViewInstanceInfo.Label)
+		assert.Equal(s.T(), "Files view for test", viewInstance.ViewInstanceInfo.Description)
+	}
+
+	// Update view instance
+	viewInstance.ViewInstanceInfo.Label = "Files test 2"
+	viewInstance.ViewInstanceInfo.Properties["webhdfs.url"] = "webhdfs://ambari-agent3:50070"
+	viewInstance, err = s.client.UpdateViewInstance(viewInstance)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), viewInstance)
+	if viewInstance != nil {
+		assert.Equal(s.T(), "Files test 2", viewInstance.ViewInstanceInfo.Label)
+		assert.Equal(s.T(), "webhdfs://ambari-agent3:50070", viewInstance.ViewInstanceInfo.Properties["webhdfs.url"])
+	}
+
+	// Get all view instances
+	viewInstances, err := s.client.ViewInstances("FILES", "1.0.0")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), viewInstances)
+
+	// Delete view instance
+	err = s.client.DeleteViewInstance("FILES", "1.0.0", "test")
+	assert.NoError(s.T(), err)
+	viewInstance, err = s.client.ViewInstance("FILES", "1.0.0", "test")
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), viewInstance)
+}
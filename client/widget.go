@@ -0,0 +1,593 @@
+// This file permit to manage widget and widget layout in Ambari API
+// Ambari documentation: https://cwiki.apache.org/confluence/display/AMBARI/Enhanced+Service+Dashboard
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	WIDGET_TYPE_GRAPH    = "GRAPH"
+	WIDGET_TYPE_GAUGE    = "GAUGE"
+	WIDGET_TYPE_NUMBER   = "NUMBER"
+	WIDGET_TYPE_TEMPLATE = "TEMPLATE"
+	WIDGET_TYPE_HEATMAP  = "HEATMAP"
+	WIDGET_SCOPE_USER    = "USER"
+	WIDGET_SCOPE_CLUSTER = "CLUSTER"
+)
+
+// Widget object
+type Widget struct {
+	WidgetInfo *WidgetInfo `json:"WidgetInfo"`
+}
+type WidgetsResponse struct {
+	Response
+	Items []Widget `json:"items"`
+}
+type WidgetInfo struct {
+	Id                 int64             `json:"id,omitempty"`
+	ClusterName        string            `json:"cluster_name,omitempty"`
+	WidgetName         string            `json:"widget_name,omitempty"`
+	WidgetType         string            `json:"widget_type,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	Author             string            `json:"author,omitempty"`
+	Scope              string            `json:"scope,omitempty"`
+	DefaultSectionName string            `json:"default_section_name,omitempty"`
+	Tag                string            `json:"tag,omitempty"`
+	TimeCreated        int64             `json:"time_created,omitempty"`
+	Metrics            []WidgetMetric    `json:"metrics,omitempty"`
+	Values             []WidgetValue     `json:"values,omitempty"`
+	Properties         map[string]string `json:"properties,omitempty"`
+}
+type WidgetMetric struct {
+	Name                  string `json:"name"`
+	MetricPath            string `json:"metric_path"`
+	ServiceName           string `json:"service_name"`
+	ComponentName         string `json:"component_name"`
+	HostComponentCriteria string `json:"host_component_criteria,omitempty"`
+}
+type WidgetValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// WidgetLayout object
+type WidgetLayout struct {
+	WidgetLayoutInfo *WidgetLayoutInfo `json:"WidgetLayoutInfo"`
+}
+type WidgetLayoutsResponse struct {
+	Response
+	Items []WidgetLayout `json:"items"`
+}
+type WidgetLayoutInfo struct {
+	Id          int64              `json:"id,omitempty"`
+	ClusterName string             `json:"cluster_name,omitempty"`
+	LayoutName  string             `json:"layout_name,omitempty"`
+	SectionName string             `json:"section_name,omitempty"`
+	DisplayName string             `json:"display_name,omitempty"`
+	Scope       string             `json:"scope,omitempty"`
+	UserName    string             `json:"user_name,omitempty"`
+	Widgets     []WidgetLayoutItem `json:"widgets,omitempty"`
+}
+
+// WidgetLayoutItem is the widget reference inside layout.
+// Ambari return the full widget when read the layout, but it only expect the widget id when save it.
+type WidgetLayoutItem struct {
+	Id         int64       `json:"id,omitempty"`
+	WidgetInfo *WidgetInfo `json:"WidgetInfo,omitempty"`
+}
+
+// Response return by Ambari when create widget or widget layout
+type widgetCreateResponse struct {
+	Resources []struct {
+		WidgetInfo       *WidgetInfo       `json:"WidgetInfo,omitempty"`
+		WidgetLayoutInfo *WidgetLayoutInfo `json:"WidgetLayoutInfo,omitempty"`
+	} `json:"resources"`
+}
+
+// String return widget object as Json string
+func (w *Widget) String() string {
+	json, _ := json.Marshal(w)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update widget
+func (w *Widget) CleanBeforeSave() *Widget {
+
+	widgetInfo := *w.WidgetInfo
+	widgetInfo.Id = 0
+	widgetInfo.ClusterName = ""
+	widgetInfo.TimeCreated = 0
+
+	return &Widget{
+		WidgetInfo: &widgetInfo,
+	}
+}
+
+// String return widget layout object as Json string
+func (w *WidgetLayout) String() string {
+	json, _ := json.Marshal(w)
+	return string(json)
+}
+
+// CleanBeforeSave permit to remove the read only attributes before save or update widget layout
+// It keep only the widget id on each widget associated to the layout
+func (w *WidgetLayout) CleanBeforeSave() *WidgetLayout {
+
+	widgetLayoutInfo := *w.WidgetLayoutInfo
+	widgetLayoutInfo.Id = 0
+	widgetLayoutInfo.ClusterName = ""
+	widgetLayoutInfo.Widgets = make([]WidgetLayoutItem, 0, len(w.WidgetLayoutInfo.Widgets))
+	for _, widget := range w.WidgetLayoutInfo.Widgets {
+		id := widget.Id
+		if id == 0 && widget.WidgetInfo != nil {
+			id = widget.WidgetInfo.Id
+		}
+		widgetLayoutInfo.Widgets = append(widgetLayoutInfo.Widgets, WidgetLayoutItem{Id: id})
+	}
+
+	return &WidgetLayout{
+		WidgetLayoutInfo: &widgetLayoutInfo,
+	}
+}
+
+// Widget return existing widget on cluster
+// It return the widget if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Widget(clusterName string, id int64, opts ...RequestOption) (*Widget, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Widget", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/widgets/%d", clusterName, id)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widget := &Widget{}
+	err = json.Unmarshal(resp.Body(), widget)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return widget: %s", widget)
+
+	return widget, nil
+}
+
+// Widgets return all widgets on cluster
+// It return the list of widgets.
+// If not widget, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) Widgets(clusterName string, opts ...RequestOption) ([]Widget, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("Widgets", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/widgets", clusterName)
+	resp, err := c.get(path, opts, Fields("WidgetInfo/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widgetsResponse := &WidgetsResponse{}
+	err = json.Unmarshal(resp.Body(), widgetsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Widgets: ", widgetsResponse.Items)
+
+	return widgetsResponse.Items, nil
+}
+
+// SearchWidget permit to get widget by is name
+// It return the widget if is found
+// It return nil if is not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SearchWidget(clusterName string, widgetName string, opts ...RequestOption) (*Widget, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if widgetName == "" {
+		panic("WidgetName can't be empty")
+	}
+	c.log.Debugw("SearchWidget", "cluster_name", clusterName, "widget_name", widgetName)
+
+	path := fmt.Sprintf("/clusters/%s/widgets", clusterName)
+	resp, err := c.get(path, opts, Fields("WidgetInfo/*"), Where(Eq("WidgetInfo/widget_name", widgetName)))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widgetsResponse := &WidgetsResponse{}
+	err = json.Unmarshal(resp.Body(), widgetsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("WidgetsResponse: ", widgetsResponse)
+
+	if len(widgetsResponse.Items) > 0 {
+		c.log.Debug("Widget: ", widgetsResponse.Items[0])
+		return &widgetsResponse.Items[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// CreateWidget permit to create new widget on cluster
+// It return the widget if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateWidget(widget *Widget) (*Widget, error) {
+
+	if widget == nil {
+		panic("Widget can't be nil")
+	}
+	if widget.WidgetInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("CreateWidget", "widget", widget)
+
+	// Create the widget
+	path := fmt.Sprintf("/clusters/%s/widgets", widget.WidgetInfo.ClusterName)
+	widgetPayload := widget.CleanBeforeSave()
+	c.log.Debug("Widget payload: ", widgetPayload)
+	jsonData, err := json.Marshal(widgetPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Ambari return the id of the widget that just created
+	createResponse := &widgetCreateResponse{}
+	err = json.Unmarshal(resp.Body(), createResponse)
+	if err != nil {
+		return nil, err
+	}
+	if len(createResponse.Resources) == 0 || createResponse.Resources[0].WidgetInfo == nil {
+		return nil, NewAmbariError(500, "Can't get the id of widget that just created")
+	}
+
+	// Get the widget
+	widget, err = c.Widget(widget.WidgetInfo.ClusterName, createResponse.Resources[0].WidgetInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if widget == nil {
+		return nil, NewAmbariError(500, "Can't get widget that just created")
+	}
+
+	return widget, err
+
+}
+
+// UpdateWidget permit to update existing widget
+// It return the widget if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateWidget(widget *Widget) (*Widget, error) {
+
+	if widget == nil {
+		panic("Widget can't be nil")
+	}
+	if widget.WidgetInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("UpdateWidget", "widget", widget)
+
+	// Update the widget
+	path := fmt.Sprintf("/clusters/%s/widgets/%d", widget.WidgetInfo.ClusterName, widget.WidgetInfo.Id)
+	widgetPayload := widget.CleanBeforeSave()
+	c.log.Debug("Widget payload: ", widgetPayload)
+	jsonData, err := json.Marshal(widgetPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the widget after update
+	widget, err = c.Widget(widget.WidgetInfo.ClusterName, widget.WidgetInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if widget == nil {
+		return nil, NewAmbariError(500, "Can't get widget that just updated")
+	}
+
+	return widget, err
+
+}
+
+// DeleteWidget permit to delete existing widget on cluster
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteWidget(clusterName string, id int64) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("DeleteWidget", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/widgets/%d", clusterName, id)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete widget: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
+
+// WidgetLayout return existing widget layout on cluster
+// It return the widget layout if is found
+// It return nil if not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) WidgetLayout(clusterName string, id int64, opts ...RequestOption) (*WidgetLayout, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("WidgetLayout", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/widget_layouts/%d", clusterName, id)
+	resp, err := c.get(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widgetLayout := &WidgetLayout{}
+	err = json.Unmarshal(resp.Body(), widgetLayout)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Return widget layout: %s", widgetLayout)
+
+	return widgetLayout, nil
+}
+
+// WidgetLayouts return all widget layouts on cluster
+// It return the list of widget layouts.
+// If not widget layout, it return empty list.
+// It return error if something wrong when it call the API
+func (c *AmbariClient) WidgetLayouts(clusterName string, opts ...RequestOption) ([]WidgetLayout, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("WidgetLayouts", "cluster_name", clusterName)
+
+	path := fmt.Sprintf("/clusters/%s/widget_layouts", clusterName)
+	resp, err := c.get(path, opts, Fields("WidgetLayoutInfo/*"))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widgetLayoutsResponse := &WidgetLayoutsResponse{}
+	err = json.Unmarshal(resp.Body(), widgetLayoutsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Widget layouts: ", widgetLayoutsResponse.Items)
+
+	return widgetLayoutsResponse.Items, nil
+}
+
+// SearchWidgetLayout permit to get widget layout by is name
+// It return the widget layout if is found
+// It return nil if is not found
+// It return error if something wrong when it call the API
+func (c *AmbariClient) SearchWidgetLayout(clusterName string, layoutName string, opts ...RequestOption) (*WidgetLayout, error) {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	if layoutName == "" {
+		panic("LayoutName can't be empty")
+	}
+	c.log.Debugw("SearchWidgetLayout", "cluster_name", clusterName, "layout_name", layoutName)
+
+	path := fmt.Sprintf("/clusters/%s/widget_layouts", clusterName)
+	resp, err := c.get(path, opts, Fields("WidgetLayoutInfo/*"), Where(Eq("WidgetLayoutInfo/layout_name", layoutName)))
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to get: ", resp)
+	if resp.StatusCode() >= 300 {
+		if resp.StatusCode() == 404 {
+			return nil, nil
+		} else {
+			return nil, NewAmbariErrorFromResponse(resp)
+		}
+	}
+	widgetLayoutsResponse := &WidgetLayoutsResponse{}
+	err = json.Unmarshal(resp.Body(), widgetLayoutsResponse)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("WidgetLayoutsResponse: ", widgetLayoutsResponse)
+
+	if len(widgetLayoutsResponse.Items) > 0 {
+		c.log.Debug("Widget layout: ", widgetLayoutsResponse.Items[0])
+		return &widgetLayoutsResponse.Items[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// CreateWidgetLayout permit to create new widget layout on cluster and associate the widgets on it
+// It return the widget layout if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) CreateWidgetLayout(widgetLayout *WidgetLayout) (*WidgetLayout, error) {
+
+	if widgetLayout == nil {
+		panic("WidgetLayout can't be nil")
+	}
+	if widgetLayout.WidgetLayoutInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("CreateWidgetLayout", "widget_layout", widgetLayout)
+
+	// Create the widget layout
+	path := fmt.Sprintf("/clusters/%s/widget_layouts", widgetLayout.WidgetLayoutInfo.ClusterName)
+	widgetLayoutPayload := widgetLayout.CleanBeforeSave()
+	c.log.Debug("WidgetLayout payload: ", widgetLayoutPayload)
+	jsonData, err := json.Marshal(widgetLayoutPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Post(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to create: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Ambari return the id of the widget layout that just created
+	createResponse := &widgetCreateResponse{}
+	err = json.Unmarshal(resp.Body(), createResponse)
+	if err != nil {
+		return nil, err
+	}
+	if len(createResponse.Resources) == 0 || createResponse.Resources[0].WidgetLayoutInfo == nil {
+		return nil, NewAmbariError(500, "Can't get the id of widget layout that just created")
+	}
+
+	// Get the widget layout
+	widgetLayout, err = c.WidgetLayout(widgetLayout.WidgetLayoutInfo.ClusterName, createResponse.Resources[0].WidgetLayoutInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if widgetLayout == nil {
+		return nil, NewAmbariError(500, "Can't get widget layout that just created")
+	}
+
+	return widgetLayout, err
+
+}
+
+// UpdateWidgetLayout permit to update existing widget layout, like the widgets associated on it
+// It return the widget layout if all work fine
+// It return error if something wrong when it call the API
+func (c *AmbariClient) UpdateWidgetLayout(widgetLayout *WidgetLayout) (*WidgetLayout, error) {
+
+	if widgetLayout == nil {
+		panic("WidgetLayout can't be nil")
+	}
+	if widgetLayout.WidgetLayoutInfo.ClusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("UpdateWidgetLayout", "widget_layout", widgetLayout)
+
+	// Update the widget layout
+	path := fmt.Sprintf("/clusters/%s/widget_layouts/%d", widgetLayout.WidgetLayoutInfo.ClusterName, widgetLayout.WidgetLayoutInfo.Id)
+	widgetLayoutPayload := widgetLayout.CleanBeforeSave()
+	c.log.Debug("WidgetLayout payload: ", widgetLayoutPayload)
+	jsonData, err := json.Marshal(widgetLayoutPayload)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.Client().R().SetBody(jsonData).Put(path)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debug("Response to update: ", resp)
+	if resp.StatusCode() >= 300 {
+		return nil, NewAmbariErrorFromResponse(resp)
+	}
+
+	// Get the widget layout after update
+	widgetLayout, err = c.WidgetLayout(widgetLayout.WidgetLayoutInfo.ClusterName, widgetLayout.WidgetLayoutInfo.Id)
+	if err != nil {
+		return nil, err
+	}
+	if widgetLayout == nil {
+		return nil, NewAmbariError(500, "Can't get widget layout that just updated")
+	}
+
+	return widgetLayout, err
+
+}
+
+// DeleteWidgetLayout permit to delete existing widget layout on cluster
+// The widgets associated to the layout are not deleted
+// It return error if something wrong when it call the API
+func (c *AmbariClient) DeleteWidgetLayout(clusterName string, id int64) error {
+
+	if clusterName == "" {
+		panic("ClusterName can't be empty")
+	}
+	c.log.Debugw("DeleteWidgetLayout", "cluster_name", clusterName, "id", id)
+
+	path := fmt.Sprintf("/clusters/%s/widget_layouts/%d", clusterName, id)
+	resp, err := c.Client().R().Delete(path)
+	if err != nil {
+		return err
+	}
+	c.log.Debug("Response to delete widget layout: ", resp)
+	if resp.StatusCode() >= 300 {
+		return NewAmbariErrorFromResponse(resp)
+	}
+
+	return nil
+
+}
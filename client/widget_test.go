@@ -0,0 +1,133 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *ClientTestSuite) TestWidget() {
+
+	// Create widget
+	widget := &Widget{
+		WidgetInfo: &WidgetInfo{
+			ClusterName: "test",
+			WidgetName:  "test_widget",
+			WidgetType:  WIDGET_TYPE_NUMBER,
+			Description: "Widget for test",
+			Scope:       WIDGET_SCOPE_USER,
+			Metrics: []WidgetMetric{
+				{
+					Name:          "jvm.JvmMetrics.MemHeapUsedM",
+					MetricPath:    "metrics/jvm/memHeapUsedM",
+					ServiceName:   "ZOOKEEPER",
+					ComponentName: "ZOOKEEPER_SERVER",
+				},
+			},
+			Values: []WidgetValue{
+				{
+					Name:  "test_widget",
+					Value: "${jvm.JvmMetrics.MemHeapUsedM}",
+				},
+			},
+			Properties: map[string]string{
+				"display_unit": "MB",
+			},
+		},
+	}
+	widget, err := s.client.CreateWidget(widget)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widget)
+	if widget != nil {
+		assert.NotZero(s.T(), widget.WidgetInfo.Id)
+		assert.Equal(s.T(), "test_widget", widget.WidgetInfo.WidgetName)
+		assert.Equal(s.T(), WIDGET_TYPE_NUMBER, widget.WidgetInfo.WidgetType)
+		assert.Equal(s.T(), "MB", widget.WidgetInfo.Properties["display_unit"])
+	}
+
+	// Get widget
+	widget, err = s.client.Widget("test", widget.WidgetInfo.Id)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widget)
+	if widget != nil {
+		assert.Equal(s.T(), "Widget for test", widget.WidgetInfo.Description)
+	}
+
+	// Search widget
+	widgetSearch, err := s.client.SearchWidget("test", "test_widget")
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widgetSearch)
+	if widgetSearch != nil {
+		assert.Equal(s.T(), widget.WidgetInfo.Id, widgetSearch.WidgetInfo.Id)
+	}
+
+	// Update widget
+	widget.WidgetInfo.Description = "Widget for test 2"
+	widget, err = s.client.UpdateWidget(widget)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widget)
+	if widget != nil {
+		assert.Equal(s.T(), "Widget for test 2", widget.WidgetInfo.Description)
+	}
+
+	// Get all widgets
+	widgets, err := s.client.Widgets("test")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), widgets)
+
+	// Create widget layout with the widget
+	widgetLayout := &WidgetLayout{
+		WidgetLayoutInfo: &WidgetLayoutInfo{
+			ClusterName: "test",
+			LayoutName:  "test_layout",
+			SectionName: "ZOOKEEPER_SUMMARY",
+			DisplayName: "Test layout",
+			Scope:       WIDGET_SCOPE_USER,
+			UserName:    "admin",
+			Widgets: []WidgetLayoutItem{
+				{
+					Id: widget.WidgetInfo.Id,
+				},
+			},
+		},
+	}
+	widgetLayout, err = s.client.CreateWidgetLayout(widgetLayout)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widgetLayout)
+	if widgetLayout != nil {
+		assert.NotZero(s.T(), widgetLayout.WidgetLayoutInfo.Id)
+		assert.Equal(s.T(), "test_layout", widgetLayout.WidgetLayoutInfo.LayoutName)
+		assert.Equal(s.T(), 1, len(widgetLayout.WidgetLayoutInfo.Widgets))
+	}
+
+	// Search widget layout
+	widgetLayoutSearch, err := s.client.SearchWidgetLayout("test", "test_layout")
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widgetLayoutSearch)
+
+	// Update widget layout
+	widgetLayout.WidgetLayoutInfo.DisplayName = "Test layout 2"
+	widgetLayout, err = s.client.UpdateWidgetLayout(widgetLayout)
+	assert.NoError(s.T(), err)
+	assert.NotNil(s.T(), widgetLayout)
+	if widgetLayout != nil {
+		assert.Equal(s.T(), "Test layout 2", widgetLayout.WidgetLayoutInfo.DisplayName)
+	}
+
+	// Get all widget layouts
+	widgetLayouts, err := s.client.WidgetLayouts("test")
+	assert.NoError(s.T(), err)
+	assert.NotEmpty(s.T(), widgetLayouts)
+
+	// Delete widget layout
+	err = s.client.DeleteWidgetLayout("test", widgetLayout.WidgetLayoutInfo.Id)
+	assert.NoError(s.T(), err)
+	widgetLayout, err = s.client.WidgetLayout("test", widgetLayout.WidgetLayoutInfo.Id)
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), widgetLayout)
+
+	// Delete widget
+	err = s.client.DeleteWidget("test", widget.WidgetInfo.Id)
+	assert.NoError(s.T(), err)
+	widget, err = s.client.Widget("test", widget.WidgetInfo.Id)
+	assert.NoError(s.T(), err)
+	assert.Nil(s.T(), widget)
+}
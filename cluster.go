@@ -2,9 +2,13 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
+	"go-ambari-rest/client"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
 	"io/ioutil"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -50,20 +54,10 @@ func createCluster(c *cli.Context) error {
 	log.Debug("HostsTemplateJson: ", hostsTemplateJson)
 
 	// Check if blueprint already exist
-	blueprint, err := clientAmbari.Blueprint(c.String("cluster-name"))
+	err = registerBlueprint(clientAmbari, c.String("cluster-name"), blueprintJson)
 	if err != nil {
 		return cli.NewExitError(err, 1)
 	}
-	if blueprint == nil {
-		// Create the blueprint
-		_, err = clientAmbari.CreateBlueprint(c.String("cluster-name"), blueprintJson)
-		if err != nil {
-			return cli.NewExitError(err, 1)
-		}
-		log.Info("Create blueprint successfully")
-	} else {
-		log.Info("Blueprint already exist, skip.")
-	}
 
 	// Check if cluster already exist
 	cluster, err := clientAmbari.Cluster(c.String("cluster-name"))
@@ -73,54 +67,329 @@ func createCluster(c *cli.Context) error {
 	if cluster == nil {
 
 		// Before create cluster, we need wait all node join to macro substitution work fine
-		clusterTemplate := &ClusterTemplate{}
-		err = json.Unmarshal([]byte(hostsTemplateJson), clusterTemplate)
+		err = waitHostsJoin(clientAmbari, hostsTemplateJson)
 		if err != nil {
 			return cli.NewExitError(err, 1)
 		}
 
-		nbNodes := 0
-		for _, hostGroup := range clusterTemplate.HostGroups {
-			nbNodes = nbNodes + len(hostGroup.Hosts)
+		// Create the cluster
+		_, err = clientAmbari.CreateClusterFromTemplate(c.String("cluster-name"), hostsTemplateJson)
+		if err != nil {
+			return requestExitError(clientAmbari, c.String("cluster-name"), err)
+		}
+		log.Info("Cluster created successfully, look /var/log/ambari-server/ambari-server.log about potential topologie error")
+	} else {
+		log.Info("Cluster already exist, skip")
+	}
+
+	return nil
+
+}
+
+// registerBlueprint create the blueprint if not already exist
+func registerBlueprint(clientAmbari *client.AmbariClient, blueprintName string, blueprintJson string) error {
+
+	blueprint, err := clientAmbari.Blueprint(blueprintName)
+	if err != nil {
+		return err
+	}
+	if blueprint == nil {
+		// Create the blueprint
+		_, err = clientAmbari.CreateBlueprint(blueprintName, blueprintJson)
+		if err != nil {
+			return err
 		}
+		log.Info("Create blueprint successfully")
+	} else {
+		log.Info("Blueprint already exist, skip.")
+	}
+
+	return nil
+}
+
+// waitHostsJoin wait all hosts of template join the Ambari server
+func waitHostsJoin(clientAmbari *client.AmbariClient, hostsTemplateJson string) error {
+
+	clusterTemplate := &ClusterTemplate{}
+	err := json.Unmarshal([]byte(hostsTemplateJson), clusterTemplate)
+	if err != nil {
+		return err
+	}
 
-		log.Infof("Wait all nodes (%d) join Ambari server to avoid hostgroup substitution ...", nbNodes)
-		loop := true
-		for loop == true {
-			loop = false
-			for idx, hostGroup := range clusterTemplate.HostGroups {
-				tempHosts := make([]Host, 0, len(hostGroup.Hosts))
-				for _, hostTemp := range hostGroup.Hosts {
-					// Check if host already here
-					host, err := clientAmbari.Host(hostTemp.FQDN)
-					if err != nil {
-						return cli.NewExitError(err, 1)
-					}
-					if host == nil {
-						// Wait host join
-						loop = true
-						tempHosts = append(tempHosts, hostTemp)
-						log.Infof("Host %s not yet join the cluster, continuous to wait...", hostTemp.FQDN)
-						time.Sleep(10 * time.Second)
-					} else {
-						log.Infof("Host %s already join the cluster", hostTemp.FQDN)
-					}
+	nbNodes := 0
+	for _, hostGroup := range clusterTemplate.HostGroups {
+		nbNodes = nbNodes + len(hostGroup.Hosts)
+	}
+
+	log.Infof("Wait all nodes (%d) join Ambari server to avoid hostgroup substitution ...", nbNodes)
+	loop := true
+	for loop == true {
+		loop = false
+		for idx, hostGroup := range clusterTemplate.HostGroups {
+			tempHosts := make([]Host, 0, len(hostGroup.Hosts))
+			for _, hostTemp := range hostGroup.Hosts {
+				// Check if host already here
+				host, err := clientAmbari.Host(hostTemp.FQDN)
+				if err != nil {
+					return err
+				}
+				if host == nil {
+					// Wait host join
+					loop = true
+					tempHosts = append(tempHosts, hostTemp)
+					log.Infof("Host %s not yet join the cluster, continuous to wait...", hostTemp.FQDN)
+					time.Sleep(10 * time.Second)
+				} else {
+					log.Infof("Host %s already join the cluster", hostTemp.FQDN)
 				}
-				clusterTemplate.HostGroups[idx].Hosts = tempHosts
 			}
+			clusterTemplate.HostGroups[idx].Hosts = tempHosts
 		}
-		log.Info("All nodes have join the Ambari server.")
+	}
+	log.Info("All nodes have join the Ambari server.")
 
-		// Create the cluster
-		_, err = clientAmbari.CreateClusterFromTemplate(c.String("cluster-name"), hostsTemplateJson)
+	return nil
+}
+
+func createClusterFromBlueprint(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("blueprint-file") == "" {
+		return cli.NewExitError("You must set blueprint-file parameter", 1)
+	}
+	if c.String("hosts-template-file") == "" {
+		return cli.NewExitError("You must set hosts-template-file parameter", 1)
+	}
+	// Read the Json files
+	b, err := ioutil.ReadFile(c.String("blueprint-file"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	blueprintJson := string(b)
+	log.Debug("BlueprintJson: ", blueprintJson)
+	b, err = ioutil.ReadFile(c.String("hosts-template-file"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	hostsTemplateJson := string(b)
+	log.Debug("HostsTemplateJson: ", hostsTemplateJson)
+
+	// The blueprint name is the one given, else the one in template, else the cluster name
+	hostsTemplate := map[string]interface{}{}
+	err = json.Unmarshal(b, &hostsTemplate)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	blueprintName := c.String("blueprint-name")
+	if blueprintName == "" {
+		blueprintName, _ = hostsTemplate["blueprint"].(string)
+	}
+	if blueprintName == "" {
+		blueprintName = c.String("cluster-name")
+	}
+	if hostsTemplate["blueprint"] != blueprintName {
+		hostsTemplate["blueprint"] = blueprintName
+		b, err = json.Marshal(hostsTemplate)
 		if err != nil {
 			return cli.NewExitError(err, 1)
 		}
-		log.Info("Cluster created successfully, look /var/log/ambari-server/ambari-server.log about potential topologie error")
-	} else {
+		hostsTemplateJson = string(b)
+	}
+
+	err = registerBlueprint(clientAmbari, blueprintName, blueprintJson)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// Check if cluster already exist
+	cluster, err := clientAmbari.Cluster(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if cluster != nil {
 		log.Info("Cluster already exist, skip")
+		return nil
+	}
+
+	err = waitHostsJoin(clientAmbari, hostsTemplateJson)
+	if err != nil {
+		return cli.NewExitError(err, 1)
 	}
 
+	// Create the cluster
+	requestTask, err := clientAmbari.ProvisionClusterFromTemplate(c.String("cluster-name"), hostsTemplateJson)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if requestTask.RequestTaskInfo == nil {
+		log.Infof("Cluster %s is provisioning", c.String("cluster-name"))
+		return nil
+	}
+	log.Infof("Cluster %s is provisioning with request %d", c.String("cluster-name"), requestTask.RequestTaskInfo.Id)
+
+	if waitRequests {
+		_, err = waitRequest(clientAmbari, c.String("cluster-name"), requestTask.RequestTaskInfo.Id)
+		if err != nil {
+			return requestExitError(clientAmbari, c.String("cluster-name"), err)
+		}
+		log.Infof("Cluster %s is installed and started", c.String("cluster-name"))
+	}
+
+	return nil
+}
+
+func createEmptyCluster(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("version") == "" {
+		return cli.NewExitError("You must set version parameter", 1)
+	}
+
+	// Check if cluster already exist
+	cluster, err := clientAmbari.Cluster(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if cluster != nil {
+		log.Infof("Cluster %s already exist, skip", c.String("cluster-name"))
+		return nil
+	}
+
+	cluster, err = clientAmbari.CreateCluster(&client.Cluster{
+		ClusterInfo: &client.ClusterInfo{
+			ClusterName:  c.String("cluster-name"),
+			Version:      c.String("version"),
+			SecurityType: c.String("security-type"),
+		},
+	})
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Successfully create cluster %s with version %s and security type %s", cluster.ClusterInfo.ClusterName, cluster.ClusterInfo.Version, cluster.ClusterInfo.SecurityType)
+
 	return nil
+}
+
+func renameCluster(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("new-cluster-name") == "" {
+		return cli.NewExitError("You must set new-cluster-name parameter", 1)
+	}
+
+	// Check if cluster is already renamed
+	cluster, err := clientAmbari.Cluster(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if cluster == nil {
+		cluster, err = clientAmbari.Cluster(c.String("new-cluster-name"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		if cluster != nil {
+			log.Infof("Cluster %s is already renamed to %s, skip", c.String("cluster-name"), c.String("new-cluster-name"))
+			return nil
+		}
+		return cli.NewExitError(client.NewAmbariError(404, "Cluster %s not found", c.String("cluster-name")), 1)
+	}
 
+	cluster.ClusterInfo.ClusterName = c.String("new-cluster-name")
+	_, err = clientAmbari.RenameCluster(c.String("cluster-name"), cluster)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Successfully rename cluster %s to %s", c.String("cluster-name"), c.String("new-cluster-name"))
+
+	return nil
+}
+
+func getCluster(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	cluster, err := clientAmbari.Cluster(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if cluster == nil {
+		return cli.NewExitError(client.NewAmbariError(404, "Cluster %s not found", c.String("cluster-name")), 1)
+	}
+
+	table := &Table{
+		Headers: []string{"NAME", "VERSION", "SECURITY"},
+		Rows:    [][]string{{cluster.ClusterInfo.ClusterName, cluster.ClusterInfo.Version, cluster.ClusterInfo.SecurityType}},
+	}
+	if err = printOutput(cluster, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func getClusterHealth(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	summary, err := clientAmbari.GetClusterHealthSummary(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"SERVICE", "STATE", "MAINTENANCE", "COMPONENT", "STARTED", "INSTALLED", "TOTAL"},
+		Rows:    make([][]string, 0),
+	}
+	for _, service := range summary.Services {
+		for _, component := range service.Components {
+			table.Rows = append(table.Rows, []string{service.ServiceName, service.State, service.MaintenanceState, component.ComponentName, strconv.Itoa(component.StartedCount), strconv.Itoa(component.InstalledCount), strconv.Itoa(component.TotalCount)})
+		}
+	}
+	if err = printOutput(summary, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// Fail when services are not healthy, to check cluster before maintenance
+	if c.Bool("check") {
+		unhealthy := summary.UnhealthyServices()
+		if len(unhealthy) > 0 {
+			serviceNames := make([]string, 0, len(unhealthy))
+			for _, service := range unhealthy {
+				serviceNames = append(serviceNames, service.ServiceName)
+			}
+			return cli.NewExitError(fmt.Sprintf("Services not healthy in cluster %s: %s", c.String("cluster-name"), strings.Join(serviceNames, ", ")), 1)
+		}
+	}
+
+	return nil
 }
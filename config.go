@@ -0,0 +1,281 @@
+package main
+
+import (
+	"go-ambari-rest/client"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"sort"
+	"strings"
+)
+
+// findConfiguration return the configuration of type currently used by cluster, or the one with the tag if it's set
+func findConfiguration(clientAmbari *client.AmbariClient, clusterName string, configurationType string, tag string) (*client.Configuration, error) {
+
+	var configuration *client.Configuration
+	var err error
+	if tag == "" {
+		configuration, err = clientAmbari.DesiredConfigurationOnCluster(clusterName, configurationType)
+	} else {
+		configuration, err = clientAmbari.ConfigurationOnCluster(clusterName, configurationType, tag)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if configuration == nil {
+		if tag == "" {
+			return nil, client.NewAmbariError(404, "Configuration %s not found on cluster %s", configurationType, clusterName)
+		}
+		return nil, client.NewAmbariError(404, "Configuration %s with tag %s not found on cluster %s", configurationType, tag, clusterName)
+	}
+
+	return configuration, nil
+}
+
+func getConfig(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("type") == "" {
+		return cli.NewExitError("You must set type parameter", 1)
+	}
+
+	configuration, err := findConfiguration(clientAmbari, c.String("cluster-name"), c.String("type"), c.String("tag"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// Only one property
+	if c.String("property") != "" {
+		value, ok := configuration.Properties[c.String("property")]
+		if !ok {
+			return cli.NewExitError(client.NewAmbariError(404, "Property %s not found in configuration %s", c.String("property"), c.String("type")), 1)
+		}
+		table := &Table{
+			Headers: []string{"PROPERTY", "VALUE"},
+			Rows:    [][]string{{c.String("property"), value}},
+		}
+		if err = printOutput(map[string]string{c.String("property"): value}, table); err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		return nil
+	}
+
+	keys := sortedKeys(configuration.Properties)
+	table := &Table{
+		Headers: []string{"PROPERTY", "VALUE"},
+		Rows:    make([][]string, 0, len(keys)),
+	}
+	for _, key := range keys {
+		table.Rows = append(table.Rows, []string{key, configuration.Properties[key]})
+	}
+	if err = printOutput(configuration, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func setConfig(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("type") == "" {
+		return cli.NewExitError("You must set type parameter", 1)
+	}
+	if len(c.StringSlice("property")) == 0 && len(c.StringSlice("remove")) == 0 {
+		return cli.NewExitError("You must set property or remove parameter", 1)
+	}
+
+	properties := make(map[string]string, len(c.StringSlice("property")))
+	for _, property := range c.StringSlice("property") {
+		keyValue := strings.SplitN(property, "=", 2)
+		if len(keyValue) != 2 || keyValue[0] == "" {
+			return cli.NewExitError("The property parameter must be key=value, got "+property, 1)
+		}
+		properties[keyValue[0]] = keyValue[1]
+	}
+
+	current, err := findConfiguration(clientAmbari, c.String("cluster-name"), c.String("type"), "")
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	configuration, err := clientAmbari.UpdateConfigurationProperties(c.String("cluster-name"), c.String("type"), properties, c.StringSlice("remove"), c.String("note"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if configuration.Tag == current.Tag {
+		log.Infof("Configuration %s already have these properties, skip", c.String("type"))
+		return nil
+	}
+	log.Infof("Successfully create configuration %s with tag %s in cluster %s. Restart the services to use it.", c.String("type"), configuration.Tag, c.String("cluster-name"))
+
+	return nil
+}
+
+func diffConfig(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	var diffs []client.ConfigDiff
+	if c.String("to-cluster-name") != "" {
+		// Between clusters
+		diffs, err = clientAmbari.DiffClusterConfigs(c.String("cluster-name"), c.String("to-cluster-name"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+	} else {
+		// Between versions
+		if c.String("type") == "" {
+			return cli.NewExitError("You must set to-cluster-name parameter, or type and from-tag parameters", 1)
+		}
+		if c.String("from-tag") == "" {
+			return cli.NewExitError("You must set from-tag parameter", 1)
+		}
+		from, err := findConfiguration(clientAmbari, c.String("cluster-name"), c.String("type"), c.String("from-tag"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		to, err := findConfiguration(clientAmbari, c.String("cluster-name"), c.String("type"), c.String("to-tag"))
+		if err != nil {
+			return cli.NewExitError(err, 1)
+		}
+		diffs = client.DiffConfigs(
+			&client.ClusterConfigs{ClusterName: c.String("cluster-name"), Configurations: []client.Configuration{*from}},
+			&client.ClusterConfigs{ClusterName: c.String("cluster-name"), Configurations: []client.Configuration{*to}},
+		)
+	}
+
+	table := &Table{
+		Headers: []string{"TYPE", "PROPERTY", "CHANGE", "FROM", "TO"},
+		Rows:    make([][]string, 0),
+	}
+	for _, diff := range diffs {
+		for _, key := range sortedKeys(diff.Added) {
+			table.Rows = append(table.Rows, []string{diff.Type, key, "added", "", diff.Added[key]})
+		}
+		for _, key := range sortedKeys(diff.Removed) {
+			table.Rows = append(table.Rows, []string{diff.Type, key, "removed", diff.Removed[key], ""})
+		}
+		changed := make([]string, 0, len(diff.Changed))
+		for key := range diff.Changed {
+			changed = append(changed, key)
+		}
+		sort.Strings(changed)
+		for _, key := range changed {
+			table.Rows = append(table.Rows, []string{diff.Type, key, "changed", diff.Changed[key].From, diff.Changed[key].To})
+		}
+	}
+	if err = printOutput(diffs, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func sortedKeys(properties map[string]string) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// logConfigurationType return the log4j configuration type from the type parameter, or from the service-name parameter
+func logConfigurationType(c *cli.Context) (string, error) {
+	if c.String("type") != "" {
+		return c.String("type"), nil
+	}
+	if c.String("service-name") != "" {
+		return client.LogConfigurationType(c.String("service-name")), nil
+	}
+	return "", errors.New("You must set service-name or type parameter")
+}
+
+func listLoggerLevels(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	configurationType, err := logConfigurationType(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	loggerLevels, err := clientAmbari.LoggerLevels(c.String("cluster-name"), configurationType)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"LOGGER", "LEVEL", "PROPERTY"},
+		Rows:    make([][]string, 0, len(loggerLevels)),
+	}
+	for _, loggerLevel := range loggerLevels {
+		table.Rows = append(table.Rows, []string{loggerLevel.Logger, loggerLevel.Level, loggerLevel.Property})
+	}
+	if err = printOutput(loggerLevels, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func setLoggerLevel(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	configurationType, err := logConfigurationType(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("logger") == "" {
+		return cli.NewExitError("You must set logger parameter", 1)
+	}
+	if c.String("level") == "" {
+		return cli.NewExitError("You must set level parameter", 1)
+	}
+
+	current, err := findConfiguration(clientAmbari, c.String("cluster-name"), configurationType, "")
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	configuration, err := clientAmbari.SetLoggerLevel(c.String("cluster-name"), configurationType, c.String("logger"), c.String("level"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if configuration.Tag == current.Tag {
+		log.Infof("Logger %s already have level %s, skip", c.String("logger"), strings.ToUpper(c.String("level")))
+		return nil
+	}
+	log.Infof("Successfully set logger %s to %s in configuration %s with tag %s. Restart the components to use it.", c.String("logger"), strings.ToUpper(c.String("level")), configurationType, configuration.Tag)
+
+	return nil
+}
@@ -3,7 +3,10 @@ package main
 import (
 	"go-ambari-rest/client"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
+	"strconv"
+	"strings"
+	"time"
 )
 
 func addHostInCluster(c *cli.Context) error {
@@ -28,7 +31,7 @@ func addHostInCluster(c *cli.Context) error {
 	// Register host in cluster
 	_, err = clientAmbari.RegisterHostOnCluster(c.String("cluster-name"), c.String("hostname"), c.String("blueprint-name"), c.String("role"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 	log.Infof("Successfully add new host %s in cluster %s with role %s", c.String("hostname"), c.String("cluster-name"), c.String("role"))
 
@@ -79,7 +82,7 @@ func stopAllComponentsInHost(c *cli.Context) error {
 	// Stop all components
 	err = clientAmbari.StopAllComponentsInHost(c.String("cluster-name"), c.String("hostname"), c.Bool("enable-maintenance"), c.Bool("force"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully stop all components in host %s with enable maintenance mode to %t", c.String("hostname"), c.Bool("enable-maintenance"))
@@ -112,7 +115,7 @@ func startAllComponentsInHost(c *cli.Context) error {
 	// Start all components
 	err = clientAmbari.StartAllComponentsInHost(c.String("cluster-name"), c.String("hostname"), c.Bool("disable-maintenance"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully start all components in host %s with disable maintenance mode to %t", c.String("hostname"), c.Bool("enable-maintenance"))
@@ -148,7 +151,7 @@ func startComponentInHost(c *cli.Context) error {
 	// Start component
 	_, err = clientAmbari.StartHostComponent(c.String("cluster-name"), c.String("hostname"), c.String("component-name"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully start component %s on host %s", c.String("hostname"), c.String("component-name"))
@@ -184,10 +187,80 @@ func stopComponentInHost(c *cli.Context) error {
 	// Stop component
 	_, err = clientAmbari.StopHostComponent(c.String("cluster-name"), c.String("hostname"), c.String("component-name"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully stop component %s on host %s", c.String("hostname"), c.String("component-name"))
 
 	return nil
 }
+
+func listHosts(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// All hosts registered on Ambari or only the hosts of the cluster, with their components
+	var hosts []client.Host
+	if c.String("cluster-name") == "" {
+		hosts, err = clientAmbari.Hosts(client.Fields("Hosts/*"))
+	} else {
+		hosts, err = clientAmbari.ListHosts(c.String("cluster-name"), nil)
+	}
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"HOSTNAME", "CLUSTER", "RACK", "MAINTENANCE", "STATUS", "LAST HEARTBEAT", "COMPONENTS"},
+		Rows:    make([][]string, 0, len(hosts)),
+	}
+	for _, host := range hosts {
+		lastHeartbeat := ""
+		if !host.HostInfo.LastHeartbeat().IsZero() {
+			lastHeartbeat = host.HostInfo.LastHeartbeat().Format(time.RFC3339)
+		}
+		components := make([]string, 0, len(host.HostComponents))
+		for _, hostComponent := range host.HostComponents {
+			components = append(components, hostComponent.HostComponentInfo.ComponentName+":"+hostComponent.HostComponentInfo.State)
+		}
+		table.Rows = append(table.Rows, []string{host.HostInfo.Hostname, host.HostInfo.ClusterName, host.HostInfo.Rack, host.HostInfo.MaintenanceState, host.HostInfo.HostStatus, lastHeartbeat, strings.Join(components, ",")})
+	}
+	if err = printOutput(hosts, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func listRestartRequired(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set --cluster-name parameter", 1)
+	}
+
+	hostComponents, err := clientAmbari.ListComponentsNeedingRestart(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"HOSTNAME", "SERVICE", "COMPONENT", "ADMIN STATE", "STALE CONFIGS", "STALE CODE", "UPGRADE STATE", "VERSION"},
+		Rows:    make([][]string, 0, len(hostComponents)),
+	}
+	for _, hostComponent := range hostComponents {
+		info := hostComponent.HostComponentInfo
+		table.Rows = append(table.Rows, []string{info.Hostname, info.ServiceName, info.ComponentName, info.DesiredAdminState, strconv.FormatBool(info.StaleConfigs), strconv.FormatBool(info.StaleCode()), info.UpgradeState, info.Version})
+	}
+	if err = printOutput(hostComponents, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
@@ -5,8 +5,9 @@ import (
 	"go-ambari-rest/client"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -113,6 +114,7 @@ func addKerberos(c *cli.Context) error {
 	}
 
 	// Check and wait all task is finished before start the kerberos settings
+	start := time.Now()
 	isTaskRun := true
 	for isTaskRun {
 
@@ -125,6 +127,9 @@ func addKerberos(c *cli.Context) error {
 			if requestTask.RequestTaskInfo.ProgressPercent < 100 {
 				isTaskRun = true
 				log.Debugf("Task '%s' (%d) is not yet finished, state is %s (%f %%)", requestTask.RequestTaskInfo.Context, requestTask.RequestTaskInfo.Id, requestTask.RequestTaskInfo.Status, requestTask.RequestTaskInfo.ProgressPercent)
+				if requestTimeout > 0 && time.Since(start) >= requestTimeout {
+					return requestExitError(clientAmbari, c.String("cluster-name"), client.NewRequestTimeoutError(&requestTask, requestTimeout))
+				}
 			}
 		}
 
@@ -271,7 +276,7 @@ func addKerberos(c *cli.Context) error {
 	// Stop all services
 	err = clientAmbari.StopAllServices(cluster, false, true)
 	if err != nil {
-		return nil
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 	log.Info("All services are stopped")
 
@@ -323,17 +328,66 @@ func addKerberos(c *cli.Context) error {
 	}
 	cluster, err = clientAmbari.ManageKerberosOnCluster(cluster)
 	if err != nil {
-		return err
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 	log.Info("Kerberos is enabled")
 
 	// Start all services
 	err = clientAmbari.StartAllServices(cluster, false)
 	if err != nil {
-		return err
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 	log.Info("All services are started")
 
 	return nil
 
 }
+
+func regenerateKeytabs(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	// The components are SERVICE or SERVICE:COMPONENT
+	scope := &client.KeytabScope{
+		Hosts:              c.StringSlice("host"),
+		Components:         make(map[string][]string, len(c.StringSlice("component"))),
+		ConfigUpdatePolicy: c.String("config-update-policy"),
+	}
+	for _, component := range c.StringSlice("component") {
+		serviceComponent := strings.SplitN(component, ":", 2)
+		if serviceComponent[0] == "" {
+			return cli.NewExitError("The component parameter must be SERVICE or SERVICE:COMPONENT, got "+component, 1)
+		}
+		if len(serviceComponent) == 1 {
+			scope.Components[serviceComponent[0]] = nil
+		} else {
+			scope.Components[serviceComponent[0]] = append(scope.Components[serviceComponent[0]], serviceComponent[1])
+		}
+	}
+
+	id, err := clientAmbari.RegenerateKeytabs(c.String("cluster-name"), scope, c.Bool("only-missing"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if id == 0 {
+		log.Infof("There are no keytabs to regenerate in cluster %s", c.String("cluster-name"))
+		return nil
+	}
+	log.Infof("Keytabs are regenerating in cluster %s with request %d", c.String("cluster-name"), id)
+
+	if waitRequests {
+		_, err = waitRequest(clientAmbari, c.String("cluster-name"), id)
+		if err != nil {
+			return requestExitError(clientAmbari, c.String("cluster-name"), err)
+		}
+		log.Infof("Keytabs are regenerated in cluster %s. Restart the services to use them.", c.String("cluster-name"))
+	}
+
+	return nil
+}
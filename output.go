@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	OUTPUT_TABLE = "table"
+	OUTPUT_JSON  = "json"
+	OUTPUT_YAML  = "yaml"
+)
+
+var outputFormat string
+
+// Table is the columns and the rows to display when the output is table
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// checkOutputFormat check the --output parameter
+// The logs are written on stderr when the output is json or yaml, to keep stdout parseable
+func checkOutputFormat() error {
+	switch outputFormat {
+	case OUTPUT_TABLE:
+	case OUTPUT_JSON, OUTPUT_YAML:
+		log.SetOutput(os.Stderr)
+	default:
+		return errors.Errorf("The --output parameter must be %s, %s or %s", OUTPUT_TABLE, OUTPUT_JSON, OUTPUT_YAML)
+	}
+
+	return nil
+}
+
+// printOutput display the data on stdout with the format asked by --output
+func printOutput(data interface{}, table *Table) error {
+
+	switch outputFormat {
+	case OUTPUT_JSON:
+		b, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	case OUTPUT_YAML:
+		// Use Json tags as keys
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		var object interface{}
+		if err = yaml.Unmarshal(b, &object); err != nil {
+			return err
+		}
+		b, err = yaml.Marshal(object)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(b))
+	default:
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, strings.Join(table.Headers, "\t"))
+		for _, row := range table.Rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+
+	return nil
+}
@@ -2,10 +2,14 @@ package main
 
 import (
 	"encoding/json"
+	"fmt"
 	"go-ambari-rest/client"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
 	"io/ioutil"
+	"strconv"
+	"strings"
 )
 
 type Privileges struct {
@@ -79,3 +83,259 @@ func createPrivileges(c *cli.Context) error {
 	return nil
 
 }
+
+// privilegeScope is where the privileges are given: on Ambari, on cluster or on view instance
+type privilegeScope struct {
+	clusterName  string
+	viewName     string
+	viewVersion  string
+	viewInstance string
+}
+
+func newPrivilegeScope(c *cli.Context) (*privilegeScope, error) {
+	scope := &privilegeScope{
+		clusterName:  c.String("cluster-name"),
+		viewName:     c.String("view-name"),
+		viewVersion:  c.String("view-version"),
+		viewInstance: c.String("view-instance"),
+	}
+	if scope.clusterName != "" && scope.viewName != "" {
+		return nil, errors.New("You can't set cluster-name and view-name parameters together")
+	}
+	if scope.viewName != "" && (scope.viewVersion == "" || scope.viewInstance == "") {
+		return nil, errors.New("You must set view-version and view-instance parameters with view-name")
+	}
+
+	return scope, nil
+}
+
+func (s *privilegeScope) String() string {
+	if s.clusterName != "" {
+		return "cluster " + s.clusterName
+	}
+	if s.viewName != "" {
+		return fmt.Sprintf("view %s/%s/%s", s.viewName, s.viewVersion, s.viewInstance)
+	}
+	return "Ambari"
+}
+
+func (s *privilegeScope) privileges(clientAmbari *client.AmbariClient) ([]client.Privilege, error) {
+	if s.clusterName != "" {
+		return clientAmbari.Privileges(s.clusterName)
+	}
+	if s.viewName != "" {
+		return clientAmbari.ViewPrivileges(s.viewName, s.viewVersion, s.viewInstance)
+	}
+	return clientAmbari.AmbariPrivileges()
+}
+
+func (s *privilegeScope) create(clientAmbari *client.AmbariClient, privilege *client.Privilege) (*client.Privilege, error) {
+	if s.clusterName != "" {
+		return clientAmbari.CreatePrivilege(s.clusterName, privilege)
+	}
+	if s.viewName != "" {
+		return clientAmbari.CreateViewPrivilege(s.viewName, s.viewVersion, s.viewInstance, privilege)
+	}
+	return clientAmbari.CreateAmbariPrivilege(privilege)
+}
+
+func (s *privilegeScope) delete(clientAmbari *client.AmbariClient, id int64) error {
+	if s.clusterName != "" {
+		return clientAmbari.DeletePrivilege(s.clusterName, id)
+	}
+	if s.viewName != "" {
+		return clientAmbari.DeleteViewPrivilege(s.viewName, s.viewVersion, s.viewInstance, id)
+	}
+	return clientAmbari.DeleteAmbariPrivilege(id)
+}
+
+// privilegeFromParameters return the privilege described by principal, type and permission parameters
+func privilegeFromParameters(c *cli.Context) (*client.Privilege, error) {
+	if c.String("principal") == "" {
+		return nil, errors.New("You must set principal parameter")
+	}
+	if c.String("type") == "" {
+		return nil, errors.New("You must set type parameter")
+	}
+	if c.String("permission") == "" {
+		return nil, errors.New("You must set permission parameter")
+	}
+
+	return &client.Privilege{
+		PrivilegeInfo: &client.PrivilegeInfo{
+			PermissionName: c.String("permission"),
+			PrincipalName:  c.String("principal"),
+			PrincipalType:  strings.ToUpper(c.String("type")),
+		},
+	}, nil
+}
+
+func listPrivileges(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	scope, err := newPrivilegeScope(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	privileges, err := scope.privileges(clientAmbari)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"ID", "PERMISSION", "NAME", "TYPE"},
+		Rows:    make([][]string, 0, len(privileges)),
+	}
+	for _, privilege := range privileges {
+		table.Rows = append(table.Rows, []string{strconv.FormatInt(privilege.PrivilegeInfo.PrivilegeId, 10), privilege.PrivilegeInfo.PermissionName, privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PrincipalType})
+	}
+	if err = printOutput(privileges, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func grantPrivilege(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	scope, err := newPrivilegeScope(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	privilege, err := privilegeFromParameters(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	// Check if privilege already exist
+	privileges, err := scope.privileges(clientAmbari)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if client.FindPrivilege(privileges, privilege.PrivilegeInfo.PermissionName, privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PrincipalType) != nil {
+		log.Infof("Privilege %s / %s already exist on %s, skip", privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PermissionName, scope)
+		return nil
+	}
+
+	_, err = scope.create(clientAmbari, privilege)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Grant privilege %s / %s on %s successfully", privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PermissionName, scope)
+
+	return nil
+}
+
+func revokePrivilege(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	scope, err := newPrivilegeScope(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	privilege, err := privilegeFromParameters(c)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	privileges, err := scope.privileges(clientAmbari)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	existingPrivilege := client.FindPrivilege(privileges, privilege.PrivilegeInfo.PermissionName, privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PrincipalType)
+	if existingPrivilege == nil {
+		log.Infof("Privilege %s / %s not found on %s, skip", privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PermissionName, scope)
+		return nil
+	}
+
+	err = scope.delete(clientAmbari, existingPrivilege.PrivilegeInfo.PrivilegeId)
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Revoke privilege %s / %s on %s successfully", privilege.PrivilegeInfo.PrincipalName, privilege.PrivilegeInfo.PermissionName, scope)
+
+	return nil
+}
+
+func exportSecurityModel(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set --cluster-name parameter", 1)
+	}
+	if c.String("file") == "" {
+		return cli.NewExitError("You must set --file parameter", 1)
+	}
+
+	securityModel, err := clientAmbari.ExportSecurityModel(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	data, err := securityModel.Marshal(c.String("format"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if err = ioutil.WriteFile(c.String("file"), data, 0600); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Export %d users, %d groups and %d privileges in %s successfully", len(securityModel.Users), len(securityModel.Groups), len(securityModel.Privileges), c.String("file"))
+
+	return nil
+}
+
+func importSecurityModel(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set --cluster-name parameter", 1)
+	}
+	if c.String("file") == "" {
+		return cli.NewExitError("You must set --file parameter", 1)
+	}
+
+	data, err := ioutil.ReadFile(c.String("file"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	securityModel, err := client.UnmarshalSecurityModel(data, c.String("format"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.Bool("dry-run") {
+		clientAmbari.SetDryRun(client.NewDryRunRecorder())
+	}
+
+	report, err := clientAmbari.ImportSecurityModel(c.String("cluster-name"), securityModel, c.String("password"), c.Bool("delete-privileges"))
+	if report != nil {
+		for _, change := range report.Changes {
+			log.Infof("%s %s %s", change.Action, change.Resource, change.Name)
+		}
+	}
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if report.HasChanges() && !c.Bool("dry-run") {
+		log.Infof("Import security model on %s successfully", c.String("cluster-name"))
+	} else if !report.HasChanges() {
+		log.Infof("Security model is already up to date on %s", c.String("cluster-name"))
+	}
+
+	return nil
+}
@@ -4,7 +4,7 @@ import (
 	"encoding/json"
 	"go-ambari-rest/client"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
 	"io/ioutil"
 )
 
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"go-ambari-rest/client"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"strconv"
+	"time"
+)
+
+// requestPollInterval is the time between two checks of the request progress
+var requestPollInterval = 10 * time.Second
+
+func listRequests(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	requests, err := clientAmbari.Requests(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"ID", "STATUS", "PROGRESS", "CONTEXT"},
+		Rows:    make([][]string, 0, len(requests)),
+	}
+	for _, request := range requests {
+		table.Rows = append(table.Rows, []string{strconv.Itoa(request.RequestTaskInfo.Id), request.RequestTaskInfo.Status, fmt.Sprintf("%.0f%%", request.RequestTaskInfo.ProgressPercent), request.RequestTaskInfo.Context})
+	}
+	if err = printOutput(requests, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+// waitRequest display the progress of the request until it's finished
+// It return error if the request is not completed, or if it's not finished after the --timeout parameter
+func waitRequest(clientAmbari *client.AmbariClient, clusterName string, id int) (*client.RequestTask, error) {
+
+	start := time.Now()
+	lastProgress := float64(-1)
+	for {
+		requestTask, err := clientAmbari.Request(clusterName, id)
+		if err != nil {
+			return nil, err
+		}
+		if requestTask == nil {
+			return nil, client.NewAmbariError(404, "Request %d not found in cluster %s", id, clusterName)
+		}
+		info := requestTask.RequestTaskInfo
+		if info.ProgressPercent != lastProgress {
+			log.Infof("Request %d '%s' is %s: %.0f%%", info.Id, info.Context, info.Status, info.ProgressPercent)
+			lastProgress = info.ProgressPercent
+		}
+		if info.ProgressPercent >= 100 {
+			if info.Status != client.REQUEST_COMPLETED {
+				return requestTask, client.NewRequestError(requestTask)
+			}
+			return requestTask, nil
+		}
+		if requestTimeout > 0 && time.Since(start) >= requestTimeout {
+			return requestTask, client.NewRequestTimeoutError(requestTask, requestTimeout)
+		}
+		time.Sleep(requestPollInterval)
+	}
+}
+
+// requestExitError return the error to exit the command
+// When the error come from request that failed or not finished in time, it display the logs of the tasks that not completed.
+// The exit code is 2 when the request is not finished in time, else it's 1.
+func requestExitError(clientAmbari *client.AmbariClient, clusterName string, err error) error {
+
+	var ambariError client.AmbariError
+	if errors.As(err, &ambariError) && ambariError.RequestId > 0 {
+		printFailedTasks(clientAmbari, clusterName, ambariError.RequestId)
+		if client.IsTimeout(err) {
+			return cli.NewExitError(err, 2)
+		}
+	}
+
+	return cli.NewExitError(err, 1)
+}
+
+// printFailedTasks display the output of the tasks that failed, and the tasks that are still running on timeout
+func printFailedTasks(clientAmbari *client.AmbariClient, clusterName string, requestId int) {
+
+	// The tasks are streamed because the requests of big cluster can have thousand of tasks
+	failedTasks := make([]client.Task, 0)
+	err := clientAmbari.StreamTasks(clusterName, requestId, func(task *client.Task) error {
+		switch task.TaskInfo.Status {
+		case client.REQUEST_COMPLETED, "PENDING", "QUEUED":
+		case "IN_PROGRESS":
+			log.Errorf("Task %d %s %s on %s is still running", task.TaskInfo.Id, task.TaskInfo.Role, task.TaskInfo.Command, task.TaskInfo.Hostname)
+		default:
+			failedTasks = append(failedTasks, *task)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warnf("Can't get the tasks of request %d: %s", requestId, err.Error())
+		return
+	}
+	for _, task := range failedTasks {
+		log.Errorf("Task %d %s %s on %s is %s with exit code %d", task.TaskInfo.Id, task.TaskInfo.Role, task.TaskInfo.Command, task.TaskInfo.Hostname, task.TaskInfo.Status, task.TaskInfo.ExitCode)
+		taskDetail, err := clientAmbari.Task(clusterName, requestId, task.TaskInfo.Id)
+		if err != nil || taskDetail == nil {
+			continue
+		}
+		if taskDetail.TaskInfo.Stderr != "" {
+			log.Errorf("Stderr:\n%s", taskDetail.TaskInfo.Stderr)
+		}
+		if taskDetail.TaskInfo.Stdout != "" {
+			log.Errorf("Stdout:\n%s", taskDetail.TaskInfo.Stdout)
+		}
+	}
+}
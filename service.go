@@ -3,7 +3,7 @@ package main
 import (
 	"go-ambari-rest/client"
 	log "github.com/sirupsen/logrus"
-	"gopkg.in/urfave/cli.v1"
+	"github.com/urfave/cli"
 )
 
 func stopServiceInCluster(c *cli.Context) error {
@@ -22,7 +22,7 @@ func stopServiceInCluster(c *cli.Context) error {
 	// Stop the service
 	_, err = clientAmbari.StopService(c.String("cluster-name"), c.String("service-name"), c.Bool("enable-maintenance"), c.Bool("force"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully stop service %s in cluster %s with enable maintenance mode to %t", c.String("service-name"), c.String("cluster-name"), c.Bool("enable-maintenance"))
@@ -46,7 +46,7 @@ func startServiceInCluster(c *cli.Context) error {
 	// Stop the service
 	_, err = clientAmbari.StartService(c.String("cluster-name"), c.String("service-name"), c.Bool("disable-maintenance"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully start service %s in cluster %s with  disable maintenance mode to %t", c.String("service-name"), c.String("cluster-name"), c.Bool("disable-maintenance"))
@@ -76,7 +76,7 @@ func stopAllServicesInCluster(c *cli.Context) error {
 	// Stop all the services
 	err = clientAmbari.StopAllServices(cluster, c.Bool("enable-maintenance"), c.Bool("force"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully stop all services in cluster %s with enable maintenance mode to %t", c.String("cluster-name"), c.Bool("enable-maintenance"))
@@ -106,10 +106,42 @@ func startAllServicesInCluster(c *cli.Context) error {
 	// Start all the services
 	err = clientAmbari.StartAllServices(cluster, c.Bool("disable-maintenance"))
 	if err != nil {
-		return cli.NewExitError(err, 1)
+		return requestExitError(clientAmbari, c.String("cluster-name"), err)
 	}
 
 	log.Infof("Successfully start all services in cluster %s", c.String("cluster-name"))
 
 	return nil
 }
+
+func getService(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.String("service-name") == "" {
+		return cli.NewExitError("You must set service-name parameter", 1)
+	}
+
+	service, err := clientAmbari.Service(c.String("cluster-name"), c.String("service-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if service == nil {
+		return cli.NewExitError(client.NewAmbariError(404, "Service %s not found in cluster %s", c.String("service-name"), c.String("cluster-name")), 1)
+	}
+
+	table := &Table{
+		Headers: []string{"NAME", "CLUSTER", "STATE", "MAINTENANCE"},
+		Rows:    [][]string{{service.ServiceInfo.ServiceName, service.ServiceInfo.ClusterName, service.ServiceInfo.State, service.ServiceInfo.MaintenanceState}},
+	}
+	if err = printOutput(service, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
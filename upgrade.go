@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"go-ambari-rest/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"strconv"
+	"time"
+)
+
+func listUpgrades(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+
+	upgrades, err := clientAmbari.Upgrades(c.String("cluster-name"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	table := &Table{
+		Headers: []string{"ID", "DIRECTION", "TYPE", "VERSION", "STATUS", "PROGRESS", "START", "END"},
+		Rows:    make([][]string, 0, len(upgrades)),
+	}
+	for _, upgrade := range upgrades {
+		info := upgrade.UpgradeInfo
+		table.Rows = append(table.Rows, []string{strconv.Itoa(info.Id), info.Direction, info.UpgradeType, info.AssociatedVersion, info.Status, fmt.Sprintf("%.0f%%", info.ProgressPercent), formatTime(info.Started()), formatTime(info.Ended())})
+	}
+	if err = printOutput(upgrades, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func getUpgrade(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.Int("id") <= 0 {
+		return cli.NewExitError("You must set id parameter", 1)
+	}
+
+	upgrade, err := clientAmbari.Upgrade(c.String("cluster-name"), c.Int("id"))
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if upgrade == nil {
+		return cli.NewExitError(fmt.Sprintf("Upgrade %d not found in cluster %s", c.Int("id"), c.String("cluster-name")), 1)
+	}
+
+	table := &Table{
+		Headers: []string{"GROUP", "STAGE", "STATUS", "STEP"},
+		Rows:    make([][]string, 0),
+	}
+	for _, group := range upgrade.UpgradeGroups {
+		for _, item := range group.UpgradeItems {
+			table.Rows = append(table.Rows, []string{group.UpgradeGroupInfo.Title, strconv.Itoa(item.UpgradeItemInfo.StageId), item.UpgradeItemInfo.Status, item.UpgradeItemInfo.Context})
+		}
+	}
+	if err = printOutput(upgrade, table); err != nil {
+		return cli.NewExitError(err, 1)
+	}
+
+	return nil
+}
+
+func finalizeUpgrade(c *cli.Context) error {
+
+	clientAmbari, err := manageGlobalParameters()
+	if err != nil {
+		return cli.NewExitError(err, 1)
+	}
+	if c.String("cluster-name") == "" {
+		return cli.NewExitError("You must set cluster-name parameter", 1)
+	}
+	if c.Int("id") <= 0 {
+		return cli.NewExitError("You must set id parameter", 1)
+	}
+
+	var upgrade *client.Upgrade
+	if c.Bool("skip-failures") {
+		upgrade, err = clientAmbari.SkipAndFinalizeUpgrade(c.String("cluster-name"), c.Int("id"))
+	} else {
+		upgrade, err = clientAmbari.FinalizeUpgrade(c.String("cluster-name"), c.Int("id"))
+	}
+	if err != nil {
+		if client.IsTimeout(err) {
+			return cli.NewExitError(err, 2)
+		}
+		return cli.NewExitError(err, 1)
+	}
+	log.Infof("Upgrade %d is finalized", upgrade.UpgradeInfo.Id)
+
+	if waitRequests {
+		if _, err = waitRequest(clientAmbari, c.String("cluster-name"), upgrade.UpgradeInfo.Id); err != nil {
+			return requestExitError(clientAmbari, c.String("cluster-name"), err)
+		}
+	}
+
+	return nil
+}
+
+// formatTime return the time as RFC3339, or empty string for zero time
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}